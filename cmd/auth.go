@@ -2,10 +2,14 @@ package cmd
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"strings"
 	"syscall"
+	"text/tabwriter"
 
 	"github.com/doughughes/atlassian-cli/internal/atlassian"
 	"github.com/doughughes/atlassian-cli/internal/config"
@@ -24,17 +28,44 @@ var loginCmd = &cobra.Command{
 	Short: "Log in to an Atlassian account",
 	Long: `Authenticate with Atlassian Cloud by providing your site URL, email, and API token.
 
-Your API token can be generated at: https://id.atlassian.com/manage-profile/security/api-tokens`,
+Your API token can be generated at: https://id.atlassian.com/manage-profile/security/api-tokens
+
+By default the token is saved in ~/.config/atlassian/config.json. With
+--use-keyring (or 'atl config set credential-store keyring') it is saved in
+the OS keyring instead (macOS Keychain, Windows Credential Manager, or the
+Secret Service on Linux) and the config file only records where to find it.
+If no keyring is available the token is saved to the config file with a
+warning.`,
 	RunE: runLogin,
 }
 
+// Flags for login
+var loginUseKeyring bool
+
 var statusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Show authentication status",
-	Long:  `Display the current authentication status and active account information.`,
-	RunE:  runStatus,
+	Long: `Display the current authentication status and the account other commands
+would run as: the one named by --account, the ATLASSIAN_SITE, ATLASSIAN_EMAIL,
+and ATLASSIAN_TOKEN environment variables, or the active account.
+
+The credentials are checked against the API and the HTTP status is shown, so
+bad or revoked credentials (401) can be told apart from an account that lacks
+permission to use the API (403). For valid credentials the account type and
+the global permissions it holds (browse projects, create issues, administer)
+are listed too. Exits non-zero when the credentials fail the check, or with
+--all when any account does.
+
+Examples:
+  atl auth status
+  atl auth status --account personal
+  atl auth status --all`,
+	RunE: runStatus,
 }
 
+// Flags for status
+var statusAll bool
+
 var logoutCmd = &cobra.Command{
 	Use:   "logout",
 	Short: "Log out of an Atlassian account",
@@ -42,11 +73,38 @@ var logoutCmd = &cobra.Command{
 	RunE:  runLogout,
 }
 
+var switchCmd = &cobra.Command{
+	Use:   "switch <accountName>",
+	Short: "Change the active account",
+	Long: `Make another configured account the active one.
+
+Run 'atl auth list' to see the configured accounts. To use another account
+for a single command without switching, pass --account instead.
+
+Examples:
+  atl auth switch personal`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSwitch,
+}
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List configured accounts",
+	Long:  `List every configured account with its site and email. The active account is marked with *.`,
+	Args:  cobra.NoArgs,
+	RunE:  runList,
+}
+
 func init() {
 	rootCmd.AddCommand(authCmd)
 	authCmd.AddCommand(loginCmd)
 	authCmd.AddCommand(statusCmd)
 	authCmd.AddCommand(logoutCmd)
+	authCmd.AddCommand(switchCmd)
+	authCmd.AddCommand(listCmd)
+
+	loginCmd.Flags().BoolVar(&loginUseKeyring, "use-keyring", false, "Store the API token in the OS keyring instead of the config file")
+	statusCmd.Flags().BoolVar(&statusAll, "all", false, "Check every configured account and print a table of their statuses")
 }
 
 func runLogin(cmd *cobra.Command, args []string) error {
@@ -82,7 +140,7 @@ func runLogin(cmd *cobra.Command, args []string) error {
 
 	// Create client and test authentication
 	fmt.Println("\nVerifying credentials...")
-	client := atlassian.NewClient(email, token, site)
+	client := withRequestSettings(atlassian.NewClient(email, token, site))
 
 	user, err := client.GetCurrentUser()
 	if err != nil {
@@ -98,11 +156,29 @@ func runLogin(cmd *cobra.Command, args []string) error {
 	// Use site domain as account name (e.g., "mycompany" from "mycompany.atlassian.net")
 	configAccountName := strings.Split(site, ".")[0]
 
-	cfg.SetAccount(configAccountName, &config.Account{
-		Site:  site,
-		Email: email,
-		Token: token,
-	})
+	account := &config.Account{
+		Site:      site,
+		Email:     email,
+		Token:     token,
+		AccountID: user.AccountID,
+	}
+
+	store, _ := cfg.GetDefault("credential-store")
+	if loginUseKeyring || store == config.CredentialStoreKeyring {
+		if err := storeTokenInKeyring(configAccountName, token); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v; saving the token to the config file instead\n", err)
+		} else {
+			account.KeyringKey = configAccountName
+		}
+	}
+
+	// Logging in again with file storage leaves nothing for an old keyring
+	// entry to do, so don't leave the token behind in the keyring
+	if previous, ok := cfg.Accounts[configAccountName]; ok && previous.KeyringKey != "" && account.KeyringKey == "" {
+		deleteKeyringToken(previous.KeyringKey)
+	}
+
+	cfg.SetAccount(configAccountName, account)
 	cfg.ActiveAccount = configAccountName
 
 	if err := cfg.Save(); err != nil {
@@ -112,45 +188,141 @@ func runLogin(cmd *cobra.Command, args []string) error {
 	fmt.Printf("\n✓ Successfully authenticated as %s\n", user.DisplayName)
 	fmt.Printf("✓ Email: %s\n", email)
 	fmt.Printf("✓ Site: %s\n", site)
+	if account.KeyringKey != "" {
+		fmt.Printf("✓ Token stored in the OS keyring\n")
+	}
 	fmt.Printf("\nConfiguration saved. You can now use 'atl' commands.\n")
 
 	return nil
 }
 
+// storeTokenInKeyring saves token in the OS keyring under key
+func storeTokenInKeyring(key, token string) error {
+	keyring, err := config.OpenKeyring()
+	if err != nil {
+		return err
+	}
+	if err := keyring.Set(key, token); err != nil {
+		return fmt.Errorf("failed to store token in keyring: %w", err)
+	}
+	return nil
+}
+
+// deleteKeyringToken removes a token from the OS keyring. Failures only warn,
+// since the account is being replaced or removed either way.
+func deleteKeyringToken(key string) {
+	keyring, err := config.OpenKeyring()
+	if err == nil {
+		err = keyring.Delete(key)
+	}
+	if err != nil && !errors.Is(err, config.ErrKeyringNotFound) {
+		fmt.Fprintf(os.Stderr, "Warning: failed to remove token from keyring: %v\n", err)
+	}
+}
+
+// statusPermissions are the permissions auth status reports on
+var statusPermissions = []string{"BROWSE_PROJECTS", "CREATE_ISSUES", "ADMINISTER"}
+
+// authCheck is the outcome of validating one account's credentials
+type authCheck struct {
+	StatusCode  int
+	User        *atlassian.UserInfo
+	Permissions []string
+	Label       string // short outcome, e.g. "Bad credentials"
+	Hint        string // what to do about a failure
+}
+
+// checkAuth validates the client's credentials and, when they work, looks up
+// the permissions the account holds
+func checkAuth(client *atlassian.Client) *authCheck {
+	user, code, err := client.CheckAuthentication()
+	check := &authCheck{StatusCode: code, User: user}
+
+	switch {
+	case err == nil:
+		check.Label = "Valid"
+		// Permissions are a bonus; a failed lookup doesn't make the
+		// credentials invalid
+		check.Permissions, _ = client.GetMyPermissions(statusPermissions)
+	case code == http.StatusUnauthorized:
+		check.Label = "Bad credentials"
+		check.Hint = "The email or API token is wrong, or the token was revoked or has expired. Run 'atl auth login' with a new token."
+	case code == http.StatusForbidden:
+		check.Label = "Insufficient permissions"
+		check.Hint = "The credentials were accepted but the account may not use the API. Check its product access and the token's scopes."
+	case code == 0:
+		check.Label = "Unreachable"
+		check.Hint = err.Error()
+	default:
+		check.Label = "Check failed"
+		check.Hint = err.Error()
+	}
+	return check
+}
+
 func runStatus(cmd *cobra.Command, args []string) error {
-	cfg, err := config.Load()
+	if statusAll {
+		if accountFlag != "" {
+			return fmt.Errorf("--all can't be combined with --account")
+		}
+		return runStatusAll()
+	}
+
+	cfg, err := loadConfig()
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return err
 	}
 
-	if cfg.ActiveAccount == "" {
+	// --account shows another configured account without switching to it,
+	// and otherwise the same credentials other commands would use are shown
+	fromEnv := accountFlag == "" && config.AccountFromEnv() != nil
+	name := cfg.ActiveAccount
+	if accountFlag != "" {
+		name = accountFlag
+	} else if fromEnv {
+		name = "environment"
+	}
+	if name == "" {
 		fmt.Println("Not logged in. Run 'atl auth login' to authenticate.")
 		return nil
 	}
 
-	account, err := cfg.GetActiveAccount()
+	account, err := resolveAccount(cfg)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("Logged in to: %s\n", cfg.ActiveAccount)
+	fmt.Printf("Logged in to: %s\n", name)
 	fmt.Printf("  Site:  %s\n", account.Site)
 	fmt.Printf("  Email: %s\n", account.Email)
+	switch {
+	case fromEnv:
+		fmt.Printf("  Token: %s environment variable\n", config.EnvToken)
+	case account.KeyringKey != "":
+		fmt.Printf("  Token: OS keyring\n")
+	default:
+		fmt.Printf("  Token: config file\n")
+	}
 
 	// Test if credentials are still valid
-	fmt.Print("  Status:   ")
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-	if err := client.TestAuthentication(); err != nil {
-		fmt.Println("✗ Invalid (credentials may have expired)")
-		return nil
+	client := withRequestSettings(atlassian.NewClient(account.Email, account.Token, account.Site))
+	check := checkAuth(client)
+	if check.User == nil {
+		fmt.Printf("  Status: ✗ %s%s\n", check.Label, formatHTTPStatus(check.StatusCode))
+		fmt.Printf("  Hint:   %s\n", check.Hint)
+		return fmt.Errorf("credentials for %s failed the check: %s", name, check.Label)
 	}
 
-	// Get user info
-	user, err := client.GetCurrentUser()
-	if err != nil {
-		fmt.Println("✓ Valid")
-	} else {
-		fmt.Printf("✓ Valid (%s)\n", user.DisplayName)
+	fmt.Printf("  Status: ✓ Valid (%s)%s\n", check.User.DisplayName, formatHTTPStatus(check.StatusCode))
+	if check.User.AccountType != "" {
+		fmt.Printf("  Account type: %s\n", check.User.AccountType)
+	}
+	if check.Permissions != nil {
+		permissions := strings.Join(check.Permissions, ", ")
+		if permissions == "" {
+			permissions = "(none of " + strings.Join(statusPermissions, ", ") + ")"
+		}
+		fmt.Printf("  Permissions:  %s\n", permissions)
 	}
 
 	return nil
@@ -168,6 +340,9 @@ func runLogout(cmd *cobra.Command, args []string) error {
 	}
 
 	accountName := cfg.ActiveAccount
+	if account, ok := cfg.Accounts[accountName]; ok && account.KeyringKey != "" {
+		deleteKeyringToken(account.KeyringKey)
+	}
 	delete(cfg.Accounts, accountName)
 	cfg.ActiveAccount = ""
 
@@ -178,3 +353,117 @@ func runLogout(cmd *cobra.Command, args []string) error {
 	fmt.Printf("Logged out of %s\n", accountName)
 	return nil
 }
+
+func runSwitch(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	if err := cfg.SetActiveAccount(name); err != nil {
+		return err
+	}
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	account := cfg.Accounts[name]
+	fmt.Printf("✓ Switched to %s (%s, %s)\n", name, account.Site, account.Email)
+	return nil
+}
+
+func runList(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	names := cfg.AccountNames()
+	if len(names) == 0 {
+		fmt.Println("No accounts configured. Run 'atl auth login' to add one.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, name := range names {
+		account := cfg.Accounts[name]
+		marker := " "
+		if name == cfg.ActiveAccount {
+			marker = "*"
+		}
+		fmt.Fprintf(w, "%s %s\t%s\t%s\n", marker, name, account.Site, account.Email)
+	}
+	w.Flush()
+	return nil
+}
+
+// formatHTTPStatus returns " (HTTP n)", or "" when no response was received
+func formatHTTPStatus(code int) string {
+	if code == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (HTTP %d)", code)
+}
+
+// runStatusAll checks every configured account and prints a table of the
+// results
+func runStatusAll() error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if len(cfg.Accounts) == 0 {
+		fmt.Println("No accounts configured. Run 'atl auth login' to add one.")
+		return nil
+	}
+
+	results, err := fanOutAccounts(func(client *atlassian.Client) (map[string]any, error) {
+		return map[string]any{"check": checkAuth(client)}, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if failed := printStatusTable(os.Stdout, results, cfg.ActiveAccount); failed > 0 {
+		return fmt.Errorf("%d of %d account(s) failed the credential check", failed, len(results))
+	}
+	return nil
+}
+
+// printStatusTable writes one row per account check, marking the active
+// account, and returns how many accounts failed
+func printStatusTable(out io.Writer, results []accountResult, activeAccount string) int {
+	failed := 0
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  ACCOUNT\tSITE\tSTATUS\tHTTP\tDETAIL")
+	for _, r := range results {
+		marker := " "
+		if r.Account == activeAccount {
+			marker = "*"
+		}
+
+		status, code, detail := "✗ Error", "-", r.Error
+		check, ok := r.Result["check"].(*authCheck)
+		if ok {
+			if check.StatusCode != 0 {
+				code = fmt.Sprintf("%d", check.StatusCode)
+			}
+			if check.User != nil {
+				status, detail = "✓ "+check.Label, check.User.DisplayName
+				if check.User.AccountType != "" {
+					detail += " (" + check.User.AccountType + ")"
+				}
+			} else {
+				status, detail = "✗ "+check.Label, check.Hint
+			}
+		}
+		if !ok || check.User == nil {
+			failed++
+		}
+		fmt.Fprintf(w, "%s %s\t%s\t%s\t%s\t%s\n", marker, r.Account, r.Site, status, code, detail)
+	}
+	w.Flush()
+	return failed
+}
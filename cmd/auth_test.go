@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/doughughes/atlassian-cli/internal/config"
+)
+
+func TestRunStatusAll_FailsWhenAnAccountFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, _, _ := r.BasicAuth()
+		if user != "good@example.com" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path == "/rest/api/3/myself" {
+			w.Write([]byte(`{"accountId":"abc","displayName":"Good User"}`))
+			return
+		}
+		w.Write([]byte(`{"permissions":{}}`))
+	}))
+	defer server.Close()
+
+	t.Setenv("HOME", t.TempDir())
+
+	tests := []struct {
+		name     string
+		emails   []string
+		failures string // Expected error text; empty means success
+	}{
+		{"All valid", []string{"good@example.com"}, ""},
+		{"One revoked", []string{"good@example.com", "revoked@example.com"}, "1 of 2 account(s)"},
+		{"All revoked", []string{"revoked@example.com"}, "1 of 1 account(s)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.New()
+			for _, email := range tt.emails {
+				cfg.SetAccount(email, &config.Account{Site: server.URL, Email: email, Token: "token"})
+			}
+			if err := cfg.Save(); err != nil {
+				t.Fatalf("Expected config to save, got %v", err)
+			}
+
+			err := runStatusAll()
+			if tt.failures == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tt.failures) {
+				t.Errorf("Expected an error mentioning %q, got %v", tt.failures, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/doughughes/atlassian-cli/internal/atlassian"
+	"github.com/doughughes/atlassian-cli/internal/config"
+)
+
+// baseURLOverride is set by the hidden --base-url global flag
+var baseURLOverride string
+
+// Set by the --max-retries and --retry-on global flags
+var (
+	maxRetriesFlag int
+	retryOnFlag    string
+)
+
+// headerFlags holds the raw --header values; extraHeaders is the parsed form
+var (
+	headerFlags  []string
+	extraHeaders http.Header
+)
+
+// timeoutFlag is set by the --timeout global flag
+var timeoutFlag time.Duration
+
+// requestContext is cancelled on Ctrl-C; every client's requests are bound
+// to it
+var requestContext context.Context
+
+// newClient resolves the account to use (see resolveAccount) and creates an
+// API client for it.
+//
+// The --base-url flag (or ATLASSIAN_BASE_URL) replaces the account's site for
+// this invocation. It is intended for testing against mock servers and
+// staging instances, and works without a logged-in account.
+func newClient() (*atlassian.Client, *config.Account, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	baseURL := baseURLOverride
+	if baseURL == "" {
+		baseURL = os.Getenv("ATLASSIAN_BASE_URL")
+	}
+
+	account, err := resolveAccount(cfg)
+	if err != nil {
+		if baseURL == "" {
+			return nil, nil, err
+		}
+		account = &config.Account{}
+	}
+
+	if baseURL != "" {
+		warnCredentialsRedirected(account, baseURL)
+
+		// Copy so the override never leaks into a saved config
+		overridden := *account
+		overridden.Site = baseURL
+		account = &overridden
+	}
+
+	retry, err := resolveRetryPolicy(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client := atlassian.NewClient(account.Email, account.Token, account.Site)
+	client.Retry = retry
+	client.Headers = extraHeaders
+	return withRequestSettings(client), account, nil
+}
+
+// warnedBaseURL records that the --base-url warning was printed, so commands
+// that create several clients only print it once
+var warnedBaseURL bool
+
+// warnCredentialsRedirected warns on stderr when an overridden base URL
+// sends an account's stored credentials to a host other than its own site
+func warnCredentialsRedirected(account *config.Account, baseURL string) {
+	if warnedBaseURL || account.Token == "" || siteHost(account.Site) == siteHost(baseURL) {
+		return
+	}
+	warnedBaseURL = true
+	fmt.Fprintf(os.Stderr, "Warning: sending the credentials for %s to %s (--base-url or ATLASSIAN_BASE_URL)\n", account.Site, baseURL)
+}
+
+// siteHost returns the lower-cased host name of a site, which may be given
+// with or without a scheme
+func siteHost(site string) string {
+	if !strings.Contains(site, "://") {
+		site = "https://" + site
+	}
+	u, err := url.Parse(site)
+	if err != nil {
+		return site
+	}
+	return strings.ToLower(u.Hostname())
+}
+
+// resolveAccount returns the account to run as. Credentials are looked up in
+// this order: the account named by --account, the ATLASSIAN_SITE,
+// ATLASSIAN_EMAIL, and ATLASSIAN_TOKEN environment variables (all three must
+// be set), then the active account.
+func resolveAccount(cfg *config.Config) (*config.Account, error) {
+	account, err := cfg.ResolveAccount(accountFlag)
+	if err == nil {
+		return account, nil
+	}
+	if accountFlag != "" || cfg.ActiveAccount != "" {
+		return nil, err
+	}
+	return nil, fmt.Errorf("not logged in. Credentials are taken from, in order:\n"+
+		"  1. the account named by --account\n"+
+		"  2. the %s, %s, and %s environment variables\n"+
+		"  3. the active account (run 'atl auth login' to add one)",
+		config.EnvSite, config.EnvEmail, config.EnvToken)
+}
+
+// loadConfig loads the config file for commands that run as an account.
+// When the credentials come from the environment, a config that can't be
+// loaded (e.g. on a CI runner without a home directory) is treated as empty
+// so those credentials still work.
+func loadConfig() (*config.Config, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		if accountFlag == "" && config.AccountFromEnv() != nil {
+			return config.New(), nil
+		}
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	return cfg, nil
+}
+
+// withRequestSettings applies --timeout to client and binds it to the
+// command's context so Ctrl-C aborts its requests
+func withRequestSettings(client *atlassian.Client) *atlassian.Client {
+	client.SetTimeout(timeoutFlag)
+	if requestContext == nil {
+		return client
+	}
+	return client.WithContext(requestContext)
+}
+
+// baseURLOverridden reports whether --base-url or ATLASSIAN_BASE_URL points
+// requests somewhere other than the active account's site
+func baseURLOverridden() bool {
+	return baseURLOverride != "" || os.Getenv("ATLASSIAN_BASE_URL") != ""
+}
+
+// updateActiveAccount loads the config, applies update to the account in use
+// (the --account one, or else the active account) and saves it if update
+// reports a change. Nothing is saved when the base URL is overridden or the
+// credentials come from the environment, since the results came from a
+// different site or account.
+func updateActiveAccount(update func(account *config.Account) bool) error {
+	if baseURLOverridden() || (accountFlag == "" && config.AccountFromEnv() != nil) {
+		return nil
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	account, err := cfg.ResolveAccount(accountFlag)
+	if err != nil || !update(account) {
+		return nil
+	}
+	return cfg.Save()
+}
+
+// currentAccountID returns the authenticated user's account ID, using the
+// one saved with the account when available and asking the API otherwise
+func currentAccountID(client *atlassian.Client, account *config.Account) (string, error) {
+	if account.AccountID != "" && !baseURLOverridden() {
+		return account.AccountID, nil
+	}
+
+	user, err := client.GetCurrentUser()
+	if err != nil {
+		return "", fmt.Errorf("failed to get current user: %w", err)
+	}
+	return user.AccountID, nil
+}
+
+// resolveListSetting returns the list given by a flag, falling back to the
+// comma-separated config default stored under key. A nil result means the
+// client's built-in default applies.
+func resolveListSetting(key string, flagValue []string) ([]string, error) {
+	if len(flagValue) > 0 {
+		return flagValue, nil
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	return cfg.ResolveList(key, nil), nil
+}
+
+// resolvePageExpand returns the properties a Confluence page fetch expands:
+// the --expand flag, else the confluence-page-expand setting, else the
+// client's defaults. required names what the command itself reads, and is
+// always included.
+func resolvePageExpand(flagValue []string, required ...string) ([]string, error) {
+	expand, err := resolveListSetting("confluence-page-expand", flagValue)
+	if err != nil {
+		return nil, err
+	}
+	if len(expand) == 0 {
+		expand = atlassian.DefaultPageExpand
+	}
+
+	result := append([]string(nil), expand...)
+	for _, item := range required {
+		if !slices.Contains(result, item) {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
+
+// resolveSetting returns flagValue if set, otherwise the config default
+// stored under key (empty if there is none)
+func resolveSetting(key, flagValue string) (string, error) {
+	if flagValue != "" {
+		return flagValue, nil
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return "", err
+	}
+	value, _ := cfg.GetDefault(key)
+	return value, nil
+}
+
+// resolveRetryPolicy builds the retry policy from the --max-retries and
+// --retry-on flags, falling back to the config defaults and then the
+// built-in defaults
+func resolveRetryPolicy(cfg *config.Config) (atlassian.RetryPolicy, error) {
+	policy := atlassian.DefaultRetryPolicy()
+	flags := rootCmd.PersistentFlags()
+
+	if flags.Changed("max-retries") {
+		if maxRetriesFlag < 0 {
+			return policy, fmt.Errorf("--max-retries must not be negative")
+		}
+		policy.MaxRetries = maxRetriesFlag
+	} else if value, ok := cfg.GetDefault("max-retries"); ok {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return policy, fmt.Errorf("invalid max-retries setting %q in config. Fix it with 'atl config set max-retries <n>'", value)
+		}
+		policy.MaxRetries = n
+	}
+
+	if flags.Changed("retry-on") {
+		statuses, err := atlassian.ParseRetryStatuses(retryOnFlag)
+		if err != nil {
+			return policy, fmt.Errorf("invalid --retry-on: %w", err)
+		}
+		policy.RetryOn = statuses
+	} else if value, ok := cfg.GetDefault("retry-on"); ok {
+		statuses, err := atlassian.ParseRetryStatuses(value)
+		if err != nil {
+			return policy, fmt.Errorf("invalid retry-on setting in config: %w", err)
+		}
+		policy.RetryOn = statuses
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/doughughes/atlassian-cli/internal/config"
+)
+
+func TestNewClient_EnvCredentialsWithoutHome(t *testing.T) {
+	t.Setenv("HOME", "")
+	t.Setenv("XDG_CONFIG_HOME", "")
+	t.Setenv(config.EnvSite, "https://ci.atlassian.net")
+	t.Setenv(config.EnvEmail, "ci@example.com")
+	t.Setenv(config.EnvToken, "secret")
+	t.Setenv("ATLASSIAN_BASE_URL", "")
+
+	if _, err := config.Load(); err == nil {
+		t.Skip("config loads without HOME on this platform")
+	}
+
+	client, account, err := newClient()
+	if err != nil {
+		t.Fatalf("Expected env credentials to work without a config, got %v", err)
+	}
+	if account.Site != "https://ci.atlassian.net" || account.Email != "ci@example.com" {
+		t.Errorf("Expected the account from the environment, got %+v", account)
+	}
+	if client.BaseURL != "https://ci.atlassian.net" {
+		t.Errorf("Expected the client to use the env site, got %s", client.BaseURL)
+	}
+}
+
+func TestNewClient_NoHomeWithoutEnvCredentials(t *testing.T) {
+	t.Setenv("HOME", "")
+	t.Setenv(config.EnvSite, "")
+	t.Setenv("ATLASSIAN_BASE_URL", "")
+
+	if _, err := config.Load(); err == nil {
+		t.Skip("config loads without HOME on this platform")
+	}
+
+	if _, _, err := newClient(); err == nil {
+		t.Error("Expected the config load error without env credentials")
+	}
+}
+
+func TestResolvePageExpand(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if expand, err := resolvePageExpand(nil, "ancestors"); err != nil || !slices.Equal(expand, []string{"body.storage", "version", "space", "history", "ancestors"}) {
+		t.Errorf("Expected the defaults plus ancestors, got %v (%v)", expand, err)
+	}
+
+	cfg := config.New()
+	cfg.SetDefault("confluence-page-expand", "metadata.labels,version")
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Expected config to save, got %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		flag     []string
+		required []string
+		expected []string
+	}{
+		{"Setting replaces the defaults", nil, nil, []string{"metadata.labels", "version"}},
+		{"Required properties are added once", nil, []string{"body.storage", "version"}, []string{"metadata.labels", "version", "body.storage"}},
+		{"Flag overrides the setting", []string{"history"}, []string{"version"}, []string{"history", "version"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expand, err := resolvePageExpand(tt.flag, tt.required...)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !slices.Equal(expand, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, expand)
+			}
+		})
+	}
+}
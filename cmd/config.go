@@ -1,8 +1,17 @@
 package cmd
 
 import (
+	"bufio"
+	"encoding/json"
 	"fmt"
+	"os"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/doughughes/atlassian-cli/internal/atlassian"
 	"github.com/doughughes/atlassian-cli/internal/config"
 	"github.com/spf13/cobra"
 )
@@ -25,15 +34,123 @@ var configGetCmd = &cobra.Command{
 	Short: "Get a configuration value",
 	Long: `Retrieve a specific configuration value by key.
 
-Valid keys: active-account, site, email`,
+Valid keys: active-account, site, email, and any key accepted by 'config set'`,
 	Args:  cobra.ExactArgs(1),
 	RunE:  runConfigGet,
 }
 
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set a default setting",
+	Long: `Set a default setting that applies to every command.
+
+Valid keys:
+  max-retries             Times to retry a failed request (default 3, 0 disables retries)
+  retry-on                Comma-separated status codes to retry (default 429,502,503,504)
+  confluence-page-expand  Properties Confluence page fetches expand, replacing the defaults
+  credential-store        Where 'auth login' saves the API token: file (default) or keyring
+  jira-default-fields     Fields search-jql returns when --fields isn't given
+  jira-default-issue-type Issue type create-issue uses when --type isn't given
+  jira-default-project    Project create-issue and create-version use when --project isn't given
+
+Command-line flags override these settings.
+
+Examples:
+  atl config set max-retries 5
+  atl config set retry-on 429,500,502,503,504
+  atl config set confluence-page-expand body.storage,version,metadata.labels
+  atl config set jira-default-fields summary,status,assignee
+  atl config set jira-default-project PROJ
+  atl config set credential-store keyring`,
+	Args: cobra.ExactArgs(2),
+	RunE: runConfigSet,
+}
+
+var configSetManyCmd = &cobra.Command{
+	Use:   "set-many <key=value>...",
+	Short: "Set several default settings at once",
+	Long: `Set several default settings in one command. Every value is validated
+before anything is saved, so either all settings change or none do.
+
+See 'atl config set --help' for the valid keys.
+
+Examples:
+  atl config set-many max-retries=5 jira-default-project=PROJ
+  atl config set-many retry-on=429,503 jira-default-fields=summary,status`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runConfigSetMany,
+}
+
+var configWizardCmd = &cobra.Command{
+	Use:   "wizard",
+	Short: "Interactively set common default settings",
+	Long: `Prompt for each default setting in turn, showing its current value.
+
+Press Enter to keep the current value, or enter - to unset it. Values are
+validated as they are entered; the default project is also checked against
+the projects visible to the active account.
+
+Examples:
+  atl config wizard`,
+	Args: cobra.NoArgs,
+	RunE: runConfigWizard,
+}
+
+var configUnsetCmd = &cobra.Command{
+	Use:   "unset <key>",
+	Short: "Remove a default setting",
+	Long: `Remove a default setting so the built-in default applies again.
+
+Examples:
+  atl config unset retry-on`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfigUnset,
+}
+
+var configMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Upgrade the config file to the current format",
+	Long: `Upgrade the config file to the current schema version.
+
+Older config files are read as they are and upgraded on disk the next time
+a command saves the config, with the original kept as config.json.v<N>.bak.
+This command saves the upgrade right away and also fills in details that
+need a network request, such as each account's cloud ID.
+
+Examples:
+  atl config migrate
+  atl config migrate --offline`,
+	Args: cobra.NoArgs,
+	RunE: runConfigMigrate,
+}
+
+var configDoctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check the health of your configuration",
+	Long: `Run diagnostic checks on the CLI setup: the config file, its permissions,
+the active account, credentials, and whether the account's cloud ID is saved.
+
+Exits non-zero if any critical check fails.`,
+	Args: cobra.NoArgs,
+	RunE: runConfigDoctor,
+}
+
 func init() {
 	rootCmd.AddCommand(configCmd)
 	configCmd.AddCommand(configListCmd)
 	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
+	configCmd.AddCommand(configUnsetCmd)
+	configCmd.AddCommand(configSetManyCmd)
+	configCmd.AddCommand(configWizardCmd)
+	configCmd.AddCommand(configMigrateCmd)
+	configCmd.AddCommand(configDoctorCmd)
+
+	// Flags for migrate
+	configMigrateCmd.Flags().BoolVar(&configMigrateOffline, "offline", false, "Skip steps that need network access")
+
+	// Flags for doctor
+	configDoctorCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
 }
 
 func runConfigList(cmd *cobra.Command, args []string) error {
@@ -61,9 +178,26 @@ func runConfigList(cmd *cobra.Command, args []string) error {
 			fmt.Printf("  %s%s:\n", name, active)
 			fmt.Printf("    site:  %s\n", account.Site)
 			fmt.Printf("    email: %s\n", account.Email)
+			if account.DeploymentType != "" {
+				fmt.Printf("    deployment: %s\n", account.DeploymentType)
+			}
+			if account.AccountID != "" {
+				fmt.Printf("    account id: %s\n", account.AccountID)
+			}
 		}
 	}
 
+	if len(cfg.Defaults) > 0 {
+		fmt.Println("\nDefaults:")
+		keys := make([]string, 0, len(cfg.Defaults))
+		for key := range cfg.Defaults {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Printf("  %s: %s\n", key, cfg.Defaults[key])
+		}
+	}
 
 	return nil
 }
@@ -100,6 +234,477 @@ func runConfigGet(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	if _, ok := configSettings[key]; ok {
+		value, ok := cfg.GetDefault(key)
+		if !ok {
+			return fmt.Errorf("%s is not set", key)
+		}
+		fmt.Println(value)
+		return nil
+	}
+
 	// Unknown key
-	return fmt.Errorf("unknown configuration key '%s'. Valid keys: active-account, site, email", key)
+	return fmt.Errorf("unknown configuration key '%s'. Valid keys: active-account, site, email, %s", key, strings.Join(configSettingKeys(), ", "))
+}
+
+// configSettings lists the keys accepted by 'config set', each with a
+// validator for its value
+var configSettings = map[string]func(string) error{
+	"max-retries": func(value string) error {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return fmt.Errorf("must be a non-negative integer")
+		}
+		return nil
+	},
+	"retry-on": func(value string) error {
+		_, err := atlassian.ParseRetryStatuses(value)
+		return err
+	},
+	"confluence-page-expand": validateListSetting,
+	"credential-store": func(value string) error {
+		if value != config.CredentialStoreFile && value != config.CredentialStoreKeyring {
+			return fmt.Errorf("must be %s or %s", config.CredentialStoreKeyring, config.CredentialStoreFile)
+		}
+		return nil
+	},
+	"jira-default-fields": validateListSetting,
+	"jira-default-issue-type": func(value string) error {
+		if strings.TrimSpace(value) == "" {
+			return fmt.Errorf("must be an issue type name such as Task")
+		}
+		return nil
+	},
+	"jira-default-project": func(value string) error {
+		if !projectKeyRegexp.MatchString(value) {
+			return fmt.Errorf("must be a project key such as PROJ")
+		}
+		return nil
+	},
+}
+
+// projectKeyRegexp matches Jira project keys
+var projectKeyRegexp = regexp.MustCompile(`^[A-Z][A-Z0-9_]+$`)
+
+// validateListSetting accepts a comma-separated list with at least one entry
+func validateListSetting(value string) error {
+	for _, item := range strings.Split(value, ",") {
+		if strings.TrimSpace(item) != "" {
+			return nil
+		}
+	}
+	return fmt.Errorf("must be a comma-separated list")
+}
+
+// configSettingKeys returns the valid 'config set' keys in sorted order
+func configSettingKeys() []string {
+	keys := make([]string, 0, len(configSettings))
+	for key := range configSettings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func runConfigSet(cmd *cobra.Command, args []string) error {
+	key, value := args[0], args[1]
+
+	validate, ok := configSettings[key]
+	if !ok {
+		return fmt.Errorf("unknown setting '%s'. Valid keys: %s", key, strings.Join(configSettingKeys(), ", "))
+	}
+	if err := validate(value); err != nil {
+		return fmt.Errorf("invalid value for %s: %w", key, err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	cfg.SetDefault(key, value)
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	fmt.Printf("✓ Set %s to %s\n", key, value)
+	return nil
+}
+
+func runConfigSetMany(cmd *cobra.Command, args []string) error {
+	type setting struct{ key, value string }
+	var settings []setting
+
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			return fmt.Errorf("invalid setting %q: expected key=value", arg)
+		}
+		validate, ok := configSettings[key]
+		if !ok {
+			return fmt.Errorf("unknown setting '%s'. Valid keys: %s", key, strings.Join(configSettingKeys(), ", "))
+		}
+		if err := validate(value); err != nil {
+			return fmt.Errorf("invalid value for %s: %w", key, err)
+		}
+		settings = append(settings, setting{key, value})
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	for _, s := range settings {
+		cfg.SetDefault(s.key, s.value)
+	}
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	for _, s := range settings {
+		fmt.Printf("✓ Set %s to %s\n", s.key, s.value)
+	}
+	return nil
+}
+
+// wizardSettings lists the settings 'config wizard' prompts for, in order.
+// atl has no output format, page size, or editor settings yet, so the wizard
+// can't offer them.
+var wizardSettings = []struct {
+	key    string
+	prompt string
+}{
+	{"jira-default-project", "Default Jira project key"},
+	{"jira-default-issue-type", "Default issue type for create-issue"},
+	{"jira-default-fields", "Fields search-jql returns (comma-separated)"},
+	{"confluence-page-expand", "Properties page fetches expand (comma-separated)"},
+	{"max-retries", "Times to retry a failed request"},
+	{"retry-on", "HTTP status codes to retry (comma-separated)"},
+}
+
+func runConfigWizard(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Println("Press Enter to keep the current value, or enter - to unset it.")
+
+	changed := 0
+	for _, setting := range wizardSettings {
+		current, _ := cfg.GetDefault(setting.key)
+		for {
+			if current != "" {
+				fmt.Printf("\n%s [%s]: ", setting.prompt, current)
+			} else {
+				fmt.Printf("\n%s: ", setting.prompt)
+			}
+
+			line, readErr := reader.ReadString('\n')
+			value := strings.TrimSpace(line)
+
+			if value == "" {
+				if readErr != nil {
+					// stdin closed; keep everything else as it is
+					return saveWizardSettings(cfg, changed)
+				}
+				break
+			}
+			if value == "-" {
+				if current != "" {
+					cfg.UnsetDefault(setting.key)
+					changed++
+				}
+				break
+			}
+			if err := validateWizardSetting(setting.key, value); err != nil {
+				fmt.Printf("  ✗ %v\n", err)
+				if readErr != nil {
+					return saveWizardSettings(cfg, changed)
+				}
+				continue
+			}
+			if value != current {
+				cfg.SetDefault(setting.key, value)
+				changed++
+			}
+			break
+		}
+	}
+
+	return saveWizardSettings(cfg, changed)
+}
+
+// validateWizardSetting checks a value entered in the wizard. Beyond the
+// usual validation, the default project must be visible to the active
+// account when it can be checked.
+func validateWizardSetting(key, value string) error {
+	if err := configSettings[key](value); err != nil {
+		return err
+	}
+	if key != "jira-default-project" {
+		return nil
+	}
+
+	client, _, err := newClient()
+	if err != nil {
+		fmt.Printf("  Warning: couldn't check the project exists: %v\n", err)
+		return nil
+	}
+	projects, err := client.GetVisibleProjects(&atlassian.GetVisibleProjectsOptions{SearchString: value})
+	if err != nil {
+		fmt.Printf("  Warning: couldn't check the project exists: %v\n", err)
+		return nil
+	}
+	for _, project := range projects {
+		if projectKey, _ := project["key"].(string); projectKey == value {
+			return nil
+		}
+	}
+	return fmt.Errorf("project %s not found or not visible to you", value)
+}
+
+func saveWizardSettings(cfg *config.Config, changed int) error {
+	if changed == 0 {
+		fmt.Println("\nNo changes.")
+		return nil
+	}
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+	fmt.Printf("\n✓ Saved %d setting(s). Review them with: atl config list\n", changed)
+	return nil
+}
+
+func runConfigUnset(cmd *cobra.Command, args []string) error {
+	key := args[0]
+
+	if _, ok := configSettings[key]; !ok {
+		return fmt.Errorf("unknown setting '%s'. Valid keys: %s", key, strings.Join(configSettingKeys(), ", "))
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	cfg.UnsetDefault(key)
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	fmt.Printf("✓ Unset %s\n", key)
+	return nil
+}
+
+// Flags for migrate
+var configMigrateOffline bool
+
+func runConfigMigrate(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	if from, ok := cfg.MigratedFrom(); ok {
+		if err := cfg.Save(); err != nil {
+			return fmt.Errorf("failed to save config: %w", err)
+		}
+		fmt.Printf("✓ Upgraded config from schema version %d to %d\n", from, cfg.SchemaVersion)
+	} else {
+		fmt.Printf("✓ Config is at schema version %d\n", cfg.SchemaVersion)
+	}
+
+	if configMigrateOffline {
+		return nil
+	}
+
+	names := make([]string, 0, len(cfg.Accounts))
+	for name, account := range cfg.Accounts {
+		if account.CloudID == "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	updated := 0
+	for _, name := range names {
+		account := cfg.Accounts[name]
+		if err := account.LoadToken(); err != nil {
+			fmt.Printf("! Could not get cloud ID for account %s: %v\n", name, err)
+			continue
+		}
+		client := withRequestSettings(atlassian.NewClient(account.Email, account.Token, account.Site))
+
+		cloudID, err := client.GetCloudID()
+		if err != nil {
+			fmt.Printf("! Could not get cloud ID for account %s: %v\n", name, err)
+			continue
+		}
+
+		account.CloudID = cloudID
+		updated++
+		fmt.Printf("✓ Added cloud ID for account %s (%s)\n", name, cloudID)
+	}
+
+	if updated > 0 {
+		if err := cfg.Save(); err != nil {
+			return fmt.Errorf("failed to save config: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// doctorCheck is the result of a single config doctor check
+type doctorCheck struct {
+	Name     string `json:"name"`
+	Passed   bool   `json:"passed"`
+	Critical bool   `json:"critical"`
+	Detail   string `json:"detail,omitempty"`
+	Hint     string `json:"hint,omitempty"`
+}
+
+func runConfigDoctor(cmd *cobra.Command, args []string) error {
+	var checks []doctorCheck
+	add := func(check doctorCheck) bool {
+		checks = append(checks, check)
+		return check.Passed
+	}
+
+	// Each check depends on the previous one, so stop at the first failure
+	func() {
+		configPath, err := config.ConfigPath()
+		if err != nil {
+			add(doctorCheck{Name: "Config file exists", Critical: true, Detail: err.Error()})
+			return
+		}
+
+		info, err := os.Stat(configPath)
+		if !add(doctorCheck{
+			Name:     "Config file exists",
+			Passed:   err == nil,
+			Critical: true,
+			Detail:   configPath,
+			Hint:     "Run 'atl auth login' to create it",
+		}) {
+			return
+		}
+
+		data, err := os.ReadFile(configPath)
+		valid := err == nil && json.Valid(data)
+		if !add(doctorCheck{
+			Name:     "Config file is valid JSON",
+			Passed:   valid,
+			Critical: true,
+			Hint:     "Fix the file by hand, or delete it and run 'atl auth login' again",
+		}) {
+			return
+		}
+
+		// Windows doesn't support Unix permission bits
+		if runtime.GOOS != "windows" {
+			perm := info.Mode().Perm()
+			add(doctorCheck{
+				Name:   "Config file permissions are 0600",
+				Passed: perm == 0600,
+				Detail: fmt.Sprintf("%04o", perm),
+				Hint:   fmt.Sprintf("Run 'chmod 600 %s' so only you can read your token", configPath),
+			})
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			add(doctorCheck{Name: "Active account resolves", Critical: true, Detail: err.Error()})
+			return
+		}
+
+		account, err := cfg.GetActiveAccount()
+		detail := cfg.ActiveAccount
+		if err != nil {
+			detail = err.Error()
+		}
+		if !add(doctorCheck{
+			Name:     "Active account resolves",
+			Passed:   err == nil,
+			Critical: true,
+			Detail:   detail,
+			Hint:     "Run 'atl auth login' to add an account",
+		}) {
+			return
+		}
+
+		if !add(doctorCheck{
+			Name:     "Account has site, email, and token",
+			Passed:   account.Site != "" && account.Email != "" && account.Token != "",
+			Critical: true,
+			Hint:     "Run 'atl auth login' to re-enter your credentials",
+		}) {
+			return
+		}
+
+		client := withRequestSettings(atlassian.NewClient(account.Email, account.Token, account.Site))
+
+		user, err := client.GetCurrentUser()
+		detail = ""
+		if err != nil {
+			detail = err.Error()
+		} else {
+			detail = user.DisplayName
+		}
+		add(doctorCheck{
+			Name:     "Credentials authenticate",
+			Passed:   err == nil,
+			Critical: true,
+			Detail:   detail,
+			Hint:     "Create a new API token at https://id.atlassian.com/manage-profile/security/api-tokens and run 'atl auth login'",
+		})
+
+		add(doctorCheck{
+			Name:   "Cloud ID is set",
+			Passed: account.CloudID != "",
+			Detail: account.CloudID,
+			Hint:   "Run 'atl config migrate' to look up and save the cloud ID",
+		})
+	}()
+
+	failed := 0
+	for _, check := range checks {
+		if !check.Passed && check.Critical {
+			failed++
+		}
+	}
+
+	if outputJSON {
+		if err := printJSON(map[string]any{"healthy": failed == 0, "checks": checks}); err != nil {
+			return err
+		}
+	} else {
+		for _, check := range checks {
+			mark := "✓"
+			if !check.Passed {
+				mark = "✗"
+				if !check.Critical {
+					mark = "!"
+				}
+			}
+
+			if check.Detail != "" {
+				fmt.Printf("%s %s (%s)\n", mark, check.Name, check.Detail)
+			} else {
+				fmt.Printf("%s %s\n", mark, check.Name)
+			}
+			if !check.Passed && check.Hint != "" {
+				fmt.Printf("    → %s\n", check.Hint)
+			}
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d critical check(s) failed", failed)
+	}
+
+	return nil
 }
@@ -1,12 +1,15 @@
 package cmd
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"sort"
+	"strconv"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/doughughes/atlassian-cli/internal/atlassian"
-	"github.com/doughughes/atlassian-cli/internal/config"
 	"github.com/spf13/cobra"
 )
 
@@ -29,7 +32,15 @@ CQL is a powerful query language for finding content. Examples:
 Examples:
   atl confluence search-cql "space = TEAM"
   atl confluence search-cql "title ~ 'Team Onboarding'"
-  atl confluence search-cql "type = page AND space = TEAM" --limit 10`,
+  atl confluence search-cql "type = page AND space = TEAM" --limit 10
+  atl confluence search-cql "space = TEAM" --order-by "lastmodified desc"
+  atl confluence search-cql "space = TEAM" --all --json > team-pages.json
+
+--order-by appends an ORDER BY clause (e.g. "lastmodified desc, title")
+unless the query already has one.
+
+--all follows the next-page cursor until every result is fetched; --limit
+then sets the page size.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runConfluenceSearchCQL,
 }
@@ -46,7 +57,24 @@ Page ID: 123456789
 Examples:
   atl confluence get-page 3984293906
   atl confluence get-page 3984293906 --status draft
-  atl confluence get-page 3984293906 --json`,
+  atl confluence get-page 3984293906 --include-inline-comments
+  atl confluence get-page 3984293906 --include-inline-comments --unresolved-only
+  atl confluence get-page 3984293906 --json
+  atl confluence get-page 3984293906 --select id,title,version.number,space.key
+  atl confluence get-page 3984293906 --select id,title,version.number --flatten
+  atl confluence get-page 3984293906 --wrap 80
+  atl confluence get-page 3984293906 --expand metadata.labels --json
+  atl confluence get-page 3984293912 --type blogpost
+  atl confluence get-page 3984293906 --body-format storage > page.xml
+  atl confluence get-page 3984293906 --body-format view | pandoc -f html -t markdown
+
+Blog posts are fetched the same way as pages. --type checks the content is of
+the expected type and fails otherwise.
+
+--body-format text (the default) shows the page details with the body as
+plain text. storage prints only the raw storage format body, and view prints
+only the rendered HTML, so either can be piped into other tools. With --json
+the view body is included as body.view.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runConfluenceGetPage,
 }
@@ -71,11 +99,28 @@ var confluenceGetPagesInSpaceCmd = &cobra.Command{
 Examples:
   atl confluence get-pages-in-space POL
   atl confluence get-pages-in-space POL --title "Onboarding"
-  atl confluence get-pages-in-space POL --limit 50`,
+  atl confluence get-pages-in-space POL --limit 50
+  atl confluence get-pages-in-space POL --output table
+  atl confluence get-pages-in-space POL --output table --columns id,title,createdAt --max-col-width 30`,
 	Args: cobra.ExactArgs(1),
 	RunE: runConfluenceGetPagesInSpace,
 }
 
+var confluenceGetBlogPostsCmd = &cobra.Command{
+	Use:   "get-blogposts",
+	Short: "List blog posts in a Confluence space",
+	Long: `Retrieve blog posts within a specific Confluence space.
+
+Examples:
+  atl confluence get-blogposts --space ENG
+  atl confluence get-blogposts --space ENG --title "Release notes"
+  atl confluence get-blogposts --space ENG --limit 10 --json
+
+Create a blog post with: atl confluence create-page --type blogpost`,
+	Args: cobra.NoArgs,
+	RunE: runConfluenceGetBlogPosts,
+}
+
 var confluenceCreatePageCmd = &cobra.Command{
 	Use:   "create-page",
 	Short: "Create a new Confluence page",
@@ -83,7 +128,24 @@ var confluenceCreatePageCmd = &cobra.Command{
 
 Examples:
   atl confluence create-page --space POL --title "New Page" --body "<p>Content here</p>"
-  atl confluence create-page --space POL --title "Child Page" --body "<p>Content</p>" --parent 123456`,
+  atl confluence create-page --space POL --title "Child Page" --body "<p>Content</p>" --parent 123456
+  atl confluence create-page --space POL --title "Runbook" --body-file runbook.html
+  atl confluence create-page --space POL --title "Runbook" --body-file runbook.md --body-format markdown
+  atl confluence create-page --space POL --title "Q3 Review" --from-template 98765 --var owner="Jane Doe" --var quarter=Q3
+  atl confluence create-page --space ENG --title "Release notes" --body "<p>New things</p>" --type blogpost
+
+With --from-template the page body comes from the template instead of --body.
+Every ${name} or <at:var> placeholder in the template must be given a value
+with --var name=value. The flag is --from-template rather than --template
+because the global --template flag already formats JSON output with a Go
+template.
+
+--body-format markdown converts a GitHub-flavored markdown body to storage
+format. Blockquotes starting with [!INFO], [!NOTE], [!WARNING], [!SUCCESS], or
+[!ERROR] become info, note, warning, tip, and warning panels.
+
+--type blogpost creates a blog post instead of a page. Blog posts aren't part
+of the page tree, so --parent can't be used with them.`,
 	RunE: runConfluenceCreatePage,
 }
 
@@ -92,39 +154,293 @@ var confluenceUpdatePageCmd = &cobra.Command{
 	Short: "Update a Confluence page",
 	Long: `Update an existing Confluence page.
 
-Note: Version number handling differs by status:
-  - Published pages: Use current version + 1
-  - Draft pages: Always use version 1 (drafts don't increment)
+Only what you give is changed: leave out --title or --body (and --body-file)
+to keep the page's current title or body. Use --body-format markdown to write
+the body in markdown instead of storage format.
+
+The new version number is worked out from the page's current version, so
+--version is only needed to override it (for example, to fail on purpose if
+someone else has edited the page since you last looked). Use --minor-edit to
+save the change without notifying watchers.
+
+If the title and body (ignoring whitespace) match the current page, the update
+is skipped so no version is added and watchers aren't notified. Use --force to
+update anyway. Moves (--parent, --space) and --status changes always update.
 
 Examples:
-  atl confluence update-page 3984293906 --title "Updated Title" --body "<p>New content</p>" --version 16
-  atl confluence update-page 123456 --title "Draft" --body "<p>Content</p>" --version 1 --status draft`,
+  atl confluence update-page 3984293906 --title "Updated Title" --body "<p>New content</p>"
+  atl confluence update-page 3984293906 --title "Renamed page"
+  atl confluence update-page 3984293906 --body "<p>Fixed</p>" --minor-edit
+  atl confluence update-page 3984293906 --body-file notes.md --body-format markdown
+  atl confluence update-page 123456 --title "Draft" --body "<p>Content</p>" --status draft
+  render-page | atl confluence update-page 123456 --title "Report" --body-file - --version 8`,
 	Args: cobra.ExactArgs(1),
 	RunE: runConfluenceUpdatePage,
 }
 
+var confluenceSyncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Publish a directory of markdown files as pages",
+	Long: `Create or update one page per markdown file in a directory, converting
+markdown to Confluence storage format.
+
+Each file maps to the page with the same title in the space: the "title" in
+its front matter, or the file name without the extension. Subdirectories
+become parent pages, titled and filled from an index.md or README.md inside
+them when present (otherwise the directory name and an empty body). Top-level
+pages go under --parent, or at the top of the space.
+
+Pages whose content (ignoring whitespace) and parent already match are left
+alone, so watchers aren't notified and no version is added; --force updates
+them anyway. Hidden files and directories are skipped, and local images are
+not uploaded.
+
+Examples:
+  atl confluence sync --space DOCS --dir ./docs
+  atl confluence sync --space DOCS --dir ./docs --parent 123456
+  atl confluence sync --space DOCS --dir ./docs --dry-run`,
+	Args: cobra.NoArgs,
+	RunE: runConfluenceSync,
+}
+
 var confluenceAddCommentCmd = &cobra.Command{
-	Use:   "add-comment <pageID> <comment>",
+	Use:   "add-comment <pageID> [comment]",
 	Short: "Add a comment to a Confluence page",
 	Long: `Add a comment to an existing Confluence page.
 
+A comment given as an argument must be in HTML storage format. Use
+--comment-file to read a MARKDOWN comment from a file ("-" for stdin)
+instead; it is converted to storage format.
+
 Examples:
-  atl confluence add-comment 3984293906 "<p>This is a comment</p>"`,
-	Args: cobra.ExactArgs(2),
+  atl confluence add-comment 3984293906 "<p>This is a comment</p>"
+  atl confluence add-comment 3984293906 --comment-file review.md
+  cat review.md | atl confluence add-comment 3984293906 --comment-file -`,
+	Args: cobra.RangeArgs(1, 2),
 	RunE: runConfluenceAddComment,
 }
 
+var confluenceContentByLabelCmd = &cobra.Command{
+	Use:   "content-by-label <label>",
+	Short: "Find all content tagged with a label",
+	Long: `Find pages, blog posts, and attachments tagged with a label across all spaces.
+
+Examples:
+  atl confluence content-by-label deprecated
+  atl confluence content-by-label deprecated --type page
+  atl confluence content-by-label runbook --type page,blogpost --limit 100
+  atl confluence content-by-label deprecated --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfluenceContentByLabel,
+}
+
+var confluenceDiffVersionsCmd = &cobra.Command{
+	Use:   "diff-versions <pageID> <version1> <version2>",
+	Short: "Show a diff between two versions of a Confluence page",
+	Long: `Fetch two versions of a Confluence page, convert their bodies to text,
+and print a unified line diff.
+
+Examples:
+  atl confluence diff-versions 3984293906 4 5
+  atl confluence diff-versions 3984293906 1 7 --context 5`,
+	Args: cobra.ExactArgs(3),
+	RunE: runConfluenceDiffVersions,
+}
+
+var confluenceListTrashedCmd = &cobra.Command{
+	Use:   "list-trashed",
+	Short: "List pages in a space's trash",
+	Long: `List pages that have been deleted and are in the space's trash.
+
+Examples:
+  atl confluence list-trashed --space ENG
+  atl confluence list-trashed --space ENG --limit 100 --json`,
+	Args: cobra.NoArgs,
+	RunE: runConfluenceListTrashed,
+}
+
+var confluenceRestoreTrashedCmd = &cobra.Command{
+	Use:   "restore-trashed <pageID>",
+	Short: "Restore a page from the trash",
+	Long: `Restore a trashed page to its original location.
+
+If the page's parent has also been deleted, the page is restored to the top
+level of the space and a warning is printed.
+
+Examples:
+  atl confluence list-trashed --space ENG
+  atl confluence restore-trashed 3984293906`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfluenceRestoreTrashed,
+}
+
+var confluenceDeletePageCmd = &cobra.Command{
+	Use:   "delete-page <pageID>",
+	Short: "Move a page to the trash or delete it permanently",
+	Long: `Delete a Confluence page.
+
+Deleting a current page moves it to the space's trash, where it can be brought
+back with restore-trashed. Use --purge to permanently delete a trashed page;
+given a page that is still current, --purge trashes it first and then purges
+it. A purged page cannot be recovered.
+
+You are asked to confirm unless --yes is given, which is required when not
+running interactively. --dry-run shows what would happen without deleting
+anything.
+
+Examples:
+  atl confluence delete-page 3984293906
+  atl confluence delete-page 3984293906 --purge --yes
+  atl confluence delete-page 3984293906 --purge --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfluenceDeletePage,
+}
+
+var confluenceAddAttachmentCmd = &cobra.Command{
+	Use:   "add-attachment <pageID> <filePath>...",
+	Short: "Attach files to a Confluence page",
+	Long: `Upload one or more files as attachments to a Confluence page.
+
+Uploading a file with the same name as an existing attachment adds a new
+version of that attachment. Use --comment to describe the change and
+--minor-edit to avoid notifying page watchers.
+
+Examples:
+  atl confluence add-attachment 3984293906 diagram.png
+  atl confluence add-attachment 3984293906 report.pdf data.csv --comment "Q3 numbers"
+  atl confluence add-attachment 3984293906 diagram.png --minor-edit`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: runConfluenceAddAttachment,
+}
+
+var confluenceGetAttachmentsCmd = &cobra.Command{
+	Use:   "get-attachments <pageID>",
+	Short: "List the attachments on a Confluence page",
+	Long: `List the files attached to a Confluence page with their ID, size, media
+type, and version.
+
+Examples:
+  atl confluence get-attachments 3984293906
+  atl confluence get-attachments 3984293906 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfluenceGetAttachments,
+}
+
+var confluenceGetLabelsCmd = &cobra.Command{
+	Use:   "get-labels <pageID>",
+	Short: "List the labels on a Confluence page",
+	Long: `List the labels on a Confluence page. Personal and team labels are shown
+with their my: or team: prefix.
+
+Examples:
+  atl confluence get-labels 3984293906
+  atl confluence get-labels 3984293906 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfluenceGetLabels,
+}
+
+var confluenceAddLabelsCmd = &cobra.Command{
+	Use:   "add-labels <pageID> <label>...",
+	Short: "Add labels to a Confluence page",
+	Long: `Add one or more labels to a Confluence page. Labels the page already has
+are left alone.
+
+Labels are global by default; prefix one with my: or team: to add a personal
+or team label instead. Label names can't contain spaces.
+
+Examples:
+  atl confluence add-labels 3984293906 runbook oncall
+  atl confluence add-labels 3984293906 my:to-review`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: runConfluenceAddLabels,
+}
+
+var confluenceRemoveLabelsCmd = &cobra.Command{
+	Use:   "remove-labels <pageID> <label>...",
+	Short: "Remove labels from a Confluence page",
+	Long: `Remove one or more labels from a Confluence page.
+
+Examples:
+  atl confluence remove-labels 3984293906 draft
+  atl confluence remove-labels 3984293906 old-team deprecated`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: runConfluenceRemoveLabels,
+}
+
+var confluenceGetPageWatchersCmd = &cobra.Command{
+	Use:   "get-page-watchers <pageID>",
+	Short: "List the users watching a page",
+	Long: `List everyone watching a Confluence page for changes.
+
+Examples:
+  atl confluence get-page-watchers 123456789
+  atl confluence get-page-watchers 123456789 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfluenceGetPageWatchers,
+}
+
+var confluenceWatchPageCmd = &cobra.Command{
+	Use:   "watch-page <pageID>",
+	Short: "Watch a page for changes",
+	Long: `Start watching a Confluence page so you're notified when it changes.
+
+Use --account-id to add another user as a watcher (requires permission to
+manage their watches).
+
+Examples:
+  atl confluence watch-page 123456789
+  atl confluence watch-page 123456789 --account-id 5b10ac8d82e05b22cc7d4ef5`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfluenceWatchPage,
+}
+
+var confluenceUnwatchPageCmd = &cobra.Command{
+	Use:   "unwatch-page <pageID>",
+	Short: "Stop watching a page",
+	Long: `Stop watching a Confluence page.
+
+Examples:
+  atl confluence unwatch-page 123456789
+  atl confluence unwatch-page 123456789 --account-id 5b10ac8d82e05b22cc7d4ef5`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfluenceUnwatchPage,
+}
+
+var confluenceGetSpacePermissionsCmd = &cobra.Command{
+	Use:   "get-space-permissions <spaceKey>",
+	Short: "List who has which permissions in a space",
+	Long: `List the users, groups, and roles with permissions in a space, grouped by
+principal. Viewing space permissions requires space admin permission.
+
+Examples:
+  atl confluence get-space-permissions ENG
+  atl confluence get-space-permissions ENG --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfluenceGetSpacePermissions,
+}
+
 var (
 	// Flags for get-page
-	confluenceGetPageStatus string
+	confluenceGetPageStatus         string
+	confluenceGetPageInlineComments bool
+	confluenceGetPageUnresolvedOnly bool
+	confluenceGetPageSelect         []string
+	confluenceGetPageExpand         []string
+	confluenceGetPageFlatten        bool
+	confluenceGetPageWrap           int
+	confluenceGetPageType           string
+	confluenceGetPageBodyFormat     string
 
 	// Flags for search-cql
-	confluenceSearchLimit      int
-	confluenceSearchCursor     string
-	confluenceSearchCqlContext string
-	confluenceSearchExpand     string
-	confluenceSearchNext       bool
-	confluenceSearchPrev       bool
+	confluenceSearchLimit       int
+	confluenceSearchCursor      string
+	confluenceSearchCqlContext  string
+	confluenceSearchExpand      string
+	confluenceSearchNext        bool
+	confluenceSearchPrev        bool
+	confluenceSearchAllAccounts bool
+	confluenceSearchOrderBy     string
+	confluenceSearchAll         bool
 
 	// Flags for get-spaces
 	confluenceSpaceKeys           []string
@@ -147,28 +463,55 @@ var (
 	confluencePagesCursor   string
 	confluencePagesDepth    string
 	confluencePagesSort     string
+	confluencePagesOutput   string
+	confluencePagesColumns  []string
+	confluencePagesMaxWidth int
 	confluencePagesSubtype  string
 
+	// Flags for get-blogposts
+	confluenceBlogPostsSpace  string
+	confluenceBlogPostsTitle  string
+	confluenceBlogPostsLimit  int
+	confluenceBlogPostsCursor string
+	confluenceBlogPostsSort   string
+
 	// Flags for create-page
-	confluenceCreateSpace   string
-	confluenceCreateTitle   string
-	confluenceCreateBody    string
-	confluenceCreateParent  string
-	confluenceCreatePrivate bool
+	confluenceCreateType       string
+	confluenceCreateSpace      string
+	confluenceCreateTitle      string
+	confluenceCreateBody       string
+	confluenceCreateBodyFile   string
+	confluenceCreateBodyFormat string
+	confluenceCreateParent     string
+	confluenceCreatePrivate    bool
+	confluenceCreateTemplate   string
+	confluenceCreateVars       []string
+
+	// Flags for sync
+	confluenceSyncSpace  string
+	confluenceSyncDir    string
+	confluenceSyncParent string
+	confluenceSyncDryRun bool
+	confluenceSyncForce  bool
 
 	// Flags for update-page
-	confluenceUpdateTitle         string
-	confluenceUpdateBody          string
-	confluenceUpdateVersion       int
-	confluenceUpdateParent        string
-	confluenceUpdateSpace         string
-	confluenceUpdateStatus        string
-	confluenceUpdateVersionMsg    string
+	confluenceUpdateTitle      string
+	confluenceUpdateBody       string
+	confluenceUpdateBodyFile   string
+	confluenceUpdateBodyFormat string
+	confluenceUpdateVersion    int
+	confluenceUpdateParent     string
+	confluenceUpdateSpace      string
+	confluenceUpdateStatus     string
+	confluenceUpdateVersionMsg string
+	confluenceUpdateForce      bool
+	confluenceUpdateMinorEdit  bool
 
 	// Flags for add-comment
 	confluenceCommentParentID     string
 	confluenceCommentAttachmentID string
 	confluenceCommentCustomID     string
+	confluenceCommentFile         string
 
 	// Flags for get-page-descendants
 	confluenceDescendantsDepth int
@@ -178,6 +521,36 @@ var (
 	confluenceCommentsLimit  int
 	confluenceCommentsStart  int
 	confluenceCommentsStatus string
+	confluenceCommentsAll    bool
+
+	// Flags for diff-versions
+	confluenceDiffContext int
+
+	// Flags for watch-page and unwatch-page
+	confluenceWatchAccountID string
+
+	// Flags for add-attachment
+	confluenceAttachmentComment   string
+	confluenceAttachmentMinorEdit bool
+
+	// Flags for get-attachments
+	confluenceAttachmentsLimit int
+	confluenceAttachmentsStart int
+
+	// Flags for list-trashed
+	confluenceTrashedSpace  string
+	confluenceTrashedLimit  int
+	confluenceTrashedCursor string
+
+	// Flags for delete-page
+	confluenceDeletePurge  bool
+	confluenceDeleteYes    bool
+	confluenceDeleteDryRun bool
+
+	// Flags for content-by-label
+	confluenceLabelTypes  []string
+	confluenceLabelLimit  int
+	confluenceLabelCursor string
 
 	// Flags for create-inline-comment
 	confluenceInlineTextSelection      string
@@ -191,6 +564,7 @@ func init() {
 	confluenceCmd.AddCommand(confluenceGetPageCmd)
 	confluenceCmd.AddCommand(confluenceGetSpacesCmd)
 	confluenceCmd.AddCommand(confluenceGetPagesInSpaceCmd)
+	confluenceCmd.AddCommand(confluenceGetBlogPostsCmd)
 	confluenceCmd.AddCommand(confluenceCreatePageCmd)
 	confluenceCmd.AddCommand(confluenceUpdatePageCmd)
 	confluenceCmd.AddCommand(confluenceAddCommentCmd)
@@ -198,6 +572,21 @@ func init() {
 	confluenceCmd.AddCommand(confluenceGetPageDescendantsCmd)
 	confluenceCmd.AddCommand(confluenceGetPageCommentsCmd)
 	confluenceCmd.AddCommand(confluenceCreateInlineCommentCmd)
+	confluenceCmd.AddCommand(confluenceContentByLabelCmd)
+	confluenceCmd.AddCommand(confluenceDiffVersionsCmd)
+	confluenceCmd.AddCommand(confluenceListTrashedCmd)
+	confluenceCmd.AddCommand(confluenceRestoreTrashedCmd)
+	confluenceCmd.AddCommand(confluenceDeletePageCmd)
+	confluenceCmd.AddCommand(confluenceAddAttachmentCmd)
+	confluenceCmd.AddCommand(confluenceGetAttachmentsCmd)
+	confluenceCmd.AddCommand(confluenceGetLabelsCmd)
+	confluenceCmd.AddCommand(confluenceAddLabelsCmd)
+	confluenceCmd.AddCommand(confluenceRemoveLabelsCmd)
+	confluenceCmd.AddCommand(confluenceGetSpacePermissionsCmd)
+	confluenceCmd.AddCommand(confluenceGetPageWatchersCmd)
+	confluenceCmd.AddCommand(confluenceSyncCmd)
+	confluenceCmd.AddCommand(confluenceWatchPageCmd)
+	confluenceCmd.AddCommand(confluenceUnwatchPageCmd)
 
 	// Flags for search-cql
 	confluenceSearchCQLCmd.Flags().IntVar(&confluenceSearchLimit, "limit", 25, "Maximum number of results (max 250)")
@@ -206,11 +595,23 @@ func init() {
 	confluenceSearchCQLCmd.Flags().StringVar(&confluenceSearchExpand, "expand", "", "Properties to expand")
 	confluenceSearchCQLCmd.Flags().BoolVar(&confluenceSearchNext, "next", false, "Include next page link")
 	confluenceSearchCQLCmd.Flags().BoolVar(&confluenceSearchPrev, "prev", false, "Include previous page link")
+	confluenceSearchCQLCmd.Flags().BoolVar(&confluenceSearchAllAccounts, "all-accounts", false, "Run the query against every configured account")
+	confluenceSearchCQLCmd.Flags().BoolVar(&confluenceSearchAll, "all", false, "Fetch every page of results")
+	confluenceSearchCQLCmd.Flags().StringVar(&confluenceSearchOrderBy, "order-by", "", "Append an ORDER BY clause (e.g., \"lastmodified desc\") unless the query has one")
 	confluenceSearchCQLCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
 
 	// Flags for get-page
 	confluenceGetPageCmd.Flags().StringVar(&confluenceGetPageStatus, "status", "", "Page status (current, draft, archived, trashed)")
+	confluenceGetPageCmd.Flags().BoolVar(&confluenceGetPageInlineComments, "include-inline-comments", false, "Also show inline comments with the text they highlight")
+	confluenceGetPageCmd.Flags().BoolVar(&confluenceGetPageUnresolvedOnly, "unresolved-only", false, "With --include-inline-comments, hide resolved comments")
+	confluenceGetPageCmd.Flags().StringSliceVar(&confluenceGetPageSelect, "select", []string{}, "Output only these fields as JSON (dotted paths, e.g. version.number)")
+	confluenceGetPageCmd.Flags().StringSliceVar(&confluenceGetPageExpand, "expand", []string{}, "Properties to expand instead of the defaults (overrides the confluence-page-expand setting)")
+	confluenceGetPageCmd.Flags().BoolVar(&confluenceGetPageFlatten, "flatten", false, "Output dotted key=value lines for every non-null value")
+	confluenceGetPageCmd.Flags().IntVar(&confluenceGetPageWrap, "wrap", 0, "Wrap page text to this width (default: terminal width; 0 disables)")
+	confluenceGetPageCmd.Flags().StringVar(&confluenceGetPageType, "type", "", "Expected content type (page, blogpost)")
+	confluenceGetPageCmd.Flags().StringVar(&confluenceGetPageBodyFormat, "body-format", "text", "How to show the body (text, storage, view)")
 	confluenceGetPageCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+	confluenceGetPageCmd.MarkFlagsMutuallyExclusive("flatten", "json")
 
 	// Flags for get-spaces
 	confluenceGetSpacesCmd.Flags().StringSliceVar(&confluenceSpaceKeys, "keys", []string{}, "Filter by space keys")
@@ -235,36 +636,67 @@ func init() {
 	confluenceGetPagesInSpaceCmd.Flags().StringVar(&confluencePagesDepth, "depth", "", "Filter by depth (all, root)")
 	confluenceGetPagesInSpaceCmd.Flags().StringVar(&confluencePagesSort, "sort", "", "Sort order (id, -id, title, -title, etc)")
 	confluenceGetPagesInSpaceCmd.Flags().StringVar(&confluencePagesSubtype, "subtype", "", "Filter by subtype (live for live docs, page for regular pages)")
-	confluenceGetPagesInSpaceCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+	confluenceGetPagesInSpaceCmd.Flags().StringVar(&confluencePagesOutput, "output", "pretty", "Output format: pretty, json, or table")
+	confluenceGetPagesInSpaceCmd.Flags().StringSliceVar(&confluencePagesColumns, "columns", []string{"id", "title", "status", "parentId"}, "With --output table, the columns to show (dotted paths, e.g. version.number)")
+	confluenceGetPagesInSpaceCmd.Flags().IntVar(&confluencePagesMaxWidth, "max-col-width", defaultMaxColWidth, "With --output table, truncate longer values (0 disables)")
+	confluenceGetPagesInSpaceCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON (same as --output json)")
+
+	// Flags for get-blogposts
+	confluenceGetBlogPostsCmd.Flags().StringVar(&confluenceBlogPostsSpace, "space", "", "Space key (required)")
+	confluenceGetBlogPostsCmd.Flags().StringVar(&confluenceBlogPostsTitle, "title", "", "Filter by blog post title")
+	confluenceGetBlogPostsCmd.Flags().IntVar(&confluenceBlogPostsLimit, "limit", 25, "Maximum number of blog posts to return")
+	confluenceGetBlogPostsCmd.Flags().StringVar(&confluenceBlogPostsCursor, "cursor", "", "Pagination cursor")
+	confluenceGetBlogPostsCmd.Flags().StringVar(&confluenceBlogPostsSort, "sort", "", "Sort order (id, -id, title, -title, etc)")
+	confluenceGetBlogPostsCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+	confluenceGetBlogPostsCmd.MarkFlagRequired("space")
 
 	// Flags for create-page
 	confluenceCreatePageCmd.Flags().StringVar(&confluenceCreateSpace, "space", "", "Space key (required)")
 	confluenceCreatePageCmd.Flags().StringVar(&confluenceCreateTitle, "title", "", "Page title (required)")
-	confluenceCreatePageCmd.Flags().StringVar(&confluenceCreateBody, "body", "", "Page body in HTML storage format (required)")
+	confluenceCreatePageCmd.Flags().StringVar(&confluenceCreateBody, "body", "", "Page body in HTML storage format (required unless --body-file or --from-template is set)")
+	confluenceCreatePageCmd.Flags().StringVar(&confluenceCreateBodyFile, "body-file", "", "Read the storage format body from this file (\"-\" for stdin)")
+	confluenceCreatePageCmd.Flags().StringVar(&confluenceCreateBodyFormat, "body-format", bodyFormatStorage, "Format of --body or --body-file (storage, markdown)")
 	confluenceCreatePageCmd.Flags().StringVar(&confluenceCreateParent, "parent", "", "Parent page ID")
 	confluenceCreatePageCmd.Flags().BoolVar(&confluenceCreatePrivate, "private", false, "Create as private page")
+	confluenceCreatePageCmd.Flags().StringVar(&confluenceCreateTemplate, "from-template", "", "Create the page from this template ID")
+	confluenceCreatePageCmd.Flags().StringArrayVar(&confluenceCreateVars, "var", nil, "Template variable as name=value (repeatable)")
+	confluenceCreatePageCmd.Flags().StringVar(&confluenceCreateType, "type", atlassian.ContentTypePage, "Content type to create (page, blogpost)")
 	confluenceCreatePageCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
 	confluenceCreatePageCmd.MarkFlagRequired("space")
 	confluenceCreatePageCmd.MarkFlagRequired("title")
-	confluenceCreatePageCmd.MarkFlagRequired("body")
+	confluenceCreatePageCmd.MarkFlagsMutuallyExclusive("body", "body-file", "from-template")
+	confluenceCreatePageCmd.MarkFlagsOneRequired("body", "body-file", "from-template")
+
+	// Flags for sync
+	confluenceSyncCmd.Flags().StringVar(&confluenceSyncSpace, "space", "", "Space key (required)")
+	confluenceSyncCmd.Flags().StringVar(&confluenceSyncDir, "dir", "", "Directory of markdown files (required)")
+	confluenceSyncCmd.Flags().StringVar(&confluenceSyncParent, "parent", "", "Page ID to put top-level pages under")
+	confluenceSyncCmd.Flags().BoolVar(&confluenceSyncDryRun, "dry-run", false, "Show what would change without changing anything")
+	confluenceSyncCmd.Flags().BoolVar(&confluenceSyncForce, "force", false, "Update pages even when their content hasn't changed")
+	confluenceSyncCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+	confluenceSyncCmd.MarkFlagRequired("space")
+	confluenceSyncCmd.MarkFlagRequired("dir")
 
 	// Flags for update-page
-	confluenceUpdatePageCmd.Flags().StringVar(&confluenceUpdateTitle, "title", "", "New page title (required)")
-	confluenceUpdatePageCmd.Flags().StringVar(&confluenceUpdateBody, "body", "", "New page body in HTML storage format (required)")
-	confluenceUpdatePageCmd.Flags().IntVar(&confluenceUpdateVersion, "version", 0, "New version number (required, must be current version + 1)")
+	confluenceUpdatePageCmd.Flags().StringVar(&confluenceUpdateTitle, "title", "", "New page title (default: keep the current title)")
+	confluenceUpdatePageCmd.Flags().StringVar(&confluenceUpdateBody, "body", "", "New page body in HTML storage format (default: keep the current body)")
+	confluenceUpdatePageCmd.Flags().StringVar(&confluenceUpdateBodyFile, "body-file", "", "Read the storage format body from this file (\"-\" for stdin)")
+	confluenceUpdatePageCmd.Flags().StringVar(&confluenceUpdateBodyFormat, "body-format", bodyFormatStorage, "Format of --body or --body-file (storage, markdown)")
+	confluenceUpdatePageCmd.Flags().IntVar(&confluenceUpdateVersion, "version", 0, "New version number (default: current version + 1, or 1 for drafts)")
 	confluenceUpdatePageCmd.Flags().StringVar(&confluenceUpdateParent, "parent", "", "New parent page ID")
 	confluenceUpdatePageCmd.Flags().StringVar(&confluenceUpdateSpace, "space", "", "New space key")
 	confluenceUpdatePageCmd.Flags().StringVar(&confluenceUpdateStatus, "status", "", "Page status (current, draft)")
 	confluenceUpdatePageCmd.Flags().StringVar(&confluenceUpdateVersionMsg, "version-message", "", "Version message describing changes")
+	confluenceUpdatePageCmd.Flags().BoolVar(&confluenceUpdateForce, "force", false, "Update even when the content hasn't changed")
+	confluenceUpdatePageCmd.Flags().BoolVar(&confluenceUpdateMinorEdit, "minor-edit", false, "Don't notify watchers about this change")
 	confluenceUpdatePageCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
-	confluenceUpdatePageCmd.MarkFlagRequired("title")
-	confluenceUpdatePageCmd.MarkFlagRequired("body")
-	confluenceUpdatePageCmd.MarkFlagRequired("version")
+	confluenceUpdatePageCmd.MarkFlagsMutuallyExclusive("body", "body-file")
 
 	// Flags for add-comment
 	confluenceAddCommentCmd.Flags().StringVar(&confluenceCommentParentID, "parent-comment-id", "", "Parent comment ID for replies")
 	confluenceAddCommentCmd.Flags().StringVar(&confluenceCommentAttachmentID, "attachment-id", "", "Attachment ID to add to comment")
 	confluenceAddCommentCmd.Flags().StringVar(&confluenceCommentCustomID, "custom-content-id", "", "Custom content ID to add to comment")
+	confluenceAddCommentCmd.Flags().StringVar(&confluenceCommentFile, "comment-file", "", "Read a markdown comment from this file (\"-\" for stdin)")
 	confluenceAddCommentCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
 
 	// Flags for get-page-ancestors
@@ -279,6 +711,7 @@ func init() {
 	confluenceGetPageCommentsCmd.Flags().IntVar(&confluenceCommentsLimit, "limit", 25, "Maximum number of comments")
 	confluenceGetPageCommentsCmd.Flags().IntVar(&confluenceCommentsStart, "start", 0, "Starting index for pagination")
 	confluenceGetPageCommentsCmd.Flags().StringVar(&confluenceCommentsStatus, "status", "", "Filter by status")
+	confluenceGetPageCommentsCmd.Flags().BoolVar(&confluenceCommentsAll, "all", false, "Fetch all comments, following pagination")
 	confluenceGetPageCommentsCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
 
 	// Flags for create-inline-comment
@@ -287,6 +720,61 @@ func init() {
 	confluenceCreateInlineCommentCmd.Flags().IntVar(&confluenceInlineMatchCount, "match-count", 1, "Total number of matches")
 	confluenceCreateInlineCommentCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
 	confluenceCreateInlineCommentCmd.MarkFlagRequired("text-selection")
+
+	// Flags for content-by-label
+	confluenceContentByLabelCmd.Flags().StringSliceVar(&confluenceLabelTypes, "type", []string{}, "Filter by content type (page, blogpost, attachment)")
+	confluenceContentByLabelCmd.Flags().IntVar(&confluenceLabelLimit, "limit", 25, "Maximum number of results (max 250)")
+	confluenceContentByLabelCmd.Flags().StringVar(&confluenceLabelCursor, "cursor", "", "Pagination cursor")
+	confluenceContentByLabelCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for diff-versions
+	confluenceDiffVersionsCmd.Flags().IntVar(&confluenceDiffContext, "context", 3, "Number of unchanged lines to show around each change")
+
+	// Flags for list-trashed
+	confluenceListTrashedCmd.Flags().StringVar(&confluenceTrashedSpace, "space", "", "Space key (required)")
+	confluenceListTrashedCmd.Flags().IntVar(&confluenceTrashedLimit, "limit", 25, "Maximum number of pages")
+	confluenceListTrashedCmd.Flags().StringVar(&confluenceTrashedCursor, "cursor", "", "Pagination cursor")
+	confluenceListTrashedCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+	confluenceListTrashedCmd.MarkFlagRequired("space")
+
+	// Flags for restore-trashed
+	confluenceRestoreTrashedCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for delete-page
+	confluenceDeletePageCmd.Flags().BoolVar(&confluenceDeletePurge, "purge", false, "Permanently delete the page instead of moving it to the trash")
+	confluenceDeletePageCmd.Flags().BoolVarP(&confluenceDeleteYes, "yes", "y", false, "Delete without asking for confirmation")
+	confluenceDeletePageCmd.Flags().BoolVar(&confluenceDeleteDryRun, "dry-run", false, "Show what would be deleted without deleting it")
+	confluenceDeletePageCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+	confluenceDeletePageCmd.MarkFlagsMutuallyExclusive("yes", "dry-run")
+
+	// Flags for get-space-permissions
+	confluenceGetSpacePermissionsCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for add-attachment
+	confluenceAddAttachmentCmd.Flags().StringVar(&confluenceAttachmentComment, "comment", "", "Comment describing the upload")
+	confluenceAddAttachmentCmd.Flags().BoolVar(&confluenceAttachmentMinorEdit, "minor-edit", false, "Don't notify page watchers")
+	confluenceAddAttachmentCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for get-attachments
+	confluenceGetAttachmentsCmd.Flags().IntVar(&confluenceAttachmentsLimit, "limit", 50, "Maximum number of attachments")
+	confluenceGetAttachmentsCmd.Flags().IntVar(&confluenceAttachmentsStart, "start", 0, "Starting index for pagination")
+	confluenceGetAttachmentsCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for get-labels, add-labels, and remove-labels
+	confluenceGetLabelsCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+	confluenceAddLabelsCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+	confluenceRemoveLabelsCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for get-page-watchers
+	confluenceGetPageWatchersCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for watch-page
+	confluenceWatchPageCmd.Flags().StringVar(&confluenceWatchAccountID, "account-id", "", "Account ID of the user to add (defaults to you)")
+	confluenceWatchPageCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for unwatch-page
+	confluenceUnwatchPageCmd.Flags().StringVar(&confluenceWatchAccountID, "account-id", "", "Account ID of the user to remove (defaults to you)")
+	confluenceUnwatchPageCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
 }
 
 func runConfluenceSearchCQL(cmd *cobra.Command, args []string) error {
@@ -297,20 +785,18 @@ func runConfluenceSearchCQL(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("limit cannot exceed 250")
 	}
 
-	// Load config and get active account
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
-	}
-
-	account, err := cfg.GetActiveAccount()
-	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+	// Append ORDER BY clause if requested (user-supplied ORDER BY wins)
+	if confluenceSearchOrderBy != "" {
+		var err error
+		cql, err = atlassian.ApplyCQLOrderBy(cql, confluenceSearchOrderBy, false)
+		if err != nil {
+			return err
+		}
+		if !outputJSON {
+			fmt.Printf("CQL: %s\n\n", cql)
+		}
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
 	// Build request options
 	opts := &atlassian.SearchCQLOptions{
 		Limit:      confluenceSearchLimit,
@@ -321,8 +807,42 @@ func runConfluenceSearchCQL(cmd *cobra.Command, args []string) error {
 		Prev:       confluenceSearchPrev,
 	}
 
+	if confluenceSearchAllAccounts {
+		if confluenceSearchCursor != "" {
+			return fmt.Errorf("--cursor cannot be used with --all-accounts")
+		}
+
+		results, err := fanOutAccounts(func(client *atlassian.Client) (map[string]any, error) {
+			if confluenceSearchAll {
+				return client.SearchAllConfluenceCQL(cql, opts)
+			}
+			return client.SearchConfluenceCQL(cql, opts)
+		})
+		if err != nil {
+			return err
+		}
+
+		if outputJSON {
+			if err := printJSON(results); err != nil {
+				return err
+			}
+		} else {
+			printConfluenceSearchResultsByAccount(results)
+		}
+		return reportAccountErrors(results)
+	}
+
+	client, account, err := newClient()
+	if err != nil {
+		return err
+	}
+
 	// Search content
-	result, err := client.SearchConfluenceCQL(cql, opts)
+	search := client.SearchConfluenceCQL
+	if confluenceSearchAll {
+		search = client.SearchAllConfluenceCQL
+	}
+	result, err := search(cql, opts)
 	if err != nil {
 		return fmt.Errorf("failed to search content: %w", err)
 	}
@@ -330,11 +850,9 @@ func runConfluenceSearchCQL(cmd *cobra.Command, args []string) error {
 	// Output
 	if outputJSON {
 		// JSON output
-		output, err := json.MarshalIndent(result, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(result); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
 	} else {
 		// Pretty output (default)
 		printConfluenceSearchResults(result, account.Site)
@@ -343,26 +861,74 @@ func runConfluenceSearchCQL(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// printConfluenceSearchResultsByAccount prints merged search results from
+// several accounts as a table with an Account column
+func printConfluenceSearchResultsByAccount(results []accountResult) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ACCOUNT\tID\tTYPE\tSPACE\tTITLE")
+
+	total := 0
+	for _, r := range results {
+		items, _ := r.Result["results"].([]any)
+		for _, item := range items {
+			content, _ := item.(map[string]any)
+			id, _ := content["id"].(string)
+			title, _ := content["title"].(string)
+			contentType, _ := content["type"].(string)
+			space, _ := content["space"].(map[string]any)
+			spaceKey, _ := space["key"].(string)
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.Account, id, contentType, spaceKey, title)
+			total++
+		}
+	}
+
+	if total == 0 {
+		fmt.Println("No content found.")
+		return
+	}
+
+	w.Flush()
+	fmt.Printf("\nShowing %d result(s) across %d account(s)\n", total, len(results))
+}
+
 func runConfluenceGetPage(cmd *cobra.Command, args []string) error {
 	pageID := args[0]
 
-	// Load config and get active account
-	cfg, err := config.Load()
+	if confluenceGetPageType != "" {
+		if err := validateContentType(confluenceGetPageType); err != nil {
+			return err
+		}
+	}
+
+	switch confluenceGetPageBodyFormat {
+	case "text", "storage", "view":
+	default:
+		return fmt.Errorf("invalid --body-format %q: must be text, storage, or view", confluenceGetPageBodyFormat)
+	}
+
+	client, account, err := newClient()
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return err
 	}
 
-	account, err := cfg.GetActiveAccount()
+	expand, err := resolvePageExpand(confluenceGetPageExpand)
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
+	confluenceGetPageWrap, err = resolveWrapWidth(cmd, confluenceGetPageWrap)
+	if err != nil {
+		return err
+	}
 
 	// Get page
 	opts := &atlassian.GetPageOptions{
 		Status: confluenceGetPageStatus,
+		Expand: expand,
+	}
+	if confluenceGetPageBodyFormat == "view" {
+		opts.BodyFormat = "view"
 	}
 
 	page, err := client.GetConfluencePage(pageID, opts)
@@ -370,32 +936,138 @@ func runConfluenceGetPage(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get page: %w", err)
 	}
 
+	if contentType, _ := page["type"].(string); confluenceGetPageType != "" && contentType != confluenceGetPageType {
+		return fmt.Errorf("content %s is a %s, not a %s", pageID, contentType, confluenceGetPageType)
+	}
+
+	var inlineComments []any
+	if confluenceGetPageInlineComments {
+		inlineComments, err = getInlineComments(client, pageID, confluenceGetPageUnresolvedOnly)
+		if err != nil {
+			return err
+		}
+		page["inlineComments"] = inlineComments
+	} else if confluenceGetPageUnresolvedOnly {
+		return fmt.Errorf("--unresolved-only requires --include-inline-comments")
+	}
+
 	// Output
-	if outputJSON {
+	if confluenceGetPageFlatten {
+		var output any = page
+		if len(confluenceGetPageSelect) > 0 {
+			output = selectFields(page, confluenceGetPageSelect)
+		}
+		if err := printFlattened(output); err != nil {
+			return err
+		}
+	} else if len(confluenceGetPageSelect) > 0 {
+		if err := printJSON(selectFields(page, confluenceGetPageSelect)); err != nil {
+			return err
+		}
+	} else if outputJSON {
 		// JSON output
-		output, err := json.MarshalIndent(page, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(page); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
+	} else if confluenceGetPageBodyFormat != "text" {
+		// Raw body only, for piping into other tools
+		value, _ := lookupPath(page, "body."+confluenceGetPageBodyFormat+".value")
+		body, _ := value.(string)
+		fmt.Println(body)
 	} else {
 		// Pretty output (default)
 		printConfluencePagePretty(page, account.Site)
+		if confluenceGetPageInlineComments {
+			printInlineComments(inlineComments)
+		}
 	}
 
 	return nil
 }
 
+// getInlineComments fetches all inline comments on a page, optionally
+// dropping resolved ones
+func getInlineComments(client *atlassian.Client, pageID string, unresolvedOnly bool) ([]any, error) {
+	result, err := client.GetAllPageComments(pageID, &atlassian.GetPageCommentsOptions{
+		Limit:    100,
+		Location: "inline",
+		Expand:   "body.storage,version,extensions.inlineProperties,extensions.resolution",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inline comments: %w", err)
+	}
+
+	comments, _ := result["results"].([]any)
+	if !unresolvedOnly {
+		return comments, nil
+	}
+
+	unresolved := []any{}
+	for _, item := range comments {
+		if inlineCommentStatus(item) != "resolved" {
+			unresolved = append(unresolved, item)
+		}
+	}
+	return unresolved, nil
+}
+
+// inlineCommentStatus returns an inline comment's resolution status
+// (open, reopened, resolved, or dangling)
+func inlineCommentStatus(comment any) string {
+	c, _ := comment.(map[string]any)
+	extensions, _ := c["extensions"].(map[string]any)
+	resolution, _ := extensions["resolution"].(map[string]any)
+	status, _ := resolution["status"].(string)
+	return status
+}
+
+func printInlineComments(comments []any) {
+	fmt.Printf("\nInline Comments (%d):\n", len(comments))
+	if len(comments) == 0 {
+		fmt.Printf("  (none)\n")
+		return
+	}
+
+	for _, item := range comments {
+		comment, _ := item.(map[string]any)
+		id, _ := comment["id"].(string)
+
+		extensions, _ := comment["extensions"].(map[string]any)
+		inlineProperties, _ := extensions["inlineProperties"].(map[string]any)
+		selection, _ := inlineProperties["originalSelection"].(string)
+
+		version, _ := comment["version"].(map[string]any)
+		by, _ := version["by"].(map[string]any)
+		author, _ := by["displayName"].(string)
+
+		fmt.Printf("\n  [%s] %s", id, author)
+		if status := inlineCommentStatus(comment); status != "" {
+			fmt.Printf(" (%s)", status)
+		}
+		fmt.Println()
+
+		if selection != "" {
+			fmt.Printf("    On: \"%s\"\n", selection)
+		}
+
+		body, _ := comment["body"].(map[string]any)
+		storage, _ := body["storage"].(map[string]any)
+		value, _ := storage["value"].(string)
+		for _, line := range strings.Split(strings.TrimSpace(atlassian.HTMLToText(value)), "\n") {
+			fmt.Printf("    %s\n", line)
+		}
+	}
+}
+
 func printConfluenceSearchResults(result map[string]any, site string) {
 	results, _ := result["results"].([]any)
-	size, _ := result["size"].(float64)
 
 	if len(results) == 0 {
 		fmt.Println("No content found.")
 		return
 	}
 
-	fmt.Printf("Found %d result(s)\n\n", int(size))
+	fmt.Printf("Found %d result(s)\n\n", len(results))
 
 	for i, item := range results {
 		if content, ok := item.(map[string]any); ok {
@@ -480,11 +1152,7 @@ func printConfluencePagePretty(page map[string]any, site string) {
 				// Convert HTML to readable text
 				contentText := atlassian.HTMLToText(value)
 				if contentText != "" {
-					// Indent content
-					lines := strings.Split(contentText, "\n")
-					for _, line := range lines {
-						fmt.Printf("  %s\n", line)
-					}
+					printIndentedText(contentText, confluenceGetPageWrap)
 				} else {
 					fmt.Printf("  (empty)\n")
 				}
@@ -497,20 +1165,11 @@ func printConfluencePagePretty(page map[string]any, site string) {
 }
 
 func runConfluenceGetSpaces(cmd *cobra.Command, args []string) error {
-	// Load config and get active account
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
-	}
-
-	account, err := cfg.GetActiveAccount()
+	client, account, err := newClient()
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
 	// Get spaces
 	opts := &atlassian.GetSpacesOptions{
 		Keys:              confluenceSpaceKeys,
@@ -533,11 +1192,9 @@ func runConfluenceGetSpaces(cmd *cobra.Command, args []string) error {
 	}
 
 	if outputJSON {
-		output, err := json.MarshalIndent(result, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(result); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
 	} else {
 		printSpacesList(result, account.Site)
 	}
@@ -548,20 +1205,15 @@ func runConfluenceGetSpaces(cmd *cobra.Command, args []string) error {
 func runConfluenceGetPagesInSpace(cmd *cobra.Command, args []string) error {
 	spaceKey := args[0]
 
-	// Load config and get active account
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+	if err := resolveTableOutput(confluencePagesOutput, confluencePagesMaxWidth); err != nil {
+		return err
 	}
 
-	account, err := cfg.GetActiveAccount()
+	client, account, err := newClient()
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
 	// Get pages
 	opts := &atlassian.GetPagesInSpaceOptions{
 		SpaceKey: spaceKey,
@@ -580,11 +1232,18 @@ func runConfluenceGetPagesInSpace(cmd *cobra.Command, args []string) error {
 	}
 
 	if outputJSON {
-		output, err := json.MarshalIndent(result, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(result); err != nil {
+			return err
+		}
+	} else if confluencePagesOutput == "table" {
+		results, _ := result["results"].([]any)
+		rows := make([][]string, 0, len(results))
+		for _, item := range results {
+			if page, ok := item.(map[string]any); ok {
+				rows = append(rows, tableRow(page, confluencePagesColumns))
+			}
 		}
-		fmt.Println(string(output))
+		printTable(tableHeaders(confluencePagesColumns), rows, confluencePagesMaxWidth)
 	} else {
 		printPagesList(result, account.Site)
 	}
@@ -592,28 +1251,135 @@ func runConfluenceGetPagesInSpace(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runConfluenceCreatePage(cmd *cobra.Command, args []string) error {
-	// Load config and get active account
-	cfg, err := config.Load()
+func runConfluenceGetBlogPosts(cmd *cobra.Command, args []string) error {
+	client, account, err := newClient()
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return err
+	}
+
+	result, err := client.GetPagesInSpace(&atlassian.GetPagesInSpaceOptions{
+		SpaceKey: confluenceBlogPostsSpace,
+		Type:     atlassian.ContentTypeBlogPost,
+		Title:    confluenceBlogPostsTitle,
+		Limit:    confluenceBlogPostsLimit,
+		Cursor:   confluenceBlogPostsCursor,
+		Sort:     confluenceBlogPostsSort,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get blog posts: %w", err)
+	}
+
+	if outputJSON {
+		return printJSON(result)
+	}
+
+	results, _ := result["results"].([]any)
+	if len(results) == 0 {
+		fmt.Println("No blog posts found.")
+		return nil
+	}
+
+	size, _ := result["size"].(float64)
+	fmt.Printf("Found %d blog post(s)\n\n", int(size))
+	printContentItems(results, account.Site)
+
+	fmt.Printf("\nTo view a blog post: atl confluence get-page <id>\n")
+	fmt.Printf("For JSON output: atl confluence get-blogposts --space %s --json\n", confluenceBlogPostsSpace)
+	return nil
+}
+
+// validateContentType checks a --type value is a content type the CLI
+// supports
+func validateContentType(contentType string) error {
+	switch contentType {
+	case atlassian.ContentTypePage, atlassian.ContentTypeBlogPost:
+		return nil
+	}
+	return fmt.Errorf("invalid --type %q: must be page or blogpost", contentType)
+}
+
+// Body formats accepted by --body-format
+const (
+	bodyFormatStorage  = "storage"
+	bodyFormatMarkdown = "markdown"
+)
+
+// convertPageBody returns body as storage format, converting it from
+// markdown when format is markdown
+func convertPageBody(body, format string) (string, error) {
+	switch format {
+	case bodyFormatStorage:
+		return body, nil
+	case bodyFormatMarkdown:
+		storage, err := atlassian.MarkdownToStorage(body)
+		if err != nil {
+			return "", fmt.Errorf("failed to convert markdown body: %w", err)
+		}
+		return storage, nil
+	}
+	return "", fmt.Errorf("invalid --body-format %q: must be storage or markdown", format)
+}
+
+func runConfluenceCreatePage(cmd *cobra.Command, args []string) error {
+	if err := validateContentType(confluenceCreateType); err != nil {
+		return err
+	}
+	if confluenceCreateTemplate != "" && cmd.Flags().Changed("body-format") {
+		return fmt.Errorf("--body-format can't be used with --from-template")
+	}
+	if confluenceCreateType == atlassian.ContentTypeBlogPost && confluenceCreateParent != "" {
+		return fmt.Errorf("--parent can't be used with --type blogpost")
+	}
+
+	if len(confluenceCreateVars) > 0 && confluenceCreateTemplate == "" {
+		return fmt.Errorf("--var can only be used with --from-template")
+	}
+
+	vars := map[string]string{}
+	for _, v := range confluenceCreateVars {
+		name, value, ok := strings.Cut(v, "=")
+		if !ok || strings.TrimSpace(name) == "" {
+			return fmt.Errorf("invalid --var %q: expected name=value", v)
+		}
+		vars[strings.TrimSpace(name)] = value
+	}
+
+	if confluenceCreateBodyFile != "" {
+		body, err := readInputFile(confluenceCreateBodyFile, "body")
+		if err != nil {
+			return err
+		}
+		confluenceCreateBody = body
+	}
+	if confluenceCreateTemplate == "" {
+		body, err := convertPageBody(confluenceCreateBody, confluenceCreateBodyFormat)
+		if err != nil {
+			return err
+		}
+		confluenceCreateBody = body
 	}
 
-	account, err := cfg.GetActiveAccount()
+	client, account, err := newClient()
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
+	body := confluenceCreateBody
+	if confluenceCreateTemplate != "" {
+		body, err = renderPageTemplate(client, confluenceCreateTemplate, vars)
+		if err != nil {
+			return err
+		}
+	}
 
 	// Create page
 	opts := &atlassian.CreatePageOptions{
 		SpaceKey:  confluenceCreateSpace,
 		Title:     confluenceCreateTitle,
-		Body:      confluenceCreateBody,
+		Body:      body,
 		ParentID:  confluenceCreateParent,
 		IsPrivate: confluenceCreatePrivate,
+		Type:      confluenceCreateType,
 	}
 
 	result, err := client.CreateConfluencePage(opts)
@@ -622,11 +1388,9 @@ func runConfluenceCreatePage(cmd *cobra.Command, args []string) error {
 	}
 
 	if outputJSON {
-		output, err := json.MarshalIndent(result, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(result); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
 	} else {
 		id, _ := result["id"].(string)
 		title, _ := result["title"].(string)
@@ -642,7 +1406,11 @@ func runConfluenceCreatePage(cmd *cobra.Command, args []string) error {
 			}
 		}
 
-		fmt.Printf("✓ Created page: %s (ID: %s)\n", title, id)
+		noun := "page"
+		if confluenceCreateType == atlassian.ContentTypeBlogPost {
+			noun = "blog post"
+		}
+		fmt.Printf("✓ Created %s: %s (ID: %s)\n", noun, title, id)
 		if webURL != "" {
 			fmt.Printf("  Link: %s\n", webURL)
 		}
@@ -655,21 +1423,35 @@ func runConfluenceCreatePage(cmd *cobra.Command, args []string) error {
 func runConfluenceUpdatePage(cmd *cobra.Command, args []string) error {
 	pageID := args[0]
 
-	// Load config and get active account
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+	if confluenceUpdateBodyFile != "" {
+		body, err := readInputFile(confluenceUpdateBodyFile, "body")
+		if err != nil {
+			return err
+		}
+		confluenceUpdateBody = body
+	}
+	if confluenceUpdateBody != "" || cmd.Flags().Changed("body-format") {
+		body, err := convertPageBody(confluenceUpdateBody, confluenceUpdateBodyFormat)
+		if err != nil {
+			return err
+		}
+		confluenceUpdateBody = body
 	}
 
-	account, err := cfg.GetActiveAccount()
-	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+	if cmd.Flags().Changed("version") && confluenceUpdateVersion < 1 {
+		return fmt.Errorf("--version must be at least 1")
+	}
+
+	moving := confluenceUpdateParent != "" || confluenceUpdateSpace != "" || confluenceUpdateStatus != ""
+	if confluenceUpdateTitle == "" && confluenceUpdateBody == "" && !moving {
+		return fmt.Errorf("nothing to update (give --title, --body, --body-file, --parent, --space, or --status)")
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
 
-	// Update page
 	opts := &atlassian.UpdatePageOptions{
 		PageID:         pageID,
 		Title:          confluenceUpdateTitle,
@@ -679,19 +1461,58 @@ func runConfluenceUpdatePage(cmd *cobra.Command, args []string) error {
 		SpaceKey:       confluenceUpdateSpace,
 		Status:         confluenceUpdateStatus,
 		VersionMessage: confluenceUpdateVersionMsg,
+		MinorEdit:      confluenceUpdateMinorEdit,
 	}
 
-	result, err := client.UpdateConfluencePage(opts)
-	if err != nil {
+	// The current page supplies the title, body, and version number when they
+	// aren't given, and is used to spot no-op updates
+	checkUnchanged := !confluenceUpdateForce && !moving
+	if checkUnchanged || opts.Title == "" || opts.Body == "" || opts.Version == 0 {
+		expand, err := resolvePageExpand(nil, "body.storage", "version")
+		if err != nil {
+			return err
+		}
+		current, err := client.GetConfluencePage(pageID, &atlassian.GetPageOptions{Status: "any", Expand: expand})
+		if err != nil {
+			return fmt.Errorf("failed to get page: %w", err)
+		}
+
+		if opts.Version == 0 && opts.Status == "draft" {
+			opts.Version = 1
+		}
+		opts.FillFromPage(current)
+
+		// Skip no-op updates so the page doesn't gain a version or notify watchers
+		title, _ := current["title"].(string)
+		storage, _ := lookupPath(current, "body.storage.value")
+		currentBody, _ := storage.(string)
+		if checkUnchanged && title == opts.Title && atlassian.StorageBodiesEqual(currentBody, opts.Body) {
+			versionNumber, _ := lookupPath(current, "version.number")
+			currentVersion, _ := versionNumber.(float64)
+
+			if outputJSON {
+				return printJSON(map[string]any{
+					"id":        pageID,
+					"title":     title,
+					"version":   int(currentVersion),
+					"unchanged": true,
+				})
+			}
+			fmt.Printf("Page unchanged: %s (version %d)\n", title, int(currentVersion))
+			fmt.Printf("Use --force to update anyway.\n")
+			return nil
+		}
+	}
+
+	result, err := client.UpdateConfluencePage(opts)
+	if err != nil {
 		return fmt.Errorf("failed to update page: %w", err)
 	}
 
 	if outputJSON {
-		output, err := json.MarshalIndent(result, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(result); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
 	} else {
 		title, _ := result["title"].(string)
 		version, _ := result["version"].(map[string]any)
@@ -707,22 +1528,23 @@ func runConfluenceUpdatePage(cmd *cobra.Command, args []string) error {
 
 func runConfluenceAddComment(cmd *cobra.Command, args []string) error {
 	pageID := args[0]
-	comment := args[1]
 
-	// Load config and get active account
-	cfg, err := config.Load()
+	comment, fromFile, err := resolveCommentText(args[1:], confluenceCommentFile)
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return err
+	}
+	if fromFile {
+		comment, err = atlassian.MarkdownToStorage(comment)
+		if err != nil {
+			return fmt.Errorf("failed to convert comment to storage format: %w", err)
+		}
 	}
 
-	account, err := cfg.GetActiveAccount()
+	client, _, err := newClient()
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
 	// Add comment
 	opts := &atlassian.AddPageCommentOptions{
 		PageID:          pageID,
@@ -738,11 +1560,9 @@ func runConfluenceAddComment(cmd *cobra.Command, args []string) error {
 	}
 
 	if outputJSON {
-		output, err := json.MarshalIndent(result, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(result); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
 	} else {
 		id, _ := result["id"].(string)
 		fmt.Printf("✓ Added comment to page %s\n", pageID)
@@ -802,7 +1622,15 @@ func printPagesList(result map[string]any, site string) {
 	}
 
 	fmt.Printf("Found %d page(s)\n\n", int(size))
+	printContentItems(results, site)
 
+	fmt.Printf("\nTo view a page: atl confluence get-page <page-id>\n")
+	fmt.Printf("For JSON output: atl confluence get-pages-in-space <space> --json\n")
+}
+
+// printContentItems prints a numbered list of pages or blog posts with
+// their IDs and links
+func printContentItems(results []any, site string) {
 	for i, item := range results {
 		if page, ok := item.(map[string]any); ok {
 			id, _ := page["id"].(string)
@@ -826,9 +1654,6 @@ func printPagesList(result map[string]any, site string) {
 			fmt.Println()
 		}
 	}
-
-	fmt.Printf("\nTo view a page: atl confluence get-page <page-id>\n")
-	fmt.Printf("For JSON output: atl confluence get-pages-in-space <space> --json\n")
 }
 
 var confluenceGetPageAncestorsCmd = &cobra.Command{
@@ -861,7 +1686,8 @@ var confluenceGetPageCommentsCmd = &cobra.Command{
 
 Examples:
   atl confluence get-page-comments 3984293906
-  atl confluence get-page-comments 3984293906 --limit 50`,
+  atl confluence get-page-comments 3984293906 --limit 50
+  atl confluence get-page-comments 3984293906 --all`,
 	Args: cobra.ExactArgs(1),
 	RunE: runConfluenceGetPageComments,
 }
@@ -883,29 +1709,20 @@ Examples:
 func runConfluenceGetPageAncestors(cmd *cobra.Command, args []string) error {
 	pageID := args[0]
 
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
-	}
-
-	account, err := cfg.GetActiveAccount()
+	client, _, err := newClient()
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
 	}
 
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
 	ancestors, err := client.GetPageAncestors(pageID)
 	if err != nil {
 		return fmt.Errorf("failed to get ancestors: %w", err)
 	}
 
 	if outputJSON {
-		output, err := json.MarshalIndent(ancestors, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(ancestors); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
 	} else {
 		if len(ancestors) == 0 {
 			fmt.Println("No ancestors (this is a root page)")
@@ -927,18 +1744,11 @@ func runConfluenceGetPageAncestors(cmd *cobra.Command, args []string) error {
 func runConfluenceGetPageDescendants(cmd *cobra.Command, args []string) error {
 	pageID := args[0]
 
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
-	}
-
-	account, err := cfg.GetActiveAccount()
+	client, _, err := newClient()
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
 	}
 
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
 	opts := &atlassian.GetPageDescendantsOptions{
 		Depth: confluenceDescendantsDepth,
 		Limit: confluenceDescendantsLimit,
@@ -950,11 +1760,9 @@ func runConfluenceGetPageDescendants(cmd *cobra.Command, args []string) error {
 	}
 
 	if outputJSON {
-		output, err := json.MarshalIndent(result, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(result); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
 	} else {
 		results, _ := result["results"].([]any)
 
@@ -980,35 +1788,31 @@ func runConfluenceGetPageDescendants(cmd *cobra.Command, args []string) error {
 func runConfluenceGetPageComments(cmd *cobra.Command, args []string) error {
 	pageID := args[0]
 
-	cfg, err := config.Load()
+	client, _, err := newClient()
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return err
 	}
 
-	account, err := cfg.GetActiveAccount()
-	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
-	}
-
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
 	opts := &atlassian.GetPageCommentsOptions{
 		Limit:  confluenceCommentsLimit,
 		Start:  confluenceCommentsStart,
 		Status: confluenceCommentsStatus,
 	}
 
-	result, err := client.GetPageComments(pageID, opts)
+	var result map[string]any
+	if confluenceCommentsAll {
+		result, err = client.GetAllPageComments(pageID, opts)
+	} else {
+		result, err = client.GetPageComments(pageID, opts)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to get comments: %w", err)
 	}
 
 	if outputJSON {
-		output, err := json.MarshalIndent(result, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(result); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
 	} else {
 		results, _ := result["results"].([]any)
 
@@ -1017,7 +1821,11 @@ func runConfluenceGetPageComments(cmd *cobra.Command, args []string) error {
 			return nil
 		}
 
-		fmt.Printf("Comments on page %s:\n\n", pageID)
+		if confluenceCommentsAll {
+			fmt.Printf("Comments on page %s (%d total):\n\n", pageID, len(results))
+		} else {
+			fmt.Printf("Comments on page %s (showing %d):\n\n", pageID, len(results))
+		}
 
 		for i, item := range results {
 			if comment, ok := item.(map[string]any); ok {
@@ -1047,6 +1855,11 @@ func runConfluenceGetPageComments(cmd *cobra.Command, args []string) error {
 				fmt.Println()
 			}
 		}
+
+		links, _ := result["_links"].(map[string]any)
+		if next, _ := links["next"].(string); next != "" && !confluenceCommentsAll {
+			fmt.Println("More comments available. Use --all to fetch every comment.")
+		}
 	}
 
 	return nil
@@ -1056,18 +1869,11 @@ func runConfluenceCreateInlineComment(cmd *cobra.Command, args []string) error {
 	pageID := args[0]
 	comment := args[1]
 
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
-	}
-
-	account, err := cfg.GetActiveAccount()
+	client, _, err := newClient()
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
 	}
 
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
 	opts := &atlassian.CreateInlineCommentOptions{
 		PageID:                  pageID,
 		Comment:                 comment,
@@ -1082,11 +1888,9 @@ func runConfluenceCreateInlineComment(cmd *cobra.Command, args []string) error {
 	}
 
 	if outputJSON {
-		output, err := json.MarshalIndent(result, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(result); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
 	} else {
 		id, _ := result["id"].(string)
 		fmt.Printf("✓ Created inline comment on page %s\n", pageID)
@@ -1096,3 +1900,949 @@ func runConfluenceCreateInlineComment(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func runConfluenceContentByLabel(cmd *cobra.Command, args []string) error {
+	label := args[0]
+
+	if confluenceLabelLimit > 250 {
+		return fmt.Errorf("limit cannot exceed 250")
+	}
+
+	for _, t := range confluenceLabelTypes {
+		if t != "page" && t != "blogpost" && t != "attachment" {
+			return fmt.Errorf("invalid --type '%s'. Valid types: page, blogpost, attachment", t)
+		}
+	}
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	opts := &atlassian.GetContentByLabelOptions{
+		Types:  confluenceLabelTypes,
+		Limit:  confluenceLabelLimit,
+		Cursor: confluenceLabelCursor,
+	}
+
+	result, err := client.GetContentByLabel(label, opts)
+	if err != nil {
+		return fmt.Errorf("failed to get content by label: %w", err)
+	}
+
+	if outputJSON {
+		if err := printJSON(result); err != nil {
+			return err
+		}
+	} else {
+		results, _ := result["results"].([]any)
+
+		if len(results) == 0 {
+			fmt.Printf("No content found with label '%s'\n", label)
+			return nil
+		}
+
+		fmt.Printf("Content labeled '%s':\n\n", label)
+
+		for i, item := range results {
+			if content, ok := item.(map[string]any); ok {
+				id, _ := content["id"].(string)
+				title, _ := content["title"].(string)
+				contentType, _ := content["type"].(string)
+
+				space, _ := content["space"].(map[string]any)
+				spaceKey, _ := space["key"].(string)
+
+				fmt.Printf("%d. %s (ID: %s)\n", i+1, title, id)
+				fmt.Printf("   Type: %s | Space: %s\n", contentType, spaceKey)
+				fmt.Println()
+			}
+		}
+
+		if cursor := atlassian.NextCursor(result); cursor != "" {
+			fmt.Printf("More results available: atl confluence content-by-label %s --cursor %s\n", label, cursor)
+		}
+	}
+
+	return nil
+}
+
+func runConfluenceDiffVersions(cmd *cobra.Command, args []string) error {
+	pageID := args[0]
+
+	var versions [2]int
+	for i, arg := range args[1:] {
+		v, err := strconv.Atoi(arg)
+		if err != nil || v < 1 {
+			return fmt.Errorf("invalid version '%s': must be a positive number", arg)
+		}
+		versions[i] = v
+	}
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	expand, err := resolvePageExpand(nil, "body.storage")
+	if err != nil {
+		return err
+	}
+
+	var texts [2][]string
+	for i, version := range versions {
+		page, err := client.GetConfluencePage(pageID, &atlassian.GetPageOptions{Version: version, Expand: expand})
+		if err != nil {
+			return fmt.Errorf("failed to get page: %w", err)
+		}
+
+		body, _ := page["body"].(map[string]any)
+		storage, _ := body["storage"].(map[string]any)
+		value, _ := storage["value"].(string)
+
+		texts[i] = strings.Split(atlassian.HTMLToText(value), "\n")
+	}
+
+	diff := atlassian.UnifiedDiff(texts[0], texts[1],
+		fmt.Sprintf("page %s version %d", pageID, versions[0]),
+		fmt.Sprintf("page %s version %d", pageID, versions[1]),
+		confluenceDiffContext)
+
+	if diff == "" {
+		fmt.Printf("No differences between version %d and version %d\n", versions[0], versions[1])
+		return nil
+	}
+
+	fmt.Print(diff)
+	return nil
+}
+
+// renderPageTemplate fetches a template's storage-format body and fills in its
+// placeholders, failing if any placeholder has no value
+func renderPageTemplate(client *atlassian.Client, templateID string, vars map[string]string) (string, error) {
+	template, err := client.GetContentTemplate(templateID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get template: %w", err)
+	}
+
+	body, _ := template["body"].(map[string]any)
+	storage, _ := body["storage"].(map[string]any)
+	value, _ := storage["value"].(string)
+
+	known := map[string]bool{}
+	for _, name := range atlassian.TemplateVariables(value) {
+		known[name] = true
+	}
+	for name := range vars {
+		if !known[name] {
+			fmt.Fprintf(os.Stderr, "Warning: template has no variable named '%s'\n", name)
+		}
+	}
+
+	rendered, missing := atlassian.SubstituteTemplateVariables(value, vars)
+	if len(missing) > 0 {
+		flags := make([]string, len(missing))
+		for i, name := range missing {
+			flags[i] = fmt.Sprintf("--var %s=...", name)
+		}
+		return "", fmt.Errorf("template requires variables that were not supplied: %s", strings.Join(flags, " "))
+	}
+
+	return rendered, nil
+}
+
+func runConfluenceListTrashed(cmd *cobra.Command, args []string) error {
+	client, account, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	opts := &atlassian.GetPagesInSpaceOptions{
+		SpaceKey: confluenceTrashedSpace,
+		Status:   "trashed",
+		Limit:    confluenceTrashedLimit,
+		Cursor:   confluenceTrashedCursor,
+	}
+
+	result, err := client.GetPagesInSpace(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get trashed pages: %w", err)
+	}
+
+	if outputJSON {
+		if err := printJSON(result); err != nil {
+			return err
+		}
+	} else {
+		results, _ := result["results"].([]any)
+		if len(results) == 0 {
+			fmt.Printf("No trashed pages in space %s\n", confluenceTrashedSpace)
+			return nil
+		}
+
+		fmt.Printf("Trashed pages in space %s:\n\n", confluenceTrashedSpace)
+		printPagesList(result, account.Site)
+		fmt.Printf("To restore a page: atl confluence restore-trashed <page-id>\n")
+	}
+
+	return nil
+}
+
+func runConfluenceRestoreTrashed(cmd *cobra.Command, args []string) error {
+	pageID := args[0]
+
+	client, account, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	expand, err := resolvePageExpand(nil, "version", "ancestors")
+	if err != nil {
+		return err
+	}
+
+	page, err := client.GetConfluencePage(pageID, &atlassian.GetPageOptions{
+		Status: "trashed",
+		Expand: expand,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get trashed page: %w", err)
+	}
+
+	title, _ := page["title"].(string)
+	version, _ := page["version"].(map[string]any)
+	versionNumber, _ := version["number"].(float64)
+
+	// The direct parent is the last ancestor. If it isn't current any more the
+	// page can't go back under it, so restore to the top of the space instead.
+	// Any other failure to look it up is reported rather than guessed at.
+	toRoot := false
+	var parentTitle string
+	ancestors, _ := page["ancestors"].([]any)
+	if len(ancestors) > 0 {
+		parent, _ := ancestors[len(ancestors)-1].(map[string]any)
+		parentID, _ := parent["id"].(string)
+		parentTitle, _ = parent["title"].(string)
+
+		current, err := client.GetConfluencePage(parentID, &atlassian.GetPageOptions{Expand: expand})
+		if errors.Is(err, atlassian.ErrPageNotFound) {
+			toRoot = true
+		} else if err != nil {
+			return fmt.Errorf("failed to check parent page %s: %w", parentID, err)
+		} else if status, _ := current["status"].(string); status != "" && status != "current" {
+			toRoot = true
+		}
+	}
+
+	result, err := client.RestoreTrashedPage(&atlassian.RestorePageOptions{
+		PageID:  pageID,
+		Title:   title,
+		Version: int(versionNumber),
+		ToRoot:  toRoot,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore page: %w", err)
+	}
+
+	if toRoot {
+		fmt.Fprintf(os.Stderr, "Warning: parent page '%s' was also deleted; restored to the top level of the space\n", parentTitle)
+	}
+
+	if outputJSON {
+		if err := printJSON(result); err != nil {
+			return err
+		}
+	} else {
+		fmt.Printf("✓ Restored page: %s\n", title)
+		fmt.Printf("  ID: %s\n", pageID)
+
+		if links, ok := result["_links"].(map[string]any); ok {
+			if webui, ok := links["webui"].(string); ok {
+				webURL := fmt.Sprintf("%s/wiki%s", account.Site, webui)
+				if !strings.HasPrefix(account.Site, "http") {
+					webURL = "https://" + webURL
+				}
+				fmt.Printf("  Link: %s\n", webURL)
+			}
+		}
+	}
+
+	return nil
+}
+
+func runConfluenceAddAttachment(cmd *cobra.Command, args []string) error {
+	pageID := args[0]
+	filePaths := args[1:]
+
+	// Check every file up front so a typo doesn't leave a partial upload
+	for _, filePath := range filePaths {
+		info, err := os.Stat(filePath)
+		if err != nil {
+			return fmt.Errorf("cannot attach %s: %w", filePath, err)
+		}
+		if info.IsDir() {
+			return fmt.Errorf("cannot attach %s: is a directory", filePath)
+		}
+	}
+
+	client, account, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	opts := &atlassian.AddPageAttachmentOptions{
+		Comment:   confluenceAttachmentComment,
+		MinorEdit: confluenceAttachmentMinorEdit,
+	}
+
+	var uploaded []any
+	for _, filePath := range filePaths {
+		result, err := client.AddPageAttachment(pageID, filePath, opts)
+		if err != nil {
+			return fmt.Errorf("failed to upload %s: %w", filePath, err)
+		}
+
+		results, _ := result["results"].([]any)
+		if !outputJSON {
+			for _, r := range results {
+				attachment, _ := r.(map[string]any)
+				id, _ := attachment["id"].(string)
+				title, _ := attachment["title"].(string)
+				version, _ := attachment["version"].(map[string]any)
+				number, _ := version["number"].(float64)
+
+				if number > 1 {
+					fmt.Printf("✓ Attached %s to page %s as version %d (attachment ID: %s)\n", title, pageID, int(number), id)
+				} else {
+					fmt.Printf("✓ Attached %s to page %s (attachment ID: %s)\n", title, pageID, id)
+				}
+				if link := attachmentDownloadLink(attachment, account.Site); link != "" {
+					fmt.Printf("  Download: %s\n", link)
+				}
+			}
+		}
+		uploaded = append(uploaded, results...)
+	}
+
+	if outputJSON {
+		return printJSON(uploaded)
+	}
+	return nil
+}
+
+func runConfluenceGetAttachments(cmd *cobra.Command, args []string) error {
+	pageID := args[0]
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	result, err := client.GetPageAttachments(pageID, &atlassian.GetPageAttachmentsOptions{
+		Limit: confluenceAttachmentsLimit,
+		Start: confluenceAttachmentsStart,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get attachments: %w", err)
+	}
+
+	if outputJSON {
+		return printJSON(result)
+	}
+
+	results, _ := result["results"].([]any)
+	if len(results) == 0 {
+		fmt.Printf("No attachments on page %s\n", pageID)
+		return nil
+	}
+
+	fmt.Printf("%d attachment(s) on page %s:\n\n", len(results), pageID)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTITLE\tSIZE\tTYPE\tVERSION")
+	for _, r := range results {
+		attachment, _ := r.(map[string]any)
+		id, _ := attachment["id"].(string)
+		title, _ := attachment["title"].(string)
+		extensions, _ := attachment["extensions"].(map[string]any)
+		mediaType, _ := extensions["mediaType"].(string)
+		fileSize, _ := extensions["fileSize"].(float64)
+		version, _ := attachment["version"].(map[string]any)
+		number, _ := version["number"].(float64)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\n", id, title, formatByteSize(int64(fileSize)), mediaType, int(number))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if size, _ := result["size"].(float64); int(size) == confluenceAttachmentsLimit {
+		fmt.Printf("\nMore attachments may exist; use --start %d to see the next page\n", confluenceAttachmentsStart+int(size))
+	}
+	return nil
+}
+
+func runConfluenceGetLabels(cmd *cobra.Command, args []string) error {
+	pageID := args[0]
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	result, err := client.GetPageLabels(pageID)
+	if err != nil {
+		return fmt.Errorf("failed to get labels: %w", err)
+	}
+
+	if outputJSON {
+		return printJSON(result)
+	}
+
+	names := pageLabelNames(result)
+	if len(names) == 0 {
+		fmt.Printf("No labels on page %s\n", pageID)
+		return nil
+	}
+
+	fmt.Printf("%d label(s) on page %s:\n", len(names), pageID)
+	for _, name := range names {
+		fmt.Printf("  %s\n", name)
+	}
+	return nil
+}
+
+func runConfluenceAddLabels(cmd *cobra.Command, args []string) error {
+	pageID := args[0]
+
+	var labels []atlassian.PageLabel
+	for _, arg := range args[1:] {
+		label, err := atlassian.ParsePageLabel(arg)
+		if err != nil {
+			return err
+		}
+		labels = append(labels, label)
+	}
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	result, err := client.AddPageLabels(pageID, labels)
+	if err != nil {
+		return fmt.Errorf("failed to add labels: %w", err)
+	}
+
+	if outputJSON {
+		return printJSON(result)
+	}
+
+	fmt.Printf("✓ Added %d label(s) to page %s\n", len(labels), pageID)
+	fmt.Printf("  Labels: %s\n", strings.Join(pageLabelNames(result), ", "))
+	return nil
+}
+
+func runConfluenceRemoveLabels(cmd *cobra.Command, args []string) error {
+	pageID := args[0]
+
+	var labels []atlassian.PageLabel
+	for _, arg := range args[1:] {
+		label, err := atlassian.ParsePageLabel(arg)
+		if err != nil {
+			return err
+		}
+		labels = append(labels, label)
+	}
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	for i, label := range labels {
+		if err := client.RemovePageLabel(pageID, label.Name); err != nil {
+			if i > 0 {
+				fmt.Fprintf(os.Stderr, "Removed %d of %d label(s) before the failure\n", i, len(labels))
+			}
+			return err
+		}
+	}
+
+	return printNoContentResult(true, fmt.Sprintf("Removed %d label(s) from page %s", len(labels), pageID))
+}
+
+// pageLabelNames returns the names in a label list response, with the prefix
+// shown for personal and team labels
+func pageLabelNames(result map[string]any) []string {
+	var names []string
+	results, _ := result["results"].([]any)
+	for _, r := range results {
+		label, _ := r.(map[string]any)
+		name, _ := label["name"].(string)
+		if prefix, _ := label["prefix"].(string); prefix != "" && prefix != "global" {
+			name = prefix + ":" + name
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// attachmentDownloadLink builds the full download URL for a Confluence
+// attachment from its relative _links.download path
+func attachmentDownloadLink(attachment map[string]any, site string) string {
+	links, _ := attachment["_links"].(map[string]any)
+	download, _ := links["download"].(string)
+	if download == "" {
+		return ""
+	}
+	webURL := fmt.Sprintf("%s/wiki%s", site, download)
+	if !strings.HasPrefix(site, "http") {
+		webURL = "https://" + webURL
+	}
+	return webURL
+}
+
+// pageDeletion is the JSON result of delete-page
+type pageDeletion struct {
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	Trashed bool   `json:"trashed"`
+	Purged  bool   `json:"purged"`
+	DryRun  bool   `json:"dryRun,omitempty"`
+}
+
+func runConfluenceDeletePage(cmd *cobra.Command, args []string) error {
+	pageID := args[0]
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	expand, err := resolvePageExpand(nil)
+	if err != nil {
+		return err
+	}
+
+	page, err := client.GetConfluencePage(pageID, &atlassian.GetPageOptions{Status: "any", Expand: expand})
+	if err != nil {
+		return fmt.Errorf("failed to get page: %w", err)
+	}
+	title, _ := page["title"].(string)
+	status, _ := page["status"].(string)
+	alreadyTrashed := status == "trashed"
+
+	if alreadyTrashed && !confluenceDeletePurge {
+		return fmt.Errorf("page %s is already in the trash; use --purge to delete it permanently", pageID)
+	}
+
+	// A current page has to be trashed before it can be purged
+	result := pageDeletion{
+		ID:      pageID,
+		Title:   title,
+		Trashed: !alreadyTrashed,
+		Purged:  confluenceDeletePurge,
+		DryRun:  confluenceDeleteDryRun,
+	}
+
+	var action string
+	switch {
+	case alreadyTrashed:
+		action = fmt.Sprintf("permanently delete trashed page '%s' (%s)", title, pageID)
+	case confluenceDeletePurge:
+		action = fmt.Sprintf("move page '%s' (%s) to the trash and then permanently delete it", title, pageID)
+	default:
+		action = fmt.Sprintf("move page '%s' (%s) to the trash", title, pageID)
+	}
+
+	if confluenceDeleteDryRun {
+		if outputJSON {
+			return printJSON(result)
+		}
+		fmt.Printf("Would %s\n", action)
+		return nil
+	}
+
+	if !confluenceDeleteYes {
+		ok, err := confirmAction(fmt.Sprintf("About to %s. Continue?", action))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Fprintln(os.Stderr, "Cancelled")
+			return nil
+		}
+	}
+
+	if !alreadyTrashed {
+		if err := client.DeleteConfluencePage(pageID, false); err != nil {
+			return fmt.Errorf("failed to move page to trash: %w", err)
+		}
+		if !outputJSON {
+			fmt.Printf("✓ Moved page to trash: %s\n", title)
+		}
+	}
+
+	if confluenceDeletePurge {
+		if err := client.DeleteConfluencePage(pageID, true); err != nil {
+			if !alreadyTrashed {
+				return fmt.Errorf("page was moved to the trash but could not be purged: %w", err)
+			}
+			return fmt.Errorf("failed to purge page: %w", err)
+		}
+	}
+
+	if outputJSON {
+		return printJSON(result)
+	}
+
+	if confluenceDeletePurge {
+		fmt.Printf("✓ Permanently deleted page: %s\n", title)
+		fmt.Printf("  ID: %s\n", pageID)
+	} else {
+		fmt.Printf("  ID: %s\n", pageID)
+		fmt.Printf("  Restore it with 'atl confluence restore-trashed %s', or run again with --purge to delete it permanently\n", pageID)
+	}
+	return nil
+}
+
+// spacePrincipal is one user, group, or role with the operations it is
+// granted in a space
+type spacePrincipal struct {
+	Type       string   `json:"type"`
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Operations []string `json:"operations"`
+}
+
+func runConfluenceGetSpacePermissions(cmd *cobra.Command, args []string) error {
+	spaceKey := args[0]
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	// The permissions API is keyed by space ID rather than key
+	spaces, err := client.GetConfluenceSpaces(&atlassian.GetSpacesOptions{Keys: []string{spaceKey}})
+	if err != nil {
+		return fmt.Errorf("failed to get space: %w", err)
+	}
+	results, _ := spaces["results"].([]any)
+	if len(results) == 0 {
+		return fmt.Errorf("space %s not found", spaceKey)
+	}
+	space, _ := results[0].(map[string]any)
+	spaceID := formatFieldValue(space["id"])
+
+	permissions, err := client.GetAllSpacePermissions(spaceID)
+	if err != nil {
+		return fmt.Errorf("failed to get space permissions: %w", err)
+	}
+
+	// Group operations by principal
+	byPrincipal := map[string]*spacePrincipal{}
+	for _, p := range permissions {
+		permission, _ := p.(map[string]any)
+		principal, _ := permission["principal"].(map[string]any)
+		principalType, _ := principal["type"].(string)
+		principalID, _ := principal["id"].(string)
+		operation, _ := permission["operation"].(map[string]any)
+		key, _ := operation["key"].(string)
+		targetType, _ := operation["targetType"].(string)
+
+		id := principalType + ":" + principalID
+		entry, ok := byPrincipal[id]
+		if !ok {
+			entry = &spacePrincipal{Type: principalType, ID: principalID}
+			byPrincipal[id] = entry
+		}
+		entry.Operations = append(entry.Operations, strings.TrimSpace(key+" "+targetType))
+	}
+
+	principals := make([]*spacePrincipal, 0, len(byPrincipal))
+	for _, entry := range byPrincipal {
+		entry.Name = principalName(client, entry.Type, entry.ID)
+		sort.Strings(entry.Operations)
+		principals = append(principals, entry)
+	}
+	// Users first, then groups, then roles
+	typeRank := map[string]int{"user": 0, "group": 1, "role": 2}
+	sort.Slice(principals, func(i, j int) bool {
+		if principals[i].Type != principals[j].Type {
+			return typeRank[principals[i].Type] < typeRank[principals[j].Type]
+		}
+		return strings.ToLower(principals[i].Name) < strings.ToLower(principals[j].Name)
+	})
+
+	if outputJSON {
+		return printJSON(principals)
+	}
+
+	if len(principals) == 0 {
+		fmt.Printf("No permissions found for space %s\n", spaceKey)
+		return nil
+	}
+
+	fmt.Printf("Permissions for space %s:\n\n", spaceKey)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PRINCIPAL\tTYPE\tOPERATIONS")
+	for _, p := range principals {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", p.Name, p.Type, strings.Join(p.Operations, ", "))
+	}
+	w.Flush()
+
+	fmt.Printf("\n%d principal(s), %d permission(s)\n", len(principals), len(permissions))
+	return nil
+}
+
+// principalName looks up a display name for a space permission principal,
+// falling back to its ID if the lookup fails
+func principalName(client *atlassian.Client, principalType, id string) string {
+	switch principalType {
+	case "user":
+		if user, err := client.GetConfluenceUser(id); err == nil {
+			if name, ok := user["displayName"].(string); ok && name != "" {
+				return name
+			}
+		}
+	case "group":
+		if group, err := client.GetConfluenceGroup(id); err == nil {
+			if name, ok := group["name"].(string); ok && name != "" {
+				return name
+			}
+		}
+	}
+	return id
+}
+
+func runConfluenceGetPageWatchers(cmd *cobra.Command, args []string) error {
+	pageID := args[0]
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	watchers, err := client.GetPageWatchers(pageID)
+	if err != nil {
+		return fmt.Errorf("failed to get page watchers: %w", err)
+	}
+
+	if outputJSON {
+		return printJSON(map[string]any{
+			"results": watchers,
+			"size":    len(watchers),
+		})
+	}
+
+	if len(watchers) == 0 {
+		fmt.Printf("No one is watching page %s\n", pageID)
+		return nil
+	}
+
+	fmt.Printf("Watchers of page %s (%d):\n\n", pageID, len(watchers))
+	for i, item := range watchers {
+		watch, _ := item.(map[string]any)
+		watcher, _ := watch["watcher"].(map[string]any)
+		displayName, _ := watcher["displayName"].(string)
+		accountID, _ := watcher["accountId"].(string)
+
+		fmt.Printf("%d. %s", i+1, displayName)
+		if accountID != "" {
+			fmt.Printf(" (account ID: %s)", accountID)
+		}
+		fmt.Println()
+	}
+
+	return nil
+}
+
+func runConfluenceWatchPage(cmd *cobra.Command, args []string) error {
+	pageID := args[0]
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.WatchPage(pageID, confluenceWatchAccountID); err != nil {
+		return fmt.Errorf("failed to watch page: %w", err)
+	}
+
+	message := fmt.Sprintf("Watching page %s", pageID)
+	if confluenceWatchAccountID != "" {
+		message = fmt.Sprintf("Added %s as a watcher of page %s", confluenceWatchAccountID, pageID)
+	}
+	return printNoContentResult(true, message)
+}
+
+func runConfluenceUnwatchPage(cmd *cobra.Command, args []string) error {
+	pageID := args[0]
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.UnwatchPage(pageID, confluenceWatchAccountID); err != nil {
+		return fmt.Errorf("failed to unwatch page: %w", err)
+	}
+
+	message := fmt.Sprintf("Stopped watching page %s", pageID)
+	if confluenceWatchAccountID != "" {
+		message = fmt.Sprintf("Removed %s as a watcher of page %s", confluenceWatchAccountID, pageID)
+	}
+	return printNoContentResult(true, message)
+}
+
+// syncResult records what sync did with one document
+type syncResult struct {
+	Path   string `json:"path"`
+	Title  string `json:"title"`
+	PageID string `json:"pageId,omitempty"`
+	Action string `json:"action"` // created, updated, unchanged, or failed
+	Error  string `json:"error,omitempty"`
+}
+
+func runConfluenceSync(cmd *cobra.Command, args []string) error {
+	docs, err := atlassian.CollectSyncDocuments(confluenceSyncDir)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", confluenceSyncDir, err)
+	}
+	if len(docs) == 0 {
+		return fmt.Errorf("no markdown files found in %s", confluenceSyncDir)
+	}
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	// Page IDs by document path, so children can find their parent page
+	pageIDs := map[string]string{}
+	counts := map[string]int{}
+	var results []syncResult
+
+	for _, doc := range docs {
+		result := syncResult{Path: doc.Path, Title: doc.Title}
+
+		parentID := confluenceSyncParent
+		if doc.Parent != "" {
+			parentID = pageIDs[doc.Parent]
+		}
+
+		if doc.Parent != "" && parentID == "" {
+			result.Action = "failed"
+			result.Error = fmt.Sprintf("parent %s was not synced", doc.Parent)
+		} else if pageID, action, err := syncDocument(client, doc, parentID); err != nil {
+			result.Action = "failed"
+			result.Error = err.Error()
+		} else {
+			result.PageID = pageID
+			result.Action = action
+			pageIDs[doc.Path] = pageID
+		}
+
+		counts[result.Action]++
+		results = append(results, result)
+
+		if !outputJSON {
+			printSyncResult(result)
+		}
+	}
+
+	if outputJSON {
+		if err := printJSON(map[string]any{
+			"dryRun":    confluenceSyncDryRun,
+			"created":   counts["created"],
+			"updated":   counts["updated"],
+			"unchanged": counts["unchanged"],
+			"failed":    counts["failed"],
+			"pages":     results,
+		}); err != nil {
+			return err
+		}
+	} else {
+		format := "\nCreated %d, updated %d, unchanged %d, failed %d\n"
+		if confluenceSyncDryRun {
+			format = "\nDry run: %d to create, %d to update, %d unchanged, %d failed\n"
+		}
+		fmt.Printf(format, counts["created"], counts["updated"], counts["unchanged"], counts["failed"])
+	}
+
+	if counts["failed"] > 0 {
+		return fmt.Errorf("%d of %d page(s) failed to sync", counts["failed"], len(docs))
+	}
+	return nil
+}
+
+// syncDocument creates or updates the page for doc under parentID and
+// returns its page ID and the action taken. In dry-run mode nothing is
+// changed and new pages get a placeholder ID.
+func syncDocument(client *atlassian.Client, doc atlassian.SyncDocument, parentID string) (string, string, error) {
+	body, err := atlassian.MarkdownToStorage(doc.Markdown)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to convert markdown: %w", err)
+	}
+
+	existing, err := client.FindPageByTitle(confluenceSyncSpace, doc.Title)
+	if err != nil {
+		return "", "", err
+	}
+
+	if existing == nil {
+		if confluenceSyncDryRun {
+			return "(new)", "created", nil
+		}
+		page, err := client.CreateConfluencePage(&atlassian.CreatePageOptions{
+			SpaceKey: confluenceSyncSpace,
+			Title:    doc.Title,
+			Body:     body,
+			ParentID: parentID,
+		})
+		if err != nil {
+			return "", "", err
+		}
+		pageID, _ := page["id"].(string)
+		return pageID, "created", nil
+	}
+
+	pageID, _ := existing["id"].(string)
+	storage, _ := lookupPath(existing, "body.storage.value")
+	currentBody, _ := storage.(string)
+
+	currentParent := ""
+	if ancestors, _ := existing["ancestors"].([]any); len(ancestors) > 0 {
+		parent, _ := ancestors[len(ancestors)-1].(map[string]any)
+		currentParent, _ = parent["id"].(string)
+	}
+
+	if !confluenceSyncForce && atlassian.StorageBodiesEqual(currentBody, body) && (parentID == "" || parentID == currentParent) {
+		return pageID, "unchanged", nil
+	}
+
+	if confluenceSyncDryRun {
+		return pageID, "updated", nil
+	}
+	_, err = client.UpdateConfluencePage(&atlassian.UpdatePageOptions{
+		PageID:   pageID,
+		Title:    doc.Title,
+		Body:     body,
+		Version:  atlassian.NextPageVersion(existing),
+		ParentID: parentID,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return pageID, "updated", nil
+}
+
+func printSyncResult(result syncResult) {
+	switch result.Action {
+	case "failed":
+		fmt.Printf("✗ %-9s %s: %s\n", result.Action, result.Path, result.Error)
+	default:
+		fmt.Printf("✓ %-9s %s → %s (ID: %s)\n", result.Action, result.Path, result.Title, result.PageID)
+	}
+}
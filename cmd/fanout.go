@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/doughughes/atlassian-cli/internal/atlassian"
+	"github.com/doughughes/atlassian-cli/internal/config"
+)
+
+// accountResult holds the outcome of running a request against one account
+type accountResult struct {
+	Account string         `json:"account"`
+	Site    string         `json:"site"`
+	Result  map[string]any `json:"result,omitempty"`
+	Error   string         `json:"error,omitempty"`
+}
+
+// fanOutAccounts runs fn concurrently against every configured account and
+// returns one result per account, sorted by account name. A failure in one
+// account is recorded in its result and never cancels the others.
+func fanOutAccounts(fn func(client *atlassian.Client) (map[string]any, error)) ([]accountResult, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	if len(cfg.Accounts) == 0 {
+		return nil, fmt.Errorf("not logged in. Run 'atl auth login' first")
+	}
+
+	retry, err := resolveRetryPolicy(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(cfg.Accounts))
+	for name := range cfg.Accounts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Each goroutine writes only its own slot, so no locking is needed
+	results := make([]accountResult, len(names))
+
+	var wg sync.WaitGroup
+	for i, name := range names {
+		account := cfg.Accounts[name]
+		results[i] = accountResult{Account: name, Site: account.Site}
+
+		wg.Add(1)
+		go func(r *accountResult, account *config.Account) {
+			defer wg.Done()
+
+			if err := account.LoadToken(); err != nil {
+				r.Error = err.Error()
+				return
+			}
+			client := withRequestSettings(atlassian.NewClient(account.Email, account.Token, account.Site))
+			client.Retry = retry
+			client.Headers = extraHeaders
+			result, err := fn(client)
+			if err != nil {
+				r.Error = err.Error()
+				return
+			}
+			r.Result = result
+		}(&results[i], account)
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+// reportAccountErrors prints per-account failures to stderr and returns an
+// error only if every account failed
+func reportAccountErrors(results []accountResult) error {
+	failed := 0
+	for _, r := range results {
+		if r.Error != "" {
+			failed++
+			fmt.Fprintf(os.Stderr, "✗ %s (%s): %s\n", r.Account, r.Site, r.Error)
+		}
+	}
+
+	if failed > 0 && failed == len(results) {
+		return fmt.Errorf("search failed for all %d account(s)", failed)
+	}
+	return nil
+}
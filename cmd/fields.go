@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/doughughes/atlassian-cli/internal/atlassian"
+)
+
+// formatFieldValue renders an arbitrary Jira field value as a single line of
+// text. Objects are flattened to their most descriptive attribute (name,
+// value, displayName, key), arrays are joined with commas, and ADF documents
+// are converted to plain text.
+func formatFieldValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return fmt.Sprintf("%t", v)
+	case float64:
+		if v == float64(int64(v)) {
+			return fmt.Sprintf("%d", int64(v))
+		}
+		return fmt.Sprintf("%g", v)
+	case []any:
+		var parts []string
+		for _, item := range v {
+			if s := formatFieldValue(item); s != "" {
+				parts = append(parts, s)
+			}
+		}
+		return strings.Join(parts, ", ")
+	case map[string]any:
+		if docType, _ := v["type"].(string); docType == "doc" {
+			return strings.Join(strings.Fields(atlassian.ADFToText(v)), " ")
+		}
+		for _, attr := range []string{"displayName", "name", "value", "key"} {
+			if s, ok := v[attr].(string); ok && s != "" {
+				// Cascading selects nest the child option under "child"
+				if child, ok := v["child"].(map[string]any); ok {
+					if childValue := formatFieldValue(child); childValue != "" {
+						return s + " > " + childValue
+					}
+				}
+				return s
+			}
+		}
+		if id, ok := v["id"].(string); ok {
+			return id
+		}
+		return ""
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// printIssueWide prints every non-empty field of an issue as an aligned
+// two-column table. Field IDs are replaced with display names when the issue
+// was fetched with the "names" expansion.
+func printIssueWide(issue map[string]any) {
+	key, _ := issue["key"].(string)
+	fields, _ := issue["fields"].(map[string]any)
+	names, _ := issue["names"].(map[string]any)
+
+	type row struct {
+		name  string
+		value string
+	}
+
+	var rows []row
+	width := len("Key")
+	for id, value := range fields {
+		text := formatFieldValue(value)
+		if text == "" {
+			continue
+		}
+
+		name := id
+		if displayName, ok := names[id].(string); ok && displayName != "" {
+			name = displayName
+		}
+
+		rows = append(rows, row{name: name, value: text})
+		if len(name) > width {
+			width = len(name)
+		}
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		return strings.ToLower(rows[i].name) < strings.ToLower(rows[j].name)
+	})
+
+	fmt.Printf("%-*s | %s\n", width, "Key", key)
+	fmt.Printf("%s-+-%s\n", strings.Repeat("-", width), strings.Repeat("-", 40))
+	for _, r := range rows {
+		fmt.Printf("%-*s | %s\n", width, r.name, r.value)
+	}
+}
+
+// highlightedField is the field picked with --highlight-field, shown
+// prominently in pretty output. Nil when no field is highlighted.
+var highlightedField *atlassian.JiraField
+
+// resolveHighlightField looks up a --highlight-field value (a field name or
+// ID) on the site
+func resolveHighlightField(client *atlassian.Client, nameOrID string) (*atlassian.JiraField, error) {
+	fields, err := client.GetFields()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fields: %w", err)
+	}
+	field, err := atlassian.FindField(fields, nameOrID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --highlight-field: %w", err)
+	}
+	return field, nil
+}
+
+// highlightedFieldText formats the highlighted field's value from an issue's
+// fields as "Name: value"
+func highlightedFieldText(fields map[string]any) string {
+	value := formatFieldValue(fields[highlightedField.ID])
+	if value == "" {
+		value = "(none)"
+	}
+	return fmt.Sprintf("%s: %s", highlightedField.Name, value)
+}
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"bufio"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -9,7 +11,10 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/doughughes/atlassian-cli/internal/atlassian"
@@ -32,7 +37,23 @@ Examples:
   atl jira get-issue PROJ-123
   atl jira get-issue 10000
   atl jira get-issue PROJ-123 --json
-  atl jira get-issue PROJ-123 --fields summary,status,assignee`,
+  atl jira get-issue PROJ-123 --fields summary,status,assignee
+  atl jira get-issue PROJ-123 --links
+  atl jira get-issue PROJ-123 --output pretty-wide
+  atl jira get-issue PROJ-123 --output markdown > PROJ-123.md
+  atl jira get-issue PROJ-123 --resolve-sprints
+  atl jira get-issue PROJ-123 --remote-links
+  atl jira get-issue PROJ-123 --time-tracking
+  atl jira get-issue PROJ-123 --download-attachments ./PROJ-123
+  atl jira get-issue PROJ-123 --flatten | grep '^fields.status.name='
+  atl jira get-issue PROJ-123 --highlight-field "Story Points"
+  atl jira get-issue PROJ-123 --wrap 80
+  atl jira get-issue PROJ-123 --markdown-safe
+  atl jira get-issue PROJ-123 --blockers-depth 3
+
+--blockers-depth N follows "is blocked by" links N levels deep and prints the
+chain of blockers as a tree with each issue's status. Each issue is expanded
+once, so link cycles are shown but not followed.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runJiraGetIssue,
 }
@@ -51,7 +72,30 @@ Examples:
   atl jira search-jql "project = PROJ"
   atl jira search-jql "assignee = currentUser()"
   atl jira search-jql "status = 'In Progress'" --max-results 10
-  atl jira search-jql "project = PROJ" --fields summary,status,assignee`,
+  atl jira search-jql "project = PROJ" --fields summary,status,assignee
+  atl jira search-jql "project = PROJ" --order-by "updated DESC"
+  atl jira search-jql "project = PROJ" --order-by updated --desc
+  atl jira search-jql "project = PROJ" --sort-by customfield_10016 --desc
+  atl jira search-jql "project = PROJ" --sort-by assignee.displayName
+  atl jira search-jql "project = PROJ" --highlight-field "Story Points"
+  atl jira search-jql "project = PROJ" --all --max-results 100
+  atl jira search-jql "project = PROJ" --output csv > issues.csv
+  atl jira search-jql "project = PROJ" --output csv --fields summary,status,customfield_10016
+  atl jira search-jql "project = PROJ" --output table --max-col-width 30
+
+--output csv prints a header row and one row per issue with the columns key,
+summary, status, type, assignee, priority, created, and updated. With --fields
+the columns are key followed by the given fields, so custom fields can be
+exported too. --output table shows the same columns as an aligned grid,
+cutting values longer than --max-col-width short.
+
+--sort-by sorts the fetched results on the client, for values ORDER BY can't
+handle well. The path is relative to the issue's fields unless it starts with
+"key", "id", or "fields". Issues without a value sort last.
+
+--all follows pagination until every matching issue is fetched; --max-results
+then sets the page size. Issues are printed as each page arrives (unless
+--sort-by or --json needs the full set first).`,
 	Args: cobra.ExactArgs(1),
 	RunE: runJiraSearchJQL,
 }
@@ -67,31 +111,97 @@ The --description flag supports MARKDOWN formatting including:
   - Lists (bullets and numbered)
   - Code blocks (` + "```language" + `)
   - Links, blockquotes, and more
+  - Panels from admonition blockquotes (> [!INFO], [!NOTE], [!WARNING], [!SUCCESS], [!ERROR])
   - Inline images from local files: ![alt text](./path/to/image.png)
 
 Local image references (![alt](./file.png)) are automatically uploaded as
 attachments and embedded inline in the issue description. URLs (http/https)
 are left as-is.
 
+To send a description that markdown can't express, pass an ADF document with
+--raw-description-adf; it is used exactly as given.
+
+The --parent flag sets a sub-task's parent (a standard issue in the same
+project) or, for standard issues, the epic they belong to. The relationship
+is checked before the issue is created.
+
 Examples:
   atl jira create-issue --project PROJ --type Task --summary "Do something"
+  atl jira create-issue --project PROJ --type Sub-task --summary "Step 1" --parent PROJ-123
+  atl jira create-issue --project PROJ --type Story --summary "Feature" --parent PROJ-100
   atl jira create-issue --project PROJ --type Bug --summary "Fix bug" --description "**Important:** Bug details here"
-  atl jira create-issue --project PROJ --type Bug --summary "UI broken" --description "See bug: ![screenshot](./bug.png)"`,
+  atl jira create-issue --project PROJ --type Bug --summary "UI broken" --description "See bug: ![screenshot](./bug.png)"
+  atl jira create-issue --project PROJ --type Story --summary "Spec" --description-file spec.md
+  atl jira create-issue --project PROJ --type Task --summary "Raw" --raw-description-adf "$(cat desc.json)"
+  atl jira create-issue --project PROJ --type Story --summary "Check me" --fields '{"customfield_10010": "x"}' --validate-only`,
 	RunE: runJiraCreateIssue,
 }
 
 var jiraAddCommentCmd = &cobra.Command{
-	Use:   "add-comment <issueKey> <comment>",
+	Use:   "add-comment <issueKey> [comment]",
 	Short: "Add a comment to a Jira issue",
 	Long: `Add a comment to an existing Jira issue.
 
+A comment given as an argument is posted as plain text. Use --comment-file to
+read a MARKDOWN comment from a file ("-" for stdin) instead; it is converted
+to Jira's rich text format like --description.
+
 Examples:
   atl jira add-comment PROJ-123 "This is a comment"
-  atl jira add-comment PROJ-123 "Multi-line comment works too"`,
-	Args: cobra.ExactArgs(2),
+  atl jira add-comment PROJ-123 "Multi-line comment works too"
+  atl jira add-comment PROJ-123 --comment-file notes.md
+  git log -1 --format=%B | atl jira add-comment PROJ-123 --comment-file -`,
+	Args: cobra.RangeArgs(1, 2),
 	RunE: runJiraAddComment,
 }
 
+var jiraGetCommentsCmd = &cobra.Command{
+	Use:   "get-comments <issueKey>",
+	Short: "List the comments on a Jira issue",
+	Long: `List the comments on a Jira issue with their ID, author, creation time,
+and body, oldest first.
+
+Use --order-by -created for newest first, or --latest to show only the most
+recent comment.
+
+Examples:
+  atl jira get-comments PROJ-123
+  atl jira get-comments PROJ-123 --latest
+  atl jira get-comments PROJ-123 --order-by -created --max-results 5
+  atl jira get-comments PROJ-123 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runJiraGetComments,
+}
+
+var jiraEditCommentCmd = &cobra.Command{
+	Use:   "edit-comment <issueKey> <commentId> [newBody]",
+	Short: "Replace the body of a comment on a Jira issue",
+	Long: `Replace the body of an existing comment on a Jira issue.
+
+The new body supports MARKDOWN formatting like --description. Give it as an
+argument or read it from a file with --comment-file ("-" for stdin). Comment
+IDs are shown by get-comments.
+
+Examples:
+  atl jira edit-comment PROJ-123 10001 "Updated: **fixed** in v2"
+  atl jira edit-comment PROJ-123 10001 --comment-file notes.md`,
+	Args: cobra.RangeArgs(2, 3),
+	RunE: runJiraEditComment,
+}
+
+var jiraDeleteCommentCmd = &cobra.Command{
+	Use:   "delete-comment <issueKey> <commentId>",
+	Short: "Delete a comment from a Jira issue",
+	Long: `Delete a comment from a Jira issue. You are asked to confirm unless --yes
+is given, which is required when not running interactively.
+
+Examples:
+  atl jira delete-comment PROJ-123 10001
+  atl jira delete-comment PROJ-123 10001 --yes`,
+	Args: cobra.ExactArgs(2),
+	RunE: runJiraDeleteComment,
+}
+
 var jiraEditIssueCmd = &cobra.Command{
 	Use:   "edit-issue <issueKey>",
 	Short: "Edit a Jira issue",
@@ -99,13 +209,17 @@ var jiraEditIssueCmd = &cobra.Command{
 
 The --description flag supports MARKDOWN formatting (headings, bold, lists, code blocks, etc).
 Local image references (![alt](./file.png)) are automatically uploaded as attachments
-and embedded inline in the description.
+and embedded inline in the description. Use --raw-description-adf to set the
+description to an ADF document as-is instead.
 
 Examples:
   atl jira edit-issue PROJ-123 --summary "New summary"
   atl jira edit-issue PROJ-123 --description "## Updated\n\n- Point 1\n- Point 2"
   atl jira edit-issue PROJ-123 --summary "Update" --description "Details with **bold**"
-  atl jira edit-issue PROJ-123 --description "Fixed: ![proof](./fix-screenshot.png)"`,
+  atl jira edit-issue PROJ-123 --description "Fixed: ![proof](./fix-screenshot.png)"
+  generate-notes | atl jira edit-issue PROJ-123 --description-file -
+  atl jira edit-issue PROJ-123 --raw-description-adf '{"type":"doc","version":1,"content":[]}'
+  atl jira edit-issue PROJ-123 --summary "Quiet fix" --no-notify`,
 	Args: cobra.ExactArgs(1),
 	RunE: runJiraEditIssue,
 }
@@ -122,19 +236,126 @@ Examples:
 }
 
 var jiraTransitionIssueCmd = &cobra.Command{
-	Use:   "transition-issue <issueKey> <transitionID>",
+	Use:   "transition-issue <issueKey> [transitionID]",
 	Short: "Transition an issue to a new status",
-	Long: `Change the status of a Jira issue using a transition ID.
+	Long: `Change the status of a Jira issue using a transition ID, or with
+--to-status, the name of the status to move it to.
 
-Use 'get-transitions' to see available transition IDs.
+Use 'get-transitions' to see available transition IDs. --to-status picks the
+transition leading to that status (case-insensitive); if several do, the one
+named after the status wins, otherwise give the transition ID instead.
 
 Examples:
   atl jira transition-issue PROJ-123 21
-  atl jira transition-issue PROJ-123 31`,
-	Args: cobra.ExactArgs(2),
+  atl jira transition-issue PROJ-123 --to-status "Done"
+  atl jira transition-issue PROJ-123 31
+  atl jira transition-issue PROJ-123 41 --fields-from-meta
+
+Use --fields-from-meta to be prompted for any fields the transition screen
+requires (e.g. resolution), with their allowed values listed.
+
+The transition ID is checked against the transitions available from the
+issue's current status first, so an invalid ID fails with the list of valid
+ones. Use --no-validate to skip the extra request.`,
+	Args: cobra.RangeArgs(1, 2),
 	RunE: runJiraTransitionIssue,
 }
 
+var jiraAssignIssueCmd = &cobra.Command{
+	Use:   "assign-issue <issueKey> [assignee]",
+	Short: "Assign an issue to a user by name, email, or account ID",
+	Long: `Assign a Jira issue to a user. The assignee can be an account ID, an email
+address, a display name, or "me".
+
+Names and emails are looked up among active users. If more than one user
+matches (and exactly one doesn't match the name or email exactly), the
+candidates are listed and nothing is assigned.
+
+Examples:
+  atl jira assign-issue PROJ-123 "Doug Hughes"
+  atl jira assign-issue PROJ-123 doug@example.com
+  atl jira assign-issue PROJ-123 5b10ac8d82e05b22cc7d4ef5
+  atl jira assign-issue PROJ-123 me
+  atl jira assign-issue PROJ-123 --unassign`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runJiraAssignIssue,
+}
+
+var jiraAddWorklogCmd = &cobra.Command{
+	Use:   "add-worklog <issueKey>",
+	Short: "Log time spent on a Jira issue",
+	Long: `Log time against a Jira issue.
+
+--time-spent uses Jira's duration format (e.g. "2h 30m", "1d", "45m").
+--comment supports MARKDOWN formatting. --started defaults to now and must
+look like 2024-01-15T09:00:00.000+0000.
+
+--adjust-estimate controls the issue's remaining estimate:
+  auto    Reduce it by the time spent (default)
+  new     Set it to --new-estimate
+  leave   Leave it unchanged
+  manual  Reduce it by --reduce-by
+
+Examples:
+  atl jira add-worklog PROJ-123 --time-spent "2h 30m"
+  atl jira add-worklog PROJ-123 --time-spent 1h --comment "Pairing on the **import** bug"
+  atl jira add-worklog PROJ-123 --time-spent 3h --started "2024-01-15T09:00:00.000+0000"
+  atl jira add-worklog PROJ-123 --time-spent 2h --adjust-estimate new --new-estimate 4h
+  atl jira add-worklog PROJ-123 --time-spent 2h --adjust-estimate manual --reduce-by 1h`,
+	Args: cobra.ExactArgs(1),
+	RunE: runJiraAddWorklog,
+}
+
+var jiraAddWatcherCmd = &cobra.Command{
+	Use:   "add-watcher <issueKey> <user>",
+	Short: "Add (or remove) a watcher on a Jira issue",
+	Long: `Add a user to an issue's watchers, or remove them with --remove. The user
+can be an account ID, an email address, a display name, or "me", resolved
+the same way as assign-issue.
+
+Examples:
+  atl jira add-watcher PROJ-123 "Doug Hughes"
+  atl jira add-watcher PROJ-123 doug@example.com
+  atl jira add-watcher PROJ-123 me --remove`,
+	Args: cobra.ExactArgs(2),
+	RunE: runJiraAddWatcher,
+}
+
+var jiraListWatchersCmd = &cobra.Command{
+	Use:   "list-watchers <issueKey>",
+	Short: "List the watchers of a Jira issue",
+	Long: `List the users watching a Jira issue and whether their accounts are active.
+
+Examples:
+  atl jira list-watchers PROJ-123
+  atl jira list-watchers PROJ-123 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runJiraListWatchers,
+}
+
+var jiraGetWorklogsCmd = &cobra.Command{
+	Use:   "get-worklogs <issueKey>",
+	Short: "List time logged on a Jira issue",
+	Long: `List the worklogs on a Jira issue with their author, time spent, start
+time, and comment, followed by the total time logged.
+
+Every worklog is fetched unless --start-at or --max-results asks for a single
+page, in which case the total covers only that page. With --json, a single
+page is printed as the API returned it; when every worklog is fetched, the
+output is a JSON array of the API's pages in order.
+
+--started-after and --started-before accept a relative offset (-30m, -12h,
+-1d, -2w) or a date (2024-01-15, "2024-01-15 09:00").
+
+Examples:
+  atl jira get-worklogs PROJ-123
+  atl jira get-worklogs PROJ-123 --started-after -1w
+  atl jira get-worklogs PROJ-123 --started-after 2024-01-01 --started-before 2024-02-01
+  atl jira get-worklogs PROJ-123 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runJiraGetWorklogs,
+}
+
 var jiraLookupAccountIDCmd = &cobra.Command{
 	Use:   "lookup-account-id <searchString>",
 	Short: "Find user account ID by name or email",
@@ -142,9 +363,14 @@ var jiraLookupAccountIDCmd = &cobra.Command{
 
 Account IDs are needed for assigning issues or setting other user fields.
 
+--first prints only the account ID, and fails unless exactly one user
+matches, so the result can be used directly in scripts.
+
 Examples:
   atl jira lookup-account-id "Doug Hughes"
-  atl jira lookup-account-id "doug@example.com"`,
+  atl jira lookup-account-id "doug@example.com"
+  atl jira lookup-account-id "Doug Hughes" --active-only --exact
+  atl jira edit-issue PROJ-123 --assignee "$(atl jira lookup-account-id doug@example.com --exact --first)"`,
 	Args: cobra.ExactArgs(1),
 	RunE: runJiraLookupAccountID,
 }
@@ -157,7 +383,9 @@ var jiraGetProjectsCmd = &cobra.Command{
 Examples:
   atl jira get-projects
   atl jira get-projects --action create
-  atl jira get-projects --search "Product"`,
+  atl jira get-projects --search "Product"
+  atl jira get-projects --output table
+  atl jira get-projects --output table --columns key,name,style`,
 	RunE: runJiraGetProjects,
 }
 
@@ -173,6 +401,99 @@ Examples:
 	RunE: runJiraGetProjectIssueTypes,
 }
 
+var jiraGetProjectRolesCmd = &cobra.Command{
+	Use:   "get-project-roles <projectKey>",
+	Short: "List roles for a project",
+	Long: `List the roles defined for a Jira project along with their role IDs.
+
+Use get-role-members with a role ID to see who is in a role. Viewing roles
+requires the Administer Projects permission.
+
+Examples:
+  atl jira get-project-roles ABC
+  atl jira get-project-roles ABC --members
+  atl jira get-project-roles ABC --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runJiraGetProjectRoles,
+}
+
+var jiraGetRoleMembersCmd = &cobra.Command{
+	Use:   "get-role-members <projectKey> <roleId>",
+	Short: "List the users and groups in a project role",
+	Long: `List the users and groups that belong to a project role.
+
+Role IDs are shown by get-project-roles. Viewing role members requires the
+Administer Projects permission.
+
+Examples:
+  atl jira get-role-members ABC 10002
+  atl jira get-role-members ABC 10002 --max-results 20 --start-at 20
+  atl jira get-role-members ABC 10002 --json`,
+	Args: cobra.ExactArgs(2),
+	RunE: runJiraGetRoleMembers,
+}
+
+var jiraServerInfoCmd = &cobra.Command{
+	Use:   "serverinfo",
+	Short: "Show the Jira site's version and deployment type",
+	Long: `Show the Jira site's deployment type (Cloud, Server, or DataCenter),
+version, build number, and base URL.
+
+The deployment type is also saved with the active account in the config.
+
+Examples:
+  atl jira serverinfo
+  atl jira serverinfo --json`,
+	Args: cobra.NoArgs,
+	RunE: runJiraServerInfo,
+}
+
+var jiraWhoamiCmd = &cobra.Command{
+	Use:     "whoami",
+	Aliases: []string{"myself"},
+	Short:   "Show the authenticated user",
+	Long: `Show the user the active account authenticates as.
+
+The account ID is saved with the account at login, so commands that act on
+"me" (such as comments-since --author me) don't need to look it up. Use --save
+to refresh the saved ID, e.g. for accounts added before it was recorded.
+
+Examples:
+  atl jira whoami
+  atl jira whoami --save
+  atl jira whoami --json`,
+	Args: cobra.NoArgs,
+	RunE: runJiraWhoami,
+}
+
+var jiraCreateVersionCmd = &cobra.Command{
+	Use:   "create-version",
+	Short: "Create a project version",
+	Long: `Create a new version (release) in a Jira project.
+
+Dates use the YYYY-MM-DD format.
+
+Examples:
+  atl jira create-version --project ABC --name 1.2.0
+  atl jira create-version --project ABC --name 1.2.0 --description "Spring release" --release-date 2026-03-01
+  atl jira create-version --project ABC --name 1.2.0 --json`,
+	Args: cobra.NoArgs,
+	RunE: runJiraCreateVersion,
+}
+
+var jiraReleaseVersionCmd = &cobra.Command{
+	Use:   "release-version <versionId>",
+	Short: "Mark a version as released",
+	Long: `Mark a Jira version as released. The release date defaults to today.
+
+Examples:
+  atl jira release-version 10100
+  atl jira release-version 10100 --release-date 2026-03-01
+  atl jira release-version 10100 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runJiraReleaseVersion,
+}
+
 var jiraGetRemoteLinksCmd = &cobra.Command{
 	Use:   "get-remote-links <issueKey>",
 	Short: "Get remote links for an issue",
@@ -279,6 +600,38 @@ Examples:
 	RunE: runJiraAddAttachment,
 }
 
+var jiraGetAttachmentsCmd = &cobra.Command{
+	Use:   "get-attachments <issueKey>",
+	Short: "List the attachments on a Jira issue",
+	Long: `List the attachments on a Jira issue with their ID, filename, size, type,
+and author. Use the ID with download-attachment to fetch one.
+
+Examples:
+  atl jira get-attachments PROJ-123
+  atl jira get-attachments PROJ-123 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runJiraGetAttachments,
+}
+
+var jiraDownloadAttachmentCmd = &cobra.Command{
+	Use:   "download-attachment <attachmentId>",
+	Short: "Download a Jira attachment",
+	Long: `Download an attachment's content by its ID.
+
+Without --output the file is saved in the current directory under its
+original name; an existing file is never overwritten, the new one gets a
+numeric suffix instead. --output may name a file (which is overwritten), a
+directory, or "-" for stdout.
+
+Examples:
+  atl jira download-attachment 10001
+  atl jira download-attachment 10001 --output ./downloads/
+  atl jira download-attachment 10001 --output report-final.pdf
+  atl jira download-attachment 10001 --output - | less`,
+	Args: cobra.ExactArgs(1),
+	RunE: runJiraDownloadAttachment,
+}
+
 var jiraRemoveIssueLinkCmd = &cobra.Command{
 	Use:   "remove-issue-link <issue-key>",
 	Short: "Remove link(s) between two issues",
@@ -298,6 +651,87 @@ Examples:
 	RunE: runJiraRemoveIssueLink,
 }
 
+var jiraCommentsSinceCmd = &cobra.Command{
+	Use:   "comments-since",
+	Short: "List recent comments on issues matching a JQL query",
+	Long: `Find comments added since a given time to issues matching a JQL query,
+grouped by issue. Handy for standup reports.
+
+--since accepts a relative offset (-30m, -12h, -1d, -2w) or a date
+(2024-01-15, "2024-01-15 09:00").
+
+--author matches a comment author's account ID, display name, or email.
+Use "me" for your own comments.
+
+Every matching issue is checked unless --max-issues limits it to the most
+recently updated ones, in which case a warning says if any were left out.
+
+Examples:
+  atl jira comments-since --jql "project = PROJ" --since -1d
+  atl jira comments-since --jql "project = PROJ AND sprint in openSprints()" --since -3d --author me
+  atl jira comments-since --jql "assignee = currentUser()" --since 2024-01-15 --json`,
+	Args: cobra.NoArgs,
+	RunE: runJiraCommentsSince,
+}
+
+var jiraWatchJQLCmd = &cobra.Command{
+	Use:   "watch-jql <jql-query>",
+	Short: "Poll a JQL query and print newly matching issues",
+	Long: `Poll a JQL query and print issues as they start to match it.
+
+The first poll records the issues that already match; after that only issues
+that weren't seen before are printed. Every matching issue is fetched on each
+poll, so an older issue that starts to match (for example after a status
+change) is reported too. A failed poll prints a warning and the watch carries
+on. Press Ctrl-C to stop.
+
+Examples:
+  atl jira watch-jql "project = OPS AND status = Open"
+  atl jira watch-jql "project = OPS AND priority = Highest" --interval 1m
+  atl jira watch-jql "project = OPS" --interval 30s --max-polls 10 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runJiraWatchJQL,
+}
+
+var jiraBulkAssignCmd = &cobra.Command{
+	Use:   "bulk-assign",
+	Short: "Distribute matching issues round-robin across a team",
+	Long: `Assign the issues matching a JQL query evenly across a list of accounts.
+
+Issues are taken oldest first and assigned to each account in turn. The
+account list comes from --round-robin or from a team saved in the config
+with --save-team (not saved with --dry-run). Failed assignments are reported
+and don't stop the rest.
+
+Examples:
+  atl jira bulk-assign --jql "project = SUP AND assignee is EMPTY" --round-robin acct1,acct2,acct3 --dry-run
+  atl jira bulk-assign --jql "project = SUP AND assignee is EMPTY" --round-robin acct1,acct2,acct3 --save-team support
+  atl jira bulk-assign --jql "project = SUP AND assignee is EMPTY" --team support --no-notify`,
+	Args: cobra.NoArgs,
+	RunE: runJiraBulkAssign,
+}
+
+var jiraBulkTransitionCmd = &cobra.Command{
+	Use:   "bulk-transition",
+	Short: "Transition every issue matching a JQL query",
+	Long: `Apply a transition to every issue matching a JQL query, following all
+pages of results.
+
+Issues are transitioned one at a time, with the usual retries on rate
+limiting. By default the first failure stops the batch; use
+--continue-on-error to attempt every issue. The command exits non-zero if
+any transition failed.
+
+Use get-transitions on one of the issues to find the transition ID.
+
+Examples:
+  atl jira bulk-transition --jql "project = PROJ AND status = Resolved AND type = Bug" --transition 31 --dry-run
+  atl jira bulk-transition --jql "project = PROJ AND status = Resolved AND type = Bug" --transition 31
+  atl jira bulk-transition --jql "sprint in closedSprints() AND status != Done" --transition 41 --continue-on-error`,
+	Args: cobra.NoArgs,
+	RunE: runJiraBulkTransition,
+}
+
 var (
 	// Flags for get-issue
 	jiraGetIssueFields         []string
@@ -305,31 +739,70 @@ var (
 	jiraGetIssueProperties     []string
 	jiraGetIssueFieldsByKeys   bool
 	jiraGetIssueUpdateHistory  bool
+	jiraGetIssueShowLinks      bool
+	jiraGetIssueOutput         string
+	jiraGetIssueResolveSprints bool
+	jiraGetIssueRemoteLinks    bool
+	jiraGetIssueBlockersDepth  int
+	jiraGetIssueTimeTracking   bool
+	jiraGetIssueDownloadDir    string
+	jiraGetIssueFlatten        bool
+	jiraGetIssueHighlight      string
+	jiraGetIssueWrap           int
+	jiraGetIssueMarkdownSafe   bool
+	jiraGetIssueShowRawADF     bool
 	outputJSON                 bool
 
 	// Flags for search-jql
-	jiraSearchFields     []string
-	jiraSearchMaxResults int
-	jiraSearchStartAt    int
+	jiraSearchFields      []string
+	jiraSearchMaxResults  int
+	jiraSearchStartAt     int
+	jiraSearchOrderBy     string
+	jiraSearchDesc        bool
+	jiraSearchAllAccounts bool
+	jiraSearchSortBy      string
+	jiraSearchHighlight   string
+	jiraSearchAll         bool
+	jiraSearchOutput      string
+	jiraSearchMaxColWidth int
 
 	// Flags for create-issue
-	jiraCreateProject     string
-	jiraCreateType        string
-	jiraCreateSummary     string
-	jiraCreateDescription string
-	jiraCreateAssignee    string
-	jiraCreateParent      string
-	jiraCreateFields      string
+	jiraCreateProject         string
+	jiraCreateType            string
+	jiraCreateSummary         string
+	jiraCreateDescription     string
+	jiraCreateDescriptionFile string
+	jiraCreateDescriptionADF  string
+	jiraCreateAssignee        string
+	jiraCreateParent          string
+	jiraCreateFields          string
+	jiraCreateValidate        bool
 
 	// Flags for edit-issue
-	jiraEditSummary     string
-	jiraEditDescription string
-	jiraEditAssignee    string
-	jiraEditFields      string
+	jiraEditSummary         string
+	jiraEditDescription     string
+	jiraEditDescriptionFile string
+	jiraEditDescriptionADF  string
+	jiraEditAssignee        string
+	jiraEditFields          string
+	jiraEditNoNotify        bool
 
 	// Flags for add-comment
 	jiraCommentVisibilityType  string
 	jiraCommentVisibilityValue string
+	jiraCommentFile            string
+
+	// Flags for get-comments
+	jiraCommentsStartAt    int
+	jiraCommentsMaxResults int
+	jiraCommentsOrderBy    string
+	jiraCommentsLatest     bool
+
+	// Flags for edit-comment
+	jiraEditCommentFile string
+
+	// Flags for delete-comment
+	jiraDeleteCommentYes bool
 
 	// Flags for get-transitions
 	jiraGetTransitionsExpand                      string
@@ -342,21 +815,72 @@ var (
 	jiraTransitionFields          string
 	jiraTransitionUpdate          string
 	jiraTransitionHistoryMetadata string
+	jiraTransitionPromptFields    bool
+	jiraTransitionNoValidate      bool
+	jiraTransitionToStatus        string
+
+	// Flags for lookup-account-id
+	jiraLookupActiveOnly bool
+	jiraLookupExact      bool
+	jiraLookupFirst      bool
 
 	// Flags for get-projects
-	jiraProjectsAction         string
-	jiraProjectsSearch         string
+	jiraProjectsAction           string
+	jiraProjectsSearch           string
 	jiraProjectsExpandIssueTypes bool
-	jiraProjectsMaxResults     int
-	jiraProjectsStartAt        int
+	jiraProjectsMaxResults       int
+	jiraProjectsStartAt          int
+	jiraProjectsOutput           string
+	jiraProjectsColumns          []string
+	jiraProjectsMaxColWidth      int
 
 	// Flags for get-project-issue-types
 	jiraIssueTypesMaxResults int
 	jiraIssueTypesStartAt    int
 
+	// Flags for get-project-roles
+	jiraProjectRolesMembers bool
+
+	// Flags for get-role-members
+	jiraRoleMembersMaxResults int
+	jiraRoleMembersStartAt    int
+
+	// Flags for create-version
+	jiraVersionProject     string
+	jiraVersionName        string
+	jiraVersionDescription string
+	jiraVersionStartDate   string
+	jiraVersionReleaseDate string
+
 	// Flags for get-remote-links
 	jiraRemoteLinksGlobalID string
 
+	// Flags for download-attachment
+	jiraDownloadAttachmentOutput string
+
+	// Flags for whoami
+	jiraWhoamiSave bool
+
+	// Flags for assign-issue
+	jiraAssignUnassign bool
+
+	// Flags for add-watcher
+	jiraWatcherRemove bool
+
+	// Flags for add-worklog
+	jiraWorklogTimeSpent      string
+	jiraWorklogComment        string
+	jiraWorklogStarted        string
+	jiraWorklogAdjustEstimate string
+	jiraWorklogNewEstimate    string
+	jiraWorklogReduceBy       string
+
+	// Flags for get-worklogs
+	jiraWorklogsStartedAfter  string
+	jiraWorklogsStartedBefore string
+	jiraWorklogsStartAt       int
+	jiraWorklogsMaxResults    int
+
 	// Flags for get-field-options
 	jiraFieldOptionsProject     string
 	jiraFieldOptionsIssueTypeID string
@@ -369,6 +893,32 @@ var (
 	// Flags for remove-issue-link
 	jiraRemoveLinkIssue string
 	jiraRemoveLinkType  string
+
+	// Flags for bulk-assign
+	jiraBulkAssignJQL        string
+	jiraBulkAssignRoundRobin []string
+	jiraBulkAssignTeam       string
+	jiraBulkAssignSaveTeam   string
+	jiraBulkAssignMaxIssues  int
+	jiraBulkAssignDryRun     bool
+	jiraBulkAssignNoNotify   bool
+
+	// Flags for bulk-transition
+	jiraBulkTransitionJQL             string
+	jiraBulkTransitionID              string
+	jiraBulkTransitionDryRun          bool
+	jiraBulkTransitionContinueOnError bool
+
+	// Flags for watch-jql
+	jiraWatchInterval   time.Duration
+	jiraWatchMaxPolls   int
+	jiraWatchMaxResults int
+
+	// Flags for comments-since
+	jiraCommentsSinceJQL       string
+	jiraCommentsSinceSince     string
+	jiraCommentsSinceAuthor    string
+	jiraCommentsSinceMaxIssues int
 )
 
 func init() {
@@ -377,12 +927,26 @@ func init() {
 	jiraCmd.AddCommand(jiraSearchJQLCmd)
 	jiraCmd.AddCommand(jiraCreateIssueCmd)
 	jiraCmd.AddCommand(jiraAddCommentCmd)
+	jiraCmd.AddCommand(jiraGetCommentsCmd)
+	jiraCmd.AddCommand(jiraEditCommentCmd)
+	jiraCmd.AddCommand(jiraDeleteCommentCmd)
 	jiraCmd.AddCommand(jiraEditIssueCmd)
 	jiraCmd.AddCommand(jiraGetTransitionsCmd)
 	jiraCmd.AddCommand(jiraTransitionIssueCmd)
 	jiraCmd.AddCommand(jiraLookupAccountIDCmd)
+	jiraCmd.AddCommand(jiraAssignIssueCmd)
+	jiraCmd.AddCommand(jiraAddWatcherCmd)
+	jiraCmd.AddCommand(jiraListWatchersCmd)
+	jiraCmd.AddCommand(jiraAddWorklogCmd)
+	jiraCmd.AddCommand(jiraGetWorklogsCmd)
 	jiraCmd.AddCommand(jiraGetProjectsCmd)
 	jiraCmd.AddCommand(jiraGetProjectIssueTypesCmd)
+	jiraCmd.AddCommand(jiraGetProjectRolesCmd)
+	jiraCmd.AddCommand(jiraGetRoleMembersCmd)
+	jiraCmd.AddCommand(jiraServerInfoCmd)
+	jiraCmd.AddCommand(jiraWhoamiCmd)
+	jiraCmd.AddCommand(jiraCreateVersionCmd)
+	jiraCmd.AddCommand(jiraReleaseVersionCmd)
 	jiraCmd.AddCommand(jiraGetRemoteLinksCmd)
 	jiraCmd.AddCommand(jiraGetCreateMetaCmd)
 	jiraCmd.AddCommand(jiraGetFieldOptionsCmd)
@@ -391,48 +955,107 @@ func init() {
 	jiraCmd.AddCommand(jiraCreateIssueLinkCmd)
 	jiraCmd.AddCommand(jiraRemoveIssueLinkCmd)
 	jiraCmd.AddCommand(jiraAddAttachmentCmd)
+	jiraCmd.AddCommand(jiraGetAttachmentsCmd)
+	jiraCmd.AddCommand(jiraDownloadAttachmentCmd)
+	jiraCmd.AddCommand(jiraCommentsSinceCmd)
+	jiraCmd.AddCommand(jiraWatchJQLCmd)
+	jiraCmd.AddCommand(jiraBulkAssignCmd)
+	jiraCmd.AddCommand(jiraBulkTransitionCmd)
 
 	// Flags for add-attachment
 	jiraAddAttachmentCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
 
+	// Flags for get-attachments
+	jiraGetAttachmentsCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for download-attachment
+	jiraDownloadAttachmentCmd.Flags().StringVarP(&jiraDownloadAttachmentOutput, "output", "o", "", "File or directory to save to, or \"-\" for stdout (default: original filename)")
+	jiraDownloadAttachmentCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
 	// Flags for get-issue
 	jiraGetIssueCmd.Flags().StringSliceVar(&jiraGetIssueFields, "fields", []string{}, "Comma-separated list of fields to return")
 	jiraGetIssueCmd.Flags().StringSliceVar(&jiraGetIssueExpand, "expand", []string{}, "Comma-separated list of parameters to expand")
 	jiraGetIssueCmd.Flags().StringSliceVar(&jiraGetIssueProperties, "properties", []string{}, "Comma-separated list of properties to return")
 	jiraGetIssueCmd.Flags().BoolVar(&jiraGetIssueFieldsByKeys, "fields-by-keys", false, "Return fields by keys instead of IDs")
 	jiraGetIssueCmd.Flags().BoolVar(&jiraGetIssueUpdateHistory, "update-history", false, "Include update history")
+	jiraGetIssueCmd.Flags().BoolVar(&jiraGetIssueShowLinks, "links", false, "Show linked issues grouped by relationship")
+	jiraGetIssueCmd.Flags().StringVar(&jiraGetIssueOutput, "output", "pretty", "Output format: pretty, pretty-wide, markdown, or json")
+	jiraGetIssueCmd.Flags().BoolVar(&jiraGetIssueResolveSprints, "resolve-sprints", false, "Show sprint names and states")
+	jiraGetIssueCmd.Flags().BoolVar(&jiraGetIssueRemoteLinks, "remote-links", false, "Include remote links (Confluence pages, external URLs)")
+	jiraGetIssueCmd.Flags().IntVar(&jiraGetIssueBlockersDepth, "blockers-depth", 0, fmt.Sprintf("Show blocking issues this many levels deep (max %d)", maxBlockersDepth))
+	jiraGetIssueCmd.Flags().BoolVar(&jiraGetIssueTimeTracking, "time-tracking", false, "Show original estimate, time spent, and remaining estimate")
+	jiraGetIssueCmd.Flags().StringVar(&jiraGetIssueDownloadDir, "download-attachments", "", "Download all attachments into this directory")
+	jiraGetIssueCmd.Flags().BoolVar(&jiraGetIssueFlatten, "flatten", false, "Output dotted key=value lines for every non-null value")
+	jiraGetIssueCmd.Flags().StringVar(&jiraGetIssueHighlight, "highlight-field", "", "Show this field (name or ID) prominently at the top")
+	jiraGetIssueCmd.Flags().IntVar(&jiraGetIssueWrap, "wrap", 0, "Wrap the description to this width (default: terminal width; 0 disables)")
+	jiraGetIssueCmd.Flags().BoolVar(&jiraGetIssueMarkdownSafe, "markdown-safe", false, "Escape literal *, _, ` and # in the description so it renders as valid markdown")
+	jiraGetIssueCmd.Flags().BoolVar(&jiraGetIssueShowRawADF, "show-raw-adf-on-parse-error", false, "Print the raw ADF when the description can't be rendered as text")
 	jiraGetIssueCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+	jiraGetIssueCmd.MarkFlagsMutuallyExclusive("flatten", "json")
 
 	// Flags for search-jql
 	jiraSearchJQLCmd.Flags().StringSliceVar(&jiraSearchFields, "fields", []string{}, "Comma-separated list of fields to return")
 	jiraSearchJQLCmd.Flags().IntVar(&jiraSearchMaxResults, "max-results", 50, "Maximum number of results to return (max 100)")
-	jiraSearchJQLCmd.Flags().IntVar(&jiraSearchStartAt, "start-at", 0, "Starting index for pagination")
-	jiraSearchJQLCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+	jiraSearchJQLCmd.Flags().IntVar(&jiraSearchStartAt, "start-at", 0, "Starting index for pagination (fetches a single page)")
+	jiraSearchJQLCmd.Flags().BoolVar(&jiraSearchAll, "all", false, "Fetch every page of results")
+	jiraSearchJQLCmd.Flags().StringVar(&jiraSearchOrderBy, "order-by", "", "Append an ORDER BY clause (e.g., \"updated DESC\") unless the query has one")
+	jiraSearchJQLCmd.Flags().BoolVar(&jiraSearchDesc, "desc", false, "Sort --order-by or --sort-by fields in descending order")
+	jiraSearchJQLCmd.Flags().StringVar(&jiraSearchSortBy, "sort-by", "", "Sort fetched results by a dotted field path (e.g. customfield_10016, status.name)")
+	jiraSearchJQLCmd.Flags().StringVar(&jiraSearchHighlight, "highlight-field", "", "Show this field (name or ID) for each issue")
+	jiraSearchJQLCmd.Flags().BoolVar(&jiraSearchAllAccounts, "all-accounts", false, "Run the query against every configured account")
+	jiraSearchJQLCmd.Flags().StringVar(&jiraSearchOutput, "output", "pretty", "Output format: pretty, json, csv, or table")
+	jiraSearchJQLCmd.Flags().IntVar(&jiraSearchMaxColWidth, "max-col-width", defaultMaxColWidth, "With --output table, truncate longer values (0 disables)")
+	jiraSearchJQLCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON (same as --output json)")
 
 	// Flags for create-issue
-	jiraCreateIssueCmd.Flags().StringVar(&jiraCreateProject, "project", "", "Project key (required)")
-	jiraCreateIssueCmd.Flags().StringVar(&jiraCreateType, "type", "", "Issue type (required, e.g., Task, Bug, Story)")
+	jiraCreateIssueCmd.Flags().StringVar(&jiraCreateProject, "project", "", "Project key (defaults to the jira-default-project setting)")
+	jiraCreateIssueCmd.Flags().StringVar(&jiraCreateType, "type", "", "Issue type, e.g., Task, Bug, Story (defaults to the jira-default-issue-type setting)")
 	jiraCreateIssueCmd.Flags().StringVar(&jiraCreateSummary, "summary", "", "Issue summary (required)")
 	jiraCreateIssueCmd.Flags().StringVar(&jiraCreateDescription, "description", "", "Issue description (supports markdown formatting)")
+	jiraCreateIssueCmd.Flags().StringVar(&jiraCreateDescriptionFile, "description-file", "", "Read a markdown description from this file (\"-\" for stdin)")
+	jiraCreateIssueCmd.Flags().StringVar(&jiraCreateDescriptionADF, "raw-description-adf", "", "Description as an ADF JSON document, sent as-is")
 	jiraCreateIssueCmd.Flags().StringVar(&jiraCreateAssignee, "assignee", "", "Assignee account ID")
-	jiraCreateIssueCmd.Flags().StringVar(&jiraCreateParent, "parent", "", "Parent issue key (for creating subtasks)")
+	jiraCreateIssueCmd.Flags().StringVar(&jiraCreateParent, "parent", "", "Parent issue key (a standard issue for sub-tasks, or an epic for standard issues)")
 	jiraCreateIssueCmd.Flags().StringVar(&jiraCreateFields, "fields", "", "Additional fields as JSON object")
+	jiraCreateIssueCmd.Flags().BoolVar(&jiraCreateValidate, "validate-only", false, "Check fields against the project's create metadata without creating the issue")
 	jiraCreateIssueCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
-	jiraCreateIssueCmd.MarkFlagRequired("project")
-	jiraCreateIssueCmd.MarkFlagRequired("type")
 	jiraCreateIssueCmd.MarkFlagRequired("summary")
+	jiraCreateIssueCmd.MarkFlagsMutuallyExclusive("description", "description-file", "raw-description-adf")
 
 	// Flags for add-comment
 	jiraAddCommentCmd.Flags().StringVar(&jiraCommentVisibilityType, "visibility-type", "", "Restrict visibility (group or role)")
 	jiraAddCommentCmd.Flags().StringVar(&jiraCommentVisibilityValue, "visibility-value", "", "Group or role name for visibility restriction")
+	jiraAddCommentCmd.Flags().StringVar(&jiraCommentFile, "comment-file", "", "Read a markdown comment from this file (\"-\" for stdin)")
 	jiraAddCommentCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
 
+	// Flags for get-comments
+	jiraGetCommentsCmd.Flags().IntVar(&jiraCommentsStartAt, "start-at", 0, "Starting index for pagination")
+	jiraGetCommentsCmd.Flags().IntVar(&jiraCommentsMaxResults, "max-results", 0, "Maximum number of comments to return (default: Jira's limit)")
+	jiraGetCommentsCmd.Flags().StringVar(&jiraCommentsOrderBy, "order-by", "created", "Sort order: created (oldest first) or -created (newest first)")
+	jiraGetCommentsCmd.Flags().BoolVar(&jiraCommentsLatest, "latest", false, "Only show the most recent comment")
+	jiraGetCommentsCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+	jiraGetCommentsCmd.MarkFlagsMutuallyExclusive("latest", "start-at")
+	jiraGetCommentsCmd.MarkFlagsMutuallyExclusive("latest", "max-results")
+	jiraGetCommentsCmd.MarkFlagsMutuallyExclusive("latest", "order-by")
+
+	// Flags for edit-comment
+	jiraEditCommentCmd.Flags().StringVar(&jiraEditCommentFile, "comment-file", "", "Read the new markdown body from this file (\"-\" for stdin)")
+	jiraEditCommentCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for delete-comment
+	jiraDeleteCommentCmd.Flags().BoolVarP(&jiraDeleteCommentYes, "yes", "y", false, "Delete without asking for confirmation")
+	jiraDeleteCommentCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
 	// Flags for edit-issue
 	jiraEditIssueCmd.Flags().StringVar(&jiraEditSummary, "summary", "", "New summary")
 	jiraEditIssueCmd.Flags().StringVar(&jiraEditDescription, "description", "", "New description (supports markdown formatting)")
+	jiraEditIssueCmd.Flags().StringVar(&jiraEditDescriptionFile, "description-file", "", "Read a markdown description from this file (\"-\" for stdin)")
+	jiraEditIssueCmd.Flags().StringVar(&jiraEditDescriptionADF, "raw-description-adf", "", "New description as an ADF JSON document, sent as-is")
 	jiraEditIssueCmd.Flags().StringVar(&jiraEditAssignee, "assignee", "", "Assignee account ID")
 	jiraEditIssueCmd.Flags().StringVar(&jiraEditFields, "fields", "", "Additional fields as JSON object")
+	jiraEditIssueCmd.Flags().BoolVar(&jiraEditNoNotify, "no-notify", false, "Don't email watchers about this change (requires admin permission)")
 	jiraEditIssueCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+	jiraEditIssueCmd.MarkFlagsMutuallyExclusive("description", "description-file", "raw-description-adf")
 
 	// Flags for get-transitions
 	jiraGetTransitionsCmd.Flags().StringVar(&jiraGetTransitionsExpand, "expand", "", "Expand details for transitions")
@@ -446,10 +1069,17 @@ func init() {
 	jiraTransitionIssueCmd.Flags().StringVar(&jiraTransitionFields, "fields", "", "Fields to set during transition as JSON object")
 	jiraTransitionIssueCmd.Flags().StringVar(&jiraTransitionUpdate, "update", "", "Update operations as JSON object")
 	jiraTransitionIssueCmd.Flags().StringVar(&jiraTransitionHistoryMetadata, "history-metadata", "", "History metadata as JSON object")
+	jiraTransitionIssueCmd.Flags().BoolVar(&jiraTransitionPromptFields, "fields-from-meta", false, "Prompt for fields the transition requires")
+	jiraTransitionIssueCmd.Flags().BoolVar(&jiraTransitionNoValidate, "no-validate", false, "Don't check the transition ID is available before transitioning")
+	jiraTransitionIssueCmd.Flags().StringVar(&jiraTransitionToStatus, "to-status", "", "Use the transition that leads to this status instead of a transition ID")
 	jiraTransitionIssueCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
 
 	// Flags for lookup-account-id
+	jiraLookupAccountIDCmd.Flags().BoolVar(&jiraLookupActiveOnly, "active-only", false, "Only include active users")
+	jiraLookupAccountIDCmd.Flags().BoolVar(&jiraLookupExact, "exact", false, "Only include users whose display name or email exactly matches")
+	jiraLookupAccountIDCmd.Flags().BoolVar(&jiraLookupFirst, "first", false, "Print only the account ID of the single matching user")
 	jiraLookupAccountIDCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+	jiraLookupAccountIDCmd.MarkFlagsMutuallyExclusive("first", "json")
 
 	// Flags for get-projects
 	jiraGetProjectsCmd.Flags().StringVar(&jiraProjectsAction, "action", "view", "Filter by permission (view, browse, edit, create)")
@@ -457,13 +1087,73 @@ func init() {
 	jiraGetProjectsCmd.Flags().BoolVar(&jiraProjectsExpandIssueTypes, "expand-issue-types", false, "Include issue types in response")
 	jiraGetProjectsCmd.Flags().IntVar(&jiraProjectsMaxResults, "max-results", 50, "Maximum results to return")
 	jiraGetProjectsCmd.Flags().IntVar(&jiraProjectsStartAt, "start-at", 0, "Starting index for pagination")
-	jiraGetProjectsCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+	jiraGetProjectsCmd.Flags().StringVar(&jiraProjectsOutput, "output", "pretty", "Output format: pretty, json, or table")
+	jiraGetProjectsCmd.Flags().StringSliceVar(&jiraProjectsColumns, "columns", []string{"key", "name", "projectTypeKey"}, "With --output table, the columns to show (dotted paths, e.g. lead.displayName)")
+	jiraGetProjectsCmd.Flags().IntVar(&jiraProjectsMaxColWidth, "max-col-width", defaultMaxColWidth, "With --output table, truncate longer values (0 disables)")
+	jiraGetProjectsCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON (same as --output json)")
 
 	// Flags for get-project-issue-types
 	jiraGetProjectIssueTypesCmd.Flags().IntVar(&jiraIssueTypesMaxResults, "max-results", 50, "Maximum results to return")
 	jiraGetProjectIssueTypesCmd.Flags().IntVar(&jiraIssueTypesStartAt, "start-at", 0, "Starting index for pagination")
 	jiraGetProjectIssueTypesCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
 
+	// Flags for get-project-roles
+	jiraGetProjectRolesCmd.Flags().BoolVar(&jiraProjectRolesMembers, "members", false, "Also list the members of each role")
+	jiraGetProjectRolesCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for get-role-members
+	jiraGetRoleMembersCmd.Flags().IntVar(&jiraRoleMembersMaxResults, "max-results", 50, "Maximum members to show")
+	jiraGetRoleMembersCmd.Flags().IntVar(&jiraRoleMembersStartAt, "start-at", 0, "Starting index for pagination")
+	jiraGetRoleMembersCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for serverinfo
+	jiraServerInfoCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for assign-issue
+	jiraAssignIssueCmd.Flags().BoolVar(&jiraAssignUnassign, "unassign", false, "Remove the current assignee")
+	jiraAssignIssueCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for add-watcher
+	jiraAddWatcherCmd.Flags().BoolVar(&jiraWatcherRemove, "remove", false, "Remove the user from the watchers instead")
+	jiraAddWatcherCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for list-watchers
+	jiraListWatchersCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for add-worklog
+	jiraAddWorklogCmd.Flags().StringVar(&jiraWorklogTimeSpent, "time-spent", "", "Time spent, e.g. \"2h 30m\" (required)")
+	jiraAddWorklogCmd.Flags().StringVar(&jiraWorklogComment, "comment", "", "Worklog comment (supports markdown formatting)")
+	jiraAddWorklogCmd.Flags().StringVar(&jiraWorklogStarted, "started", "", "When the work started, e.g. 2024-01-15T09:00:00.000+0000 (defaults to now)")
+	jiraAddWorklogCmd.Flags().StringVar(&jiraWorklogAdjustEstimate, "adjust-estimate", "", "How to update the remaining estimate (auto, new, leave, manual)")
+	jiraAddWorklogCmd.Flags().StringVar(&jiraWorklogNewEstimate, "new-estimate", "", "Remaining estimate to set with --adjust-estimate new")
+	jiraAddWorklogCmd.Flags().StringVar(&jiraWorklogReduceBy, "reduce-by", "", "Amount to reduce the estimate by with --adjust-estimate manual")
+	jiraAddWorklogCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+	jiraAddWorklogCmd.MarkFlagRequired("time-spent")
+
+	// Flags for get-worklogs
+	jiraGetWorklogsCmd.Flags().StringVar(&jiraWorklogsStartedAfter, "started-after", "", "Only worklogs started at or after this time (e.g. -1w, 2024-01-15)")
+	jiraGetWorklogsCmd.Flags().StringVar(&jiraWorklogsStartedBefore, "started-before", "", "Only worklogs started before this time (e.g. -1d, 2024-02-01)")
+	jiraGetWorklogsCmd.Flags().IntVar(&jiraWorklogsStartAt, "start-at", 0, "Starting index for pagination")
+	jiraGetWorklogsCmd.Flags().IntVar(&jiraWorklogsMaxResults, "max-results", 0, "Maximum number of worklogs to return (fetches a single page)")
+	jiraGetWorklogsCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for whoami
+	jiraWhoamiCmd.Flags().BoolVar(&jiraWhoamiSave, "save", false, "Save the account ID with the active account")
+	jiraWhoamiCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for create-version
+	jiraCreateVersionCmd.Flags().StringVar(&jiraVersionProject, "project", "", "Project key (defaults to the jira-default-project setting)")
+	jiraCreateVersionCmd.Flags().StringVar(&jiraVersionName, "name", "", "Version name (required)")
+	jiraCreateVersionCmd.Flags().StringVar(&jiraVersionDescription, "description", "", "Version description")
+	jiraCreateVersionCmd.Flags().StringVar(&jiraVersionStartDate, "start-date", "", "Start date (YYYY-MM-DD)")
+	jiraCreateVersionCmd.Flags().StringVar(&jiraVersionReleaseDate, "release-date", "", "Planned release date (YYYY-MM-DD)")
+	jiraCreateVersionCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+	jiraCreateVersionCmd.MarkFlagRequired("name")
+
+	// Flags for release-version
+	jiraReleaseVersionCmd.Flags().StringVar(&jiraVersionReleaseDate, "release-date", "", "Release date (YYYY-MM-DD, defaults to today)")
+	jiraReleaseVersionCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
 	// Flags for get-remote-links
 	jiraGetRemoteLinksCmd.Flags().StringVar(&jiraRemoteLinksGlobalID, "global-id", "", "Filter by global ID")
 	jiraGetRemoteLinksCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
@@ -492,30 +1182,108 @@ func init() {
 	// Flags for remove-issue-link
 	jiraRemoveIssueLinkCmd.Flags().StringVar(&jiraRemoveLinkIssue, "linked-issue", "", "The other issue to unlink from (required)")
 	jiraRemoveIssueLinkCmd.Flags().StringVar(&jiraRemoveLinkType, "type", "", "Only remove links of this type (e.g., 'blocks')")
+	jiraRemoveIssueLinkCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
 	jiraRemoveIssueLinkCmd.MarkFlagRequired("linked-issue")
+
+	// Flags for comments-since
+	jiraCommentsSinceCmd.Flags().StringVar(&jiraCommentsSinceJQL, "jql", "", "JQL query selecting the issues to check (required)")
+	jiraCommentsSinceCmd.Flags().StringVar(&jiraCommentsSinceSince, "since", "-1d", "Only include comments created after this time")
+	jiraCommentsSinceCmd.Flags().StringVar(&jiraCommentsSinceAuthor, "author", "", "Only include comments by this author (account ID, name, email, or 'me')")
+	jiraCommentsSinceCmd.Flags().IntVar(&jiraCommentsSinceMaxIssues, "max-issues", 0, "Only check this many of the most recently updated issues (max 100, 0 checks all)")
+	jiraCommentsSinceCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+	jiraCommentsSinceCmd.MarkFlagRequired("jql")
+
+	// Flags for bulk-assign
+	jiraBulkAssignCmd.Flags().StringVar(&jiraBulkAssignJQL, "jql", "", "JQL query selecting the issues to assign (required)")
+	jiraBulkAssignCmd.Flags().StringSliceVar(&jiraBulkAssignRoundRobin, "round-robin", nil, "Comma-separated account IDs to assign in turn")
+	jiraBulkAssignCmd.Flags().StringVar(&jiraBulkAssignTeam, "team", "", "Use the account IDs of a team saved in the config")
+	jiraBulkAssignCmd.Flags().StringVar(&jiraBulkAssignSaveTeam, "save-team", "", "Save the --round-robin list as a named team")
+	jiraBulkAssignCmd.Flags().IntVar(&jiraBulkAssignMaxIssues, "max-issues", 50, "Maximum number of issues to assign (max 100)")
+	jiraBulkAssignCmd.Flags().BoolVar(&jiraBulkAssignDryRun, "dry-run", false, "Show the planned assignments without making them")
+	jiraBulkAssignCmd.Flags().BoolVar(&jiraBulkAssignNoNotify, "no-notify", false, "Don't email watchers about these changes (requires admin permission)")
+	jiraBulkAssignCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+	jiraBulkAssignCmd.MarkFlagRequired("jql")
+	jiraBulkAssignCmd.MarkFlagsMutuallyExclusive("round-robin", "team")
+	jiraBulkAssignCmd.MarkFlagsOneRequired("round-robin", "team")
+
+	// Flags for bulk-transition
+	jiraBulkTransitionCmd.Flags().StringVar(&jiraBulkTransitionJQL, "jql", "", "JQL query selecting the issues to transition (required)")
+	jiraBulkTransitionCmd.Flags().StringVar(&jiraBulkTransitionID, "transition", "", "Transition ID to apply (required)")
+	jiraBulkTransitionCmd.Flags().BoolVar(&jiraBulkTransitionDryRun, "dry-run", false, "Show which issues would be transitioned without changing them")
+	jiraBulkTransitionCmd.Flags().BoolVar(&jiraBulkTransitionContinueOnError, "continue-on-error", false, "Keep going when an issue fails to transition")
+	jiraBulkTransitionCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+	jiraBulkTransitionCmd.MarkFlagRequired("jql")
+	jiraBulkTransitionCmd.MarkFlagRequired("transition")
+
+	// Flags for watch-jql
+	jiraWatchJQLCmd.Flags().DurationVar(&jiraWatchInterval, "interval", 30*time.Second, "Time between polls")
+	jiraWatchJQLCmd.Flags().IntVar(&jiraWatchMaxPolls, "max-polls", 0, "Stop after this many polls (0 polls until interrupted)")
+	jiraWatchJQLCmd.Flags().IntVar(&jiraWatchMaxResults, "max-results", 50, "Issues to fetch per request while paging through the matches (max 100)")
+	jiraWatchJQLCmd.Flags().BoolVar(&outputJSON, "json", false, "Output each new issue as JSON")
 }
 
 func runJiraGetIssue(cmd *cobra.Command, args []string) error {
 	issueKey := args[0]
 
-	// Load config and get active account
-	cfg, err := config.Load()
+	switch jiraGetIssueOutput {
+	case "pretty", "pretty-wide", "markdown":
+	case "json":
+		outputJSON = true
+	default:
+		return fmt.Errorf("invalid --output '%s'. Valid formats: pretty, pretty-wide, markdown, json", jiraGetIssueOutput)
+	}
+
+	if jiraGetIssueBlockersDepth < 0 || jiraGetIssueBlockersDepth > maxBlockersDepth {
+		return fmt.Errorf("--blockers-depth must be between 0 and %d", maxBlockersDepth)
+	}
+
+	var err error
+	jiraGetIssueWrap, err = resolveWrapWidth(cmd, jiraGetIssueWrap)
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return err
 	}
 
-	account, err := cfg.GetActiveAccount()
+	client, account, err := newClient()
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
+	}
+
+	// Make sure issue links are returned when a field list is given
+	fields := jiraGetIssueFields
+	if (jiraGetIssueShowLinks || jiraGetIssueBlockersDepth > 0) && len(fields) > 0 {
+		fields = appendField(fields, "issuelinks")
+	}
+	if jiraGetIssueTimeTracking && len(fields) > 0 {
+		fields = appendField(fields, "timetracking")
+	}
+	if jiraGetIssueDownloadDir != "" && len(fields) > 0 {
+		fields = appendField(fields, "attachment")
+	}
+	if jiraGetIssueHighlight != "" {
+		highlightedField, err = resolveHighlightField(client, jiraGetIssueHighlight)
+		if err != nil {
+			return err
+		}
+		if len(fields) > 0 {
+			fields = appendField(fields, highlightedField.ID)
+		}
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
+	// The wide table labels custom fields with their display names
+	expand := jiraGetIssueExpand
+	if jiraGetIssueOutput == "pretty-wide" && !outputJSON {
+		expand = appendField(expand, "names")
+	}
+
+	// The field schema identifies the sprint field, whose ID varies by site
+	if jiraGetIssueResolveSprints && !outputJSON {
+		expand = appendField(expand, "schema")
+	}
 
 	// Build request options
 	opts := &atlassian.GetIssueOptions{
-		Fields:        jiraGetIssueFields,
-		Expand:        jiraGetIssueExpand,
+		Fields:        fields,
+		Expand:        expand,
 		Properties:    jiraGetIssueProperties,
 		FieldsByKeys:  jiraGetIssueFieldsByKeys,
 		UpdateHistory: jiraGetIssueUpdateHistory,
@@ -527,28 +1295,227 @@ func runJiraGetIssue(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get issue: %w", err)
 	}
 
+	if jiraGetIssueRemoteLinks {
+		remoteLinks, err := client.GetIssueRemoteLinks(issueKey, nil)
+		if err != nil {
+			return fmt.Errorf("failed to get remote links: %w", err)
+		}
+		issue["remoteLinks"] = remoteLinks
+	}
+
+	var blockers []*atlassian.BlockerNode
+	if jiraGetIssueBlockersDepth > 0 {
+		blockers = getIssueBlockers(client, issue, jiraGetIssueBlockersDepth)
+		issue["blockers"] = blockers
+	}
+
 	// Output
-	if outputJSON {
+	if jiraGetIssueFlatten {
+		if err := printFlattened(issue); err != nil {
+			return err
+		}
+	} else if outputJSON {
 		// JSON output
-		output, err := json.MarshalIndent(issue, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(issue); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
+	} else if jiraGetIssueOutput == "pretty-wide" {
+		printIssueWide(issue)
+	} else if jiraGetIssueOutput == "markdown" {
+		printIssueMarkdown(issue, account.Site)
 	} else {
 		// Pretty output (default)
 		printIssuePretty(issue)
 	}
 
+	if jiraGetIssueBlockersDepth > 0 && !outputJSON && !jiraGetIssueFlatten {
+		printBlockerTree(blockers, jiraGetIssueOutput == "markdown")
+	}
+
+	if jiraGetIssueDownloadDir != "" {
+		return downloadIssueAttachments(client, issue, jiraGetIssueDownloadDir)
+	}
+
+	return nil
+}
+
+// maxBlockersDepth bounds --blockers-depth, since every level can fan out
+// into one request per blocking issue
+const maxBlockersDepth = 10
+
+// getIssueBlockers builds the tree of issues blocking issue, depth levels
+// deep
+func getIssueBlockers(client *atlassian.Client, issue map[string]any, depth int) []*atlassian.BlockerNode {
+	key, _ := issue["key"].(string)
+	fields, _ := issue["fields"].(map[string]any)
+
+	fetch := func(key string) (map[string]any, error) {
+		linked, err := client.GetJiraIssue(key, &atlassian.GetIssueOptions{
+			Fields: []string{"summary", "status", "issuelinks"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		linkedFields, _ := linked["fields"].(map[string]any)
+		return linkedFields, nil
+	}
+
+	return atlassian.BuildBlockerTree(key, fields, depth, fetch)
+}
+
+// printBlockerTree prints blocking issues as an indented list, nesting each
+// issue's own blockers under it
+func printBlockerTree(blockers []*atlassian.BlockerNode, markdown bool) {
+	if markdown {
+		fmt.Printf("\n## Blockers\n\n")
+	} else {
+		fmt.Printf("\nBlockers:\n")
+	}
+	if len(blockers) == 0 {
+		if markdown {
+			fmt.Println("_No blockers._")
+		} else {
+			fmt.Printf("  (none)\n")
+		}
+		return
+	}
+
+	indent := "  "
+	if markdown {
+		indent = ""
+	}
+
+	var walk func(nodes []*atlassian.BlockerNode, prefix string)
+	walk = func(nodes []*atlassian.BlockerNode, prefix string) {
+		for _, node := range nodes {
+			line := node.Key
+			if node.Status != "" {
+				line += fmt.Sprintf(" [%s]", node.Status)
+			}
+			if node.Summary != "" {
+				line += " " + node.Summary
+			}
+			switch {
+			case node.Repeated:
+				line += " (already shown)"
+			case node.Error != "":
+				line += fmt.Sprintf(" (failed to get blockers: %s)", node.Error)
+			}
+			fmt.Printf("%s- %s\n", prefix, line)
+			walk(node.BlockedBy, prefix+"  ")
+		}
+	}
+	walk(blockers, indent)
+}
+
+// downloadIssueAttachments saves every attachment on the issue into dir.
+// Progress goes to stderr in JSON and flattened modes so stdout stays
+// machine-readable.
+func downloadIssueAttachments(client *atlassian.Client, issue map[string]any, dir string) error {
+	out := os.Stdout
+	if outputJSON || jiraGetIssueFlatten {
+		out = os.Stderr
+	}
+
+	fields, _ := issue["fields"].(map[string]any)
+	raw, _ := json.Marshal(fields["attachment"])
+	var attachments []atlassian.Attachment
+	if err := json.Unmarshal(raw, &attachments); err != nil {
+		return fmt.Errorf("failed to read attachments: %w", err)
+	}
+
+	if len(attachments) == 0 {
+		fmt.Fprintln(out, "\nNo attachments to download")
+		return nil
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+
+	fmt.Fprintf(out, "\nDownloading %d attachment(s) to %s:\n", len(attachments), dir)
+	failed := 0
+	for _, attachment := range attachments {
+		path, err := downloadAttachmentFile(client, &attachment, dir, out)
+		if err != nil {
+			fmt.Fprintf(out, "  ✗ %s: %v\n", attachment.Filename, err)
+			failed++
+			continue
+		}
+		info, err := os.Stat(path)
+		if err == nil {
+			fmt.Fprintf(out, "  ✓ %s (%s)\n", path, formatByteSize(info.Size()))
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d attachment(s) failed to download", failed, len(attachments))
+	}
 	return nil
 }
 
+// downloadAttachmentFile writes one attachment into dir. If a file with the
+// same name already exists the new one gets a numeric suffix (report-1.pdf)
+// instead of overwriting it.
+func downloadAttachmentFile(client *atlassian.Client, attachment *atlassian.Attachment, dir string, out io.Writer) (string, error) {
+	name := filepath.Base(attachment.Filename)
+	if name == "." || name == string(filepath.Separator) || name == "" {
+		name = "attachment-" + attachment.ID
+	}
+	ext := filepath.Ext(name)
+	stem := strings.TrimSuffix(name, ext)
+
+	path := filepath.Join(dir, name)
+	var f *os.File
+	for i := 1; ; i++ {
+		var err error
+		f, err = os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if err == nil {
+			break
+		}
+		if !os.IsExist(err) {
+			return "", err
+		}
+		path = filepath.Join(dir, fmt.Sprintf("%s-%d%s", stem, i, ext))
+	}
+	if filepath.Base(path) != name {
+		fmt.Fprintf(out, "  ! %s already exists, saving as %s\n", name, filepath.Base(path))
+	}
+
+	_, err := client.DownloadAttachment(attachment, f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		return "", err
+	}
+	return path, nil
+}
+
+// formatByteSize formats a byte count for display, e.g. "1.5 MB"
+func formatByteSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
 func printIssuePretty(issue map[string]any) {
 	// Extract common fields
 	key, _ := issue["key"].(string)
 	fields, _ := issue["fields"].(map[string]any)
 
 	fmt.Printf("Issue: %s\n", key)
+	if highlightedField != nil {
+		fmt.Printf(">> %s\n", highlightedFieldText(fields))
+	}
 
 	if fields != nil {
 		if summary, ok := fields["summary"].(string); ok {
@@ -574,17 +1541,13 @@ func printIssuePretty(issue map[string]any) {
 		}
 
 		if assignee, ok := fields["assignee"].(map[string]any); ok {
-			if displayName, ok := assignee["displayName"].(string); ok {
-				fmt.Printf("Assignee: %s\n", displayName)
-			}
+			printIssueUser("Assignee", assignee)
 		} else {
 			fmt.Printf("Assignee: Unassigned\n")
 		}
 
 		if reporter, ok := fields["reporter"].(map[string]any); ok {
-			if displayName, ok := reporter["displayName"].(string); ok {
-				fmt.Printf("Reporter: %s\n", displayName)
-			}
+			printIssueUser("Reporter", reporter)
 		}
 
 		if created, ok := fields["created"].(string); ok {
@@ -595,53 +1558,533 @@ func printIssuePretty(issue map[string]any) {
 			fmt.Printf("Updated: %s\n", updated)
 		}
 
+		if jiraGetIssueResolveSprints {
+			printIssueSprints(issue)
+		}
+
+		if jiraGetIssueTimeTracking {
+			printIssueTimeTracking(fields)
+		}
+
 		// Parse and display description using ADF parser
 		if description, ok := fields["description"]; ok && description != nil {
 			fmt.Printf("\nDescription:\n")
-			descText := atlassian.ADFToText(description)
+			descText := atlassian.ADFToTextWithOptions(description, &atlassian.ADFToTextOptions{
+				MarkdownSafe: jiraGetIssueMarkdownSafe,
+			})
 			if descText != "" {
-				// Indent description text
-				lines := strings.Split(descText, "\n")
-				for _, line := range lines {
-					fmt.Printf("  %s\n", line)
-				}
+				printIndentedText(descText, jiraGetIssueWrap)
+			} else if atlassian.ADFHasContent(description) {
+				printUnrenderedDescription(description)
 			} else {
 				fmt.Printf("  (empty)\n")
 			}
 		}
+
+		if jiraGetIssueShowLinks {
+			printIssueLinksGrouped(fields)
+		}
+	}
+
+	if remoteLinks, ok := issue["remoteLinks"].([]map[string]any); ok {
+		printIssueRemoteLinks(remoteLinks)
 	}
 
 	fmt.Printf("\n---\n")
 	fmt.Printf("For JSON output: atl jira get-issue %s --json\n", key)
 }
 
-func runJiraSearchJQL(cmd *cobra.Command, args []string) error {
-	jql := args[0]
+// printIssueSprints prints the issue's sprints as "Sprint: Sprint 42 (active)".
+// The sprint field is found via the schema expansion when present, falling
+// back to recognizing sprint-shaped values.
+func printIssueSprints(issue map[string]any) {
+	names := issueSprintNames(issue)
+	if len(names) == 0 {
+		fmt.Printf("Sprint: None\n")
+		return
+	}
+	fmt.Printf("Sprint: %s\n", strings.Join(names, ", "))
+}
 
-	// Validate max-results
-	if jiraSearchMaxResults > 100 {
-		return fmt.Errorf("max-results cannot exceed 100")
+// printIssueUser prints a user field with the email address and account ID
+// indented beneath it. Jira omits the email when the user's privacy settings
+// hide it.
+func printIssueUser(label string, user map[string]any) {
+	displayName, _ := user["displayName"].(string)
+	fmt.Printf("%s: %s\n", label, displayName)
+
+	email, _ := user["emailAddress"].(string)
+	if email == "" {
+		email = "(hidden)"
 	}
+	fmt.Printf("  Email: %s\n", email)
 
-	// Load config and get active account
-	cfg, err := config.Load()
+	if accountID, ok := user["accountId"].(string); ok {
+		fmt.Printf("  Account ID: %s\n", accountID)
+	}
+}
+
+// printIssueMarkdown renders an issue as a self-contained markdown document:
+// the summary as a heading, a metadata table, then the description
+func printIssueMarkdown(issue map[string]any, site string) {
+	key, _ := issue["key"].(string)
+	fields, _ := issue["fields"].(map[string]any)
+	summary, _ := fields["summary"].(string)
+
+	webURL := fmt.Sprintf("%s/browse/%s", site, key)
+	if !strings.HasPrefix(site, "http") {
+		webURL = "https://" + webURL
+	}
+
+	fmt.Printf("# [%s](%s): %s\n\n", key, webURL, summary)
+
+	metadata := []struct {
+		label string
+		field string
+	}{
+		{"Type", "issuetype"},
+		{"Status", "status"},
+		{"Priority", "priority"},
+		{"Assignee", "assignee"},
+		{"Reporter", "reporter"},
+		{"Parent", "parent"},
+		{"Labels", "labels"},
+		{"Components", "components"},
+		{"Fix versions", "fixVersions"},
+		{"Created", "created"},
+		{"Updated", "updated"},
+	}
+
+	fmt.Println("| Field | Value |")
+	fmt.Println("| --- | --- |")
+	for _, m := range metadata {
+		value := formatFieldValue(fields[m.field])
+		if m.field == "assignee" && value == "" {
+			value = "Unassigned"
+		}
+		if value == "" {
+			continue
+		}
+		fmt.Printf("| %s | %s |\n", m.label, strings.ReplaceAll(value, "|", `\|`))
+	}
+
+	if jiraGetIssueResolveSprints {
+		if names := issueSprintNames(issue); len(names) > 0 {
+			fmt.Printf("| Sprint | %s |\n", strings.Join(names, ", "))
+		}
+	}
+
+	if jiraGetIssueTimeTracking {
+		if summary := issueTimeTracking(fields); summary != "" {
+			fmt.Printf("| Time tracking | %s |\n", summary)
+		}
+	}
+
+	fmt.Printf("\n## Description\n\n")
+	if description := atlassian.ADFToMarkdown(fields["description"]); description != "" {
+		fmt.Println(description)
+	} else {
+		fmt.Println("_No description._")
+	}
+
+	if remoteLinks, ok := issue["remoteLinks"].([]map[string]any); ok && len(remoteLinks) > 0 {
+		fmt.Printf("\n## Remote Links\n\n")
+		for _, link := range remoteLinks {
+			obj, _ := link["object"].(map[string]any)
+			url, _ := obj["url"].(string)
+			title, _ := obj["title"].(string)
+			if title == "" {
+				title = url
+			}
+			fmt.Printf("- [%s](%s)\n", title, url)
+		}
+	}
+}
+
+func printIssueTimeTracking(fields map[string]any) {
+	summary := issueTimeTracking(fields)
+	if summary == "" {
+		summary = "None"
+	}
+	fmt.Printf("Time tracking: %s\n", summary)
+}
+
+// issueTimeTracking summarizes the timetracking field as e.g.
+// "Estimate: 2d, Spent: 1d 4h, Remaining: 4h"
+func issueTimeTracking(fields map[string]any) string {
+	tracking, _ := fields["timetracking"].(map[string]any)
+
+	var parts []string
+	for _, f := range []struct {
+		label string
+		key   string
+	}{
+		{"Estimate", "originalEstimate"},
+		{"Spent", "timeSpent"},
+		{"Remaining", "remainingEstimate"},
+	} {
+		value, _ := tracking[f.key].(string)
+		if value == "" {
+			// Older responses may only carry the seconds value
+			if seconds, ok := tracking[f.key+"Seconds"].(float64); ok {
+				value = formatWorkDuration(int(seconds))
+			}
+		}
+		if value != "" {
+			parts = append(parts, fmt.Sprintf("%s: %s", f.label, value))
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// formatWorkDuration formats seconds the way Jira does, using its default
+// 8-hour working day (e.g. 36000 → "1d 2h")
+func formatWorkDuration(seconds int) string {
+	if seconds <= 0 {
+		return "0m"
+	}
+
+	var parts []string
+	for _, unit := range []struct {
+		suffix  string
+		seconds int
+	}{
+		{"d", 8 * 3600},
+		{"h", 3600},
+		{"m", 60},
+	} {
+		if n := seconds / unit.seconds; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d%s", n, unit.suffix))
+			seconds %= unit.seconds
+		}
+	}
+	if len(parts) == 0 {
+		return "0m"
+	}
+	return strings.Join(parts, " ")
+}
+
+// issueSprintNames finds the issue's sprint field and returns each sprint's
+// name with its state
+func issueSprintNames(issue map[string]any) []string {
+	fields, _ := issue["fields"].(map[string]any)
+	schema, _ := issue["schema"].(map[string]any)
+
+	var sprints []atlassian.Sprint
+	for id, value := range fields {
+		fieldSchema, _ := schema[id].(map[string]any)
+		custom, _ := fieldSchema["custom"].(string)
+		if custom == atlassian.SprintFieldType || (schema == nil && atlassian.IsSprintValue(value)) {
+			sprints = append(sprints, atlassian.ParseSprints(value)...)
+		}
+	}
+
+	var names []string
+	for _, sprint := range sprints {
+		if sprint.State != "" {
+			names = append(names, fmt.Sprintf("%s (%s)", sprint.Name, sprint.State))
+		} else {
+			names = append(names, sprint.Name)
+		}
+	}
+	return names
+}
+
+// printUnrenderedDescription explains a description that has content but
+// converted to no text, naming the node types the converter didn't
+// recognize. With --show-raw-adf-on-parse-error the raw ADF is printed too.
+func printUnrenderedDescription(description any) {
+	fmt.Printf("  (could not be rendered)\n")
+	if types := atlassian.ADFUnhandledNodeTypes(description); len(types) > 0 {
+		fmt.Printf("  Unrecognized node types: %s\n", strings.Join(types, ", "))
+	}
+
+	if !jiraGetIssueShowRawADF {
+		fmt.Printf("  Use --show-raw-adf-on-parse-error or --json to see the raw description.\n")
+		return
+	}
+
+	raw, err := json.MarshalIndent(description, "  ", "  ")
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return
+	}
+	fmt.Printf("\n  %s\n", raw)
+}
+
+// printIssueLinksGrouped prints an issue's links grouped by relationship,
+// e.g. "blocks: ABC-5 (Open), ABC-7 (Done)"
+func printIssueLinksGrouped(fields map[string]any) {
+	issueLinks, _ := fields["issuelinks"].([]any)
+
+	fmt.Printf("\nLinks:\n")
+	if len(issueLinks) == 0 {
+		fmt.Printf("  (none)\n")
+		return
+	}
+
+	var relationships []string
+	grouped := map[string][]string{}
+
+	for _, l := range issueLinks {
+		link, _ := l.(map[string]any)
+		linkType, _ := link["type"].(map[string]any)
+
+		// The side that is present is the OTHER issue; its key determines the direction
+		var other map[string]any
+		var relationship string
+		if outward, ok := link["outwardIssue"].(map[string]any); ok {
+			other = outward
+			relationship, _ = linkType["outward"].(string)
+		} else if inward, ok := link["inwardIssue"].(map[string]any); ok {
+			other = inward
+			relationship, _ = linkType["inward"].(string)
+		}
+		if other == nil {
+			continue
+		}
+
+		otherKey, _ := other["key"].(string)
+		otherFields, _ := other["fields"].(map[string]any)
+		status, _ := otherFields["status"].(map[string]any)
+		statusName, _ := status["name"].(string)
+
+		entry := otherKey
+		if statusName != "" {
+			entry = fmt.Sprintf("%s (%s)", otherKey, statusName)
+		}
+
+		if _, seen := grouped[relationship]; !seen {
+			relationships = append(relationships, relationship)
+		}
+		grouped[relationship] = append(grouped[relationship], entry)
+	}
+
+	for _, relationship := range relationships {
+		fmt.Printf("  %s: %s\n", relationship, strings.Join(grouped[relationship], ", "))
+	}
+}
+
+// printIssueRemoteLinks prints an issue's remote links as "title: url"
+func printIssueRemoteLinks(remoteLinks []map[string]any) {
+	fmt.Printf("\nRemote Links:\n")
+	if len(remoteLinks) == 0 {
+		fmt.Printf("  (none)\n")
+		return
+	}
+
+	for _, link := range remoteLinks {
+		obj, _ := link["object"].(map[string]any)
+		url, _ := obj["url"].(string)
+		title, _ := obj["title"].(string)
+		if title == "" || title == url {
+			fmt.Printf("  %s\n", url)
+		} else {
+			fmt.Printf("  %s: %s\n", title, url)
+		}
+	}
+}
+
+// appendField adds field to the list unless it is already present. It is
+// also used for --expand lists.
+func appendField(fields []string, field string) []string {
+	for _, f := range fields {
+		if f == field || f == "*all" {
+			return fields
+		}
+	}
+	result := make([]string, len(fields), len(fields)+1)
+	copy(result, fields)
+	return append(result, field)
+}
+
+func runJiraSearchJQL(cmd *cobra.Command, args []string) error {
+	jql := args[0]
+
+	// Validate max-results
+	if jiraSearchMaxResults > 100 {
+		return fmt.Errorf("max-results cannot exceed 100")
+	}
+
+	switch jiraSearchOutput {
+	case "pretty":
+	case "csv", "table":
+		if outputJSON {
+			return fmt.Errorf("--json can't be combined with --output %s", jiraSearchOutput)
+		}
+	case "json":
+		outputJSON = true
+	default:
+		return fmt.Errorf("invalid --output '%s'. Valid formats: pretty, json, csv, table", jiraSearchOutput)
+	}
+	if jiraSearchMaxColWidth < 0 {
+		return fmt.Errorf("--max-col-width must not be negative")
+	}
+	outputCSV := jiraSearchOutput == "csv"
+	outputTable := jiraSearchOutput == "table"
+
+	// Append ORDER BY clause if requested (user-supplied ORDER BY wins)
+	if jiraSearchDesc && jiraSearchOrderBy == "" && jiraSearchSortBy == "" {
+		return fmt.Errorf("--desc requires --order-by or --sort-by")
+	}
+	jql, err := atlassian.ApplyJQLOrderBy(jql, jiraSearchOrderBy, jiraSearchDesc && jiraSearchOrderBy != "")
+	if err != nil {
+		return err
 	}
 
-	account, err := cfg.GetActiveAccount()
+	fields, err := resolveListSetting("jira-default-fields", jiraSearchFields)
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
+	// CSV and table columns follow --fields when given
+	var csvColumns []string
+	if outputCSV || outputTable {
+		csvColumns = searchCSVColumns
+		if len(jiraSearchFields) > 0 {
+			csvColumns = []string{"key"}
+			fields = nil
+			for _, field := range jiraSearchFields {
+				csvColumns = appendField(csvColumns, field)
+				if field == "type" {
+					field = "issuetype"
+				}
+				if field != "key" {
+					fields = appendField(fields, field)
+				}
+			}
+		} else {
+			fields = atlassian.DefaultSearchFields
+		}
+	}
+
+	// Resolve the client-side sort path, and make sure its field is fetched
+	sortPath := ""
+	if jiraSearchSortBy != "" {
+		sortPath = issueSortPath(jiraSearchSortBy)
+		if field, ok := strings.CutPrefix(sortPath, "fields."); ok && len(fields) > 0 {
+			fields = appendField(fields, strings.Split(field, ".")[0])
+		}
+	}
 
 	// Build request options
 	opts := &atlassian.SearchJQLOptions{
-		Fields:     jiraSearchFields,
+		Fields:     fields,
 		MaxResults: jiraSearchMaxResults,
 		StartAt:    jiraSearchStartAt,
+		FetchAll:   jiraSearchAll,
+	}
+
+	if jiraSearchAllAccounts {
+		if jiraSearchHighlight != "" {
+			// Custom field IDs differ from site to site
+			return fmt.Errorf("--highlight-field can't be combined with --all-accounts")
+		}
+
+		results, err := fanOutAccounts(func(client *atlassian.Client) (map[string]any, error) {
+			result, err := client.SearchJiraIssuesJQL(jql, opts)
+			if err == nil && sortPath != "" {
+				if issues, ok := result["issues"].([]any); ok {
+					sortByPath(issues, sortPath, jiraSearchDesc)
+				}
+			}
+			return result, err
+		})
+		if err != nil {
+			return err
+		}
+
+		if outputJSON {
+			if err := printJSON(results); err != nil {
+				return err
+			}
+		} else if outputTable {
+			var rows [][]string
+			for _, r := range results {
+				issues, _ := r.Result["issues"].([]any)
+				for _, item := range issues {
+					issue, _ := item.(map[string]any)
+					rows = append(rows, append([]string{r.Account}, searchCSVRow(issue, csvColumns)...))
+				}
+			}
+			printTable(tableHeaders(append([]string{"account"}, csvColumns...)), rows, jiraSearchMaxColWidth)
+		} else if outputCSV {
+			w := csv.NewWriter(os.Stdout)
+			w.Write(append([]string{"account"}, csvColumns...))
+			for _, r := range results {
+				issues, _ := r.Result["issues"].([]any)
+				for _, item := range issues {
+					issue, _ := item.(map[string]any)
+					w.Write(append([]string{r.Account}, searchCSVRow(issue, csvColumns)...))
+				}
+			}
+			w.Flush()
+			if err := w.Error(); err != nil {
+				return fmt.Errorf("failed to write CSV: %w", err)
+			}
+		} else {
+			printSearchResultsByAccount(results)
+		}
+		return reportAccountErrors(results)
+	}
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	if jiraSearchHighlight != "" {
+		highlightedField, err = resolveHighlightField(client, jiraSearchHighlight)
+		if err != nil {
+			return err
+		}
+		if len(opts.Fields) == 0 {
+			opts.Fields = atlassian.DefaultSearchFields
+		}
+		opts.Fields = appendField(opts.Fields, highlightedField.ID)
+	}
+
+	// Print each page as it arrives when nothing needs the full set (a
+	// table needs every row to size its columns)
+	if jiraSearchAll && !outputJSON && !outputTable && sortPath == "" {
+		count := 0
+		var w *csv.Writer
+		if outputCSV {
+			w = csv.NewWriter(os.Stdout)
+			w.Write(csvColumns)
+		}
+		opts.OnPage = func(issues []any) error {
+			for _, issue := range issues {
+				if issueMap, ok := issue.(map[string]any); ok {
+					count++
+					if w != nil {
+						w.Write(searchCSVRow(issueMap, csvColumns))
+					} else {
+						printSearchIssue(count, issueMap)
+					}
+				}
+			}
+			if w != nil {
+				w.Flush()
+				return w.Error()
+			}
+			return nil
+		}
+
+		if _, err := client.SearchJiraIssuesJQL(jql, opts); err != nil {
+			return fmt.Errorf("failed to search issues: %w", err)
+		}
+		if outputCSV {
+			return nil
+		}
+
+		if count == 0 {
+			fmt.Println("No issues found.")
+			return nil
+		}
+		fmt.Printf("Showing %d issue(s)\n", count)
+		fmt.Printf("\nFor JSON output with all fields: atl jira search-jql \"<query>\" --json\n")
+		return nil
 	}
 
 	// Search issues
@@ -650,14 +2093,31 @@ func runJiraSearchJQL(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to search issues: %w", err)
 	}
 
+	if sortPath != "" {
+		if issues, ok := result["issues"].([]any); ok {
+			sortByPath(issues, sortPath, jiraSearchDesc)
+		}
+	}
+
 	// Output
 	if outputJSON {
 		// JSON output
-		output, err := json.MarshalIndent(result, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(result); err != nil {
+			return err
+		}
+	} else if outputCSV {
+		if err := printSearchResultsCSV(result, csvColumns); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
+	} else if outputTable {
+		issues, _ := result["issues"].([]any)
+		rows := make([][]string, 0, len(issues))
+		for _, item := range issues {
+			if issue, ok := item.(map[string]any); ok {
+				rows = append(rows, searchCSVRow(issue, csvColumns))
+			}
+		}
+		printTable(tableHeaders(csvColumns), rows, jiraSearchMaxColWidth)
 	} else {
 		// Pretty output (default)
 		printSearchResults(result)
@@ -666,6 +2126,57 @@ func runJiraSearchJQL(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// searchCSVColumns are the --output csv and table columns used when --fields
+// isn't given
+var searchCSVColumns = []string{"key", "summary", "status", "type", "assignee", "priority", "created", "updated"}
+
+// printSearchResultsCSV prints search results as CSV with a header row
+func printSearchResultsCSV(result map[string]any, columns []string) error {
+	w := csv.NewWriter(os.Stdout)
+	w.Write(columns)
+	issues, _ := result["issues"].([]any)
+	for _, item := range issues {
+		if issue, ok := item.(map[string]any); ok {
+			w.Write(searchCSVRow(issue, columns))
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
+
+// searchCSVRow returns an issue's value for each CSV column. "key" and "id"
+// are read from the issue itself, "type" from issuetype, and anything else
+// from the field with that ID.
+func searchCSVRow(issue map[string]any, columns []string) []string {
+	fields, _ := issue["fields"].(map[string]any)
+	row := make([]string, len(columns))
+	for i, column := range columns {
+		switch column {
+		case "key", "id":
+			row[i], _ = issue[column].(string)
+		case "type":
+			row[i] = formatFieldValue(fields["issuetype"])
+		default:
+			row[i] = formatFieldValue(fields[column])
+		}
+	}
+	return row
+}
+
+// issueSortPath turns a --sort-by value into a path within an issue. Paths
+// are relative to the issue's fields unless they name a top-level property.
+func issueSortPath(sortBy string) string {
+	root := strings.Split(sortBy, ".")[0]
+	switch root {
+	case "key", "id", "fields":
+		return sortBy
+	}
+	return "fields." + sortBy
+}
+
 func printSearchResults(result map[string]any) {
 	issues, _ := result["issues"].([]any)
 	isLast, _ := result["isLast"].(bool)
@@ -681,75 +2192,139 @@ func printSearchResults(result map[string]any) {
 
 	for i, issue := range issues {
 		if issueMap, ok := issue.(map[string]any); ok {
-			key, _ := issueMap["key"].(string)
-			fields, _ := issueMap["fields"].(map[string]any)
+			printSearchIssue(i+1, issueMap)
+		}
+	}
+
+	// Show pagination info
+	if !isLast && nextPageToken != "" {
+		fmt.Printf("---\n")
+		fmt.Printf("More issues available.\n")
+		fmt.Printf("Use --all to fetch every page, or --max-results to increase the page size (max 100).\n")
+	}
+
+	fmt.Printf("\nFor JSON output with all fields: atl jira search-jql \"<query>\" --json\n")
+}
 
-			fmt.Printf("%d. %s", i+1, key)
+// printSearchIssue prints one numbered search result with its type, status,
+// and assignee
+func printSearchIssue(n int, issue map[string]any) {
+	key, _ := issue["key"].(string)
+	fields, _ := issue["fields"].(map[string]any)
 
-			if fields != nil {
-				if summary, ok := fields["summary"].(string); ok {
-					fmt.Printf(": %s", summary)
-				}
+	fmt.Printf("%d. %s", n, key)
+
+	if fields != nil {
+		if summary, ok := fields["summary"].(string); ok {
+			fmt.Printf(": %s", summary)
+		}
+	}
+	fmt.Println()
+
+	if fields != nil {
+		// Show type, status, assignee on same line
+		parts := []string{}
+
+		if issueType, ok := fields["issuetype"].(map[string]any); ok {
+			if name, ok := issueType["name"].(string); ok {
+				parts = append(parts, fmt.Sprintf("Type: %s", name))
 			}
-			fmt.Println()
+		}
 
-			if fields != nil {
-				// Show type, status, assignee on same line
-				parts := []string{}
+		if status, ok := fields["status"].(map[string]any); ok {
+			if name, ok := status["name"].(string); ok {
+				parts = append(parts, fmt.Sprintf("Status: %s", name))
+			}
+		}
 
-				if issueType, ok := fields["issuetype"].(map[string]any); ok {
-					if name, ok := issueType["name"].(string); ok {
-						parts = append(parts, fmt.Sprintf("Type: %s", name))
-					}
-				}
+		if assignee, ok := fields["assignee"].(map[string]any); ok {
+			if displayName, ok := assignee["displayName"].(string); ok {
+				parts = append(parts, fmt.Sprintf("Assignee: %s", displayName))
+			}
+		} else {
+			parts = append(parts, "Assignee: Unassigned")
+		}
 
-				if status, ok := fields["status"].(map[string]any); ok {
-					if name, ok := status["name"].(string); ok {
-						parts = append(parts, fmt.Sprintf("Status: %s", name))
-					}
-				}
+		if highlightedField != nil {
+			parts = append(parts, highlightedFieldText(fields))
+		}
 
-				if assignee, ok := fields["assignee"].(map[string]any); ok {
-					if displayName, ok := assignee["displayName"].(string); ok {
-						parts = append(parts, fmt.Sprintf("Assignee: %s", displayName))
-					}
-				} else {
-					parts = append(parts, "Assignee: Unassigned")
-				}
+		if len(parts) > 0 {
+			fmt.Printf("   %s\n", strings.Join(parts, " | "))
+		}
+	}
+	fmt.Println()
+}
 
-				if len(parts) > 0 {
-					fmt.Printf("   %s\n", strings.Join(parts, " | "))
-				}
+// printSearchResultsByAccount prints merged search results from several
+// accounts as a table with an Account column
+func printSearchResultsByAccount(results []accountResult) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ACCOUNT\tKEY\tSTATUS\tASSIGNEE\tSUMMARY")
+
+	total := 0
+	for _, r := range results {
+		issues, _ := r.Result["issues"].([]any)
+		for _, item := range issues {
+			issue, _ := item.(map[string]any)
+			key, _ := issue["key"].(string)
+			fields, _ := issue["fields"].(map[string]any)
+			summary, _ := fields["summary"].(string)
+			status, _ := fields["status"].(map[string]any)
+			statusName, _ := status["name"].(string)
+
+			assigneeName := "Unassigned"
+			if assignee, ok := fields["assignee"].(map[string]any); ok {
+				assigneeName, _ = assignee["displayName"].(string)
 			}
-			fmt.Println()
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.Account, key, statusName, assigneeName, summary)
+			total++
 		}
 	}
 
-	// Show pagination info
-	if !isLast && nextPageToken != "" {
-		fmt.Printf("---\n")
-		fmt.Printf("More issues available.\n")
-		fmt.Printf("Note: Pagination with next page tokens is not yet implemented.\n")
-		fmt.Printf("Use --max-results to increase the number of results (max 100).\n")
+	if total == 0 {
+		fmt.Println("No issues found.")
+		return
 	}
 
-	fmt.Printf("\nFor JSON output with all fields: atl jira search-jql \"<query>\" --json\n")
+	w.Flush()
+	fmt.Printf("\nShowing %d issue(s) across %d account(s)\n", total, len(results))
 }
 
 func runJiraCreateIssue(cmd *cobra.Command, args []string) error {
-	// Load config and get active account
-	cfg, err := config.Load()
+	var err error
+	jiraCreateProject, err = resolveProjectKey(jiraCreateProject)
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return err
 	}
-
-	account, err := cfg.GetActiveAccount()
+	jiraCreateType, err = resolveSetting("jira-default-issue-type", jiraCreateType)
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
+	}
+	if jiraCreateType == "" {
+		return fmt.Errorf("--type is required (or set a default with 'atl config set jira-default-issue-type Task')")
+	}
+
+	if jiraCreateDescriptionFile != "" {
+		jiraCreateDescription, err = readInputFile(jiraCreateDescriptionFile, "description")
+		if err != nil {
+			return err
+		}
+	}
+
+	var descriptionADF map[string]any
+	if jiraCreateDescriptionADF != "" {
+		descriptionADF, err = atlassian.ParseADFDocument(jiraCreateDescriptionADF)
+		if err != nil {
+			return fmt.Errorf("invalid --raw-description-adf: %w", err)
+		}
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
+	client, account, err := newClient()
+	if err != nil {
+		return err
+	}
 
 	// Parse additional fields if provided
 	var additionalFields map[string]any
@@ -759,7 +2334,22 @@ func runJiraCreateIssue(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	// Check for local image references in description
+	if jiraCreateValidate {
+		return validateCreateIssue(client, additionalFields, descriptionADF)
+	}
+
+	// Catch invalid parents before Jira rejects them with an opaque 400
+	if jiraCreateParent != "" {
+		issueType, err := resolveIssueType(client, jiraCreateProject, jiraCreateType)
+		if err != nil {
+			return err
+		}
+		if err := checkCreateParent(client, issueType); err != nil {
+			return err
+		}
+	}
+
+	// Check for local image references in description
 	var imageRefs []atlassian.ImageRef
 	description := jiraCreateDescription
 	if description != "" {
@@ -775,6 +2365,8 @@ func runJiraCreateIssue(cmd *cobra.Command, args []string) error {
 		AssigneeID:  jiraCreateAssignee,
 		ParentKey:   jiraCreateParent,
 		Fields:      additionalFields,
+
+		DescriptionADF: descriptionADF,
 	}
 
 	result, err := client.CreateJiraIssue(opts)
@@ -851,11 +2443,9 @@ func runJiraCreateIssue(cmd *cobra.Command, args []string) error {
 
 	if outputJSON {
 		// JSON output
-		output, err := json.MarshalIndent(result, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(result); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
 	} else {
 		// Pretty output (default)
 		id, _ := result["id"].(string)
@@ -871,454 +2461,1395 @@ func runJiraCreateIssue(cmd *cobra.Command, args []string) error {
 		if imageCount > 0 {
 			fmt.Printf("  Images: %d attached inline\n", imageCount)
 		}
-		fmt.Printf("  Link: %s\n", webURL)
-		fmt.Printf("\nView details: atl jira get-issue %s\n", key)
+		fmt.Printf("  Link: %s\n", webURL)
+		fmt.Printf("\nView details: atl jira get-issue %s\n", key)
+	}
+
+	return nil
+}
+
+// validateCreateIssue checks the create-issue flags against the create
+// metadata for the project and issue type, without creating anything. It
+// returns an error when validation fails so the command exits non-zero.
+func validateCreateIssue(client *atlassian.Client, additionalFields, descriptionADF map[string]any) error {
+	issueType, err := resolveIssueType(client, jiraCreateProject, jiraCreateType)
+	if err != nil {
+		return err
+	}
+	issueTypeID, _ := issueType["id"].(string)
+
+	meta, err := client.GetCreateMeta(jiraCreateProject, issueTypeID)
+	if err != nil {
+		return fmt.Errorf("failed to get create metadata: %w", err)
+	}
+
+	// Mirror the fields CreateJiraIssue would send
+	fields := map[string]any{
+		"project":   map[string]any{"key": jiraCreateProject},
+		"issuetype": map[string]any{"id": issueTypeID},
+		"summary":   jiraCreateSummary,
+	}
+	if jiraCreateDescription != "" {
+		fields["description"] = jiraCreateDescription
+	}
+	if descriptionADF != nil {
+		fields["description"] = descriptionADF
+	}
+	if jiraCreateAssignee != "" {
+		fields["assignee"] = map[string]any{"id": jiraCreateAssignee}
+	}
+	if jiraCreateParent != "" {
+		fields["parent"] = map[string]any{"key": jiraCreateParent}
+	}
+	for k, v := range additionalFields {
+		fields[k] = v
+	}
+
+	problems := atlassian.ValidateCreateFields(meta, fields)
+
+	if jiraCreateParent != "" {
+		if err := checkCreateParent(client, issueType); err != nil {
+			problems = append(problems, atlassian.FieldProblem{Field: "parent", Name: "Parent", Problem: err.Error()})
+		}
+	}
+
+	if outputJSON {
+		if problems == nil {
+			problems = []atlassian.FieldProblem{}
+		}
+		if err := printJSON(map[string]any{"valid": len(problems) == 0, "problems": problems}); err != nil {
+			return err
+		}
+	} else if len(problems) == 0 {
+		fmt.Printf("✓ Fields are valid for a %s in %s (nothing was created)\n", jiraCreateType, jiraCreateProject)
+	} else {
+		fmt.Printf("✗ Fields are not valid for a %s in %s:\n", jiraCreateType, jiraCreateProject)
+		for _, p := range problems {
+			if p.Name != "" {
+				fmt.Printf("  %s (%s): %s\n", p.Field, p.Name, p.Problem)
+			} else {
+				fmt.Printf("  %s: %s\n", p.Field, p.Problem)
+			}
+		}
+		fmt.Printf("\nSee all fields: atl jira get-create-meta %s %s\n", jiraCreateProject, issueTypeID)
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("validation failed with %d problem(s)", len(problems))
+	}
+	return nil
+}
+
+// checkCreateParent fetches the --parent issue and checks that an issue of
+// the given type can be created under it
+func checkCreateParent(client *atlassian.Client, issueType map[string]any) error {
+	parent, err := client.GetJiraIssue(jiraCreateParent, &atlassian.GetIssueOptions{
+		Fields: []string{"issuetype", "project"},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get parent issue %s: %w", jiraCreateParent, err)
+	}
+
+	if err := atlassian.CheckParentRelationship(issueType, jiraCreateProject, parent); err != nil {
+		return fmt.Errorf("invalid --parent: %w", err)
+	}
+	return nil
+}
+
+// resolveIssueType finds a project's issue type by name (case-insensitive)
+// or ID
+func resolveIssueType(client *atlassian.Client, projectKey, nameOrID string) (map[string]any, error) {
+	issueTypes, err := client.GetProjectIssueTypes(projectKey, &atlassian.GetProjectIssueTypesOptions{MaxResults: 200})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue types: %w", err)
+	}
+
+	var names []string
+	for _, issueType := range issueTypes {
+		id, _ := issueType["id"].(string)
+		name, _ := issueType["name"].(string)
+		if id == nameOrID || strings.EqualFold(name, nameOrID) {
+			return issueType, nil
+		}
+		names = append(names, name)
+	}
+
+	return nil, fmt.Errorf("issue type '%s' not found in project %s. Available types: %s", nameOrID, projectKey, strings.Join(names, ", "))
+}
+
+func runJiraAddComment(cmd *cobra.Command, args []string) error {
+	issueKey := args[0]
+
+	comment, fromFile, err := resolveCommentText(args[1:], jiraCommentFile)
+	if err != nil {
+		return err
+	}
+
+	// Validate visibility flags
+	if (jiraCommentVisibilityType != "" && jiraCommentVisibilityValue == "") ||
+		(jiraCommentVisibilityType == "" && jiraCommentVisibilityValue != "") {
+		return fmt.Errorf("both --visibility-type and --visibility-value must be provided together")
+	}
+
+	if jiraCommentVisibilityType != "" && jiraCommentVisibilityType != "group" && jiraCommentVisibilityType != "role" {
+		return fmt.Errorf("--visibility-type must be 'group' or 'role'")
+	}
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	// Add comment
+	opts := &atlassian.AddCommentOptions{
+		Comment:         comment,
+		VisibilityType:  jiraCommentVisibilityType,
+		VisibilityValue: jiraCommentVisibilityValue,
+	}
+	if fromFile {
+		adf, warnings, err := atlassian.MarkdownToADF(comment)
+		for _, w := range warnings {
+			fmt.Fprintf(os.Stderr, "Warning: %s\n", w)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to convert comment to ADF: %w", err)
+		}
+		opts.Body = adf
+	}
+
+	result, err := client.AddCommentToIssue(issueKey, opts)
+	if err != nil {
+		return fmt.Errorf("failed to add comment: %w", err)
+	}
+
+	if outputJSON {
+		// JSON output
+		if err := printJSON(result); err != nil {
+			return err
+		}
+	} else {
+		// Pretty output (default)
+		id, _ := result["id"].(string)
+		fmt.Printf("✓ Added comment to %s\n", issueKey)
+		fmt.Printf("  Comment ID: %s\n", id)
+	}
+
+	return nil
+}
+
+func runJiraGetComments(cmd *cobra.Command, args []string) error {
+	issueKey := args[0]
+
+	if jiraCommentsOrderBy != "created" && jiraCommentsOrderBy != "-created" {
+		return fmt.Errorf("--order-by must be 'created' or '-created'")
+	}
+
+	opts := &atlassian.GetIssueCommentsOptions{
+		StartAt:    jiraCommentsStartAt,
+		MaxResults: jiraCommentsMaxResults,
+		OrderBy:    jiraCommentsOrderBy,
+	}
+	if jiraCommentsLatest {
+		opts.MaxResults = 1
+		opts.OrderBy = "-created"
+	}
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	result, err := client.GetIssueComments(issueKey, opts)
+	if err != nil {
+		return fmt.Errorf("failed to get comments: %w", err)
+	}
+
+	if outputJSON {
+		return printJSON(result)
+	}
+
+	comments, _ := result["comments"].([]any)
+	if len(comments) == 0 {
+		fmt.Printf("No comments on %s\n", issueKey)
+		return nil
+	}
+
+	if jiraCommentsLatest {
+		fmt.Printf("Latest comment on %s:\n", issueKey)
+	} else {
+		startAt, _ := result["startAt"].(float64)
+		total, _ := result["total"].(float64)
+		fmt.Printf("Comments on %s (showing %d-%d of %d):\n", issueKey, int(startAt)+1, int(startAt)+len(comments), int(total))
+	}
+
+	for _, c := range comments {
+		comment, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		id, _ := comment["id"].(string)
+		authorInfo, _ := comment["author"].(map[string]any)
+		authorName, _ := authorInfo["displayName"].(string)
+
+		created, _ := comment["created"].(string)
+		if createdAt, err := time.Parse(atlassian.JiraTimeLayout, created); err == nil {
+			created = createdAt.Local().Format("2006-01-02 15:04")
+		}
+
+		fmt.Printf("\n  [%s] %s (comment %s):\n", created, authorName, id)
+		if text := strings.TrimSpace(atlassian.ADFToText(comment["body"])); text != "" {
+			for _, line := range strings.Split(text, "\n") {
+				fmt.Printf("    %s\n", line)
+			}
+		}
+	}
+
+	return nil
+}
+
+func runJiraEditComment(cmd *cobra.Command, args []string) error {
+	issueKey, commentID := args[0], args[1]
+
+	body, _, err := resolveCommentText(args[2:], jiraEditCommentFile)
+	if err != nil {
+		return err
+	}
+
+	adf, warnings, err := atlassian.MarkdownToADF(body)
+	for _, w := range warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", w)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to convert comment to ADF: %w", err)
+	}
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	result, err := client.EditComment(issueKey, commentID, adf)
+	if err != nil {
+		return fmt.Errorf("failed to edit comment: %w", err)
+	}
+
+	if outputJSON {
+		return printJSON(result)
+	}
+
+	fmt.Printf("✓ Updated comment %s on %s\n", commentID, issueKey)
+	return nil
+}
+
+func runJiraDeleteComment(cmd *cobra.Command, args []string) error {
+	issueKey, commentID := args[0], args[1]
+
+	if !jiraDeleteCommentYes {
+		ok, err := confirmAction(fmt.Sprintf("Delete comment %s on %s?", commentID, issueKey))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Fprintln(os.Stderr, "Cancelled")
+			return nil
+		}
+	}
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteComment(issueKey, commentID); err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	return printNoContentResult(true, fmt.Sprintf("Deleted comment %s from %s", commentID, issueKey))
+}
+
+// resolveCommentText returns the comment from the optional positional
+// argument or from --comment-file ("-" reads stdin), and whether it came from
+// the file. Exactly one of the two must be given.
+func resolveCommentText(args []string, file string) (string, bool, error) {
+	if len(args) > 0 && file != "" {
+		return "", false, fmt.Errorf("give the comment as an argument or with --comment-file, not both")
+	}
+	if file == "" {
+		if len(args) == 0 {
+			return "", false, fmt.Errorf("a comment is required (as an argument or with --comment-file)")
+		}
+		return args[0], false, nil
+	}
+
+	comment, err := readInputFile(file, "comment")
+	if err != nil {
+		return "", false, err
+	}
+	return comment, true, nil
+}
+
+// readInputFile reads text content for a --*-file flag, with "-" meaning
+// stdin. what names the content in error messages. Surrounding whitespace is
+// trimmed and an empty file is an error.
+func readInputFile(path, what string) (string, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s file: %w", what, err)
+	}
+
+	content := strings.TrimSpace(string(data))
+	if content == "" {
+		return "", fmt.Errorf("%s file %s is empty", what, path)
+	}
+	return content, nil
+}
+
+func runJiraEditIssue(cmd *cobra.Command, args []string) error {
+	issueKey := args[0]
+
+	if jiraEditDescriptionFile != "" {
+		description, err := readInputFile(jiraEditDescriptionFile, "description")
+		if err != nil {
+			return err
+		}
+		jiraEditDescription = description
+	}
+
+	var descriptionADF map[string]any
+	if jiraEditDescriptionADF != "" {
+		var err error
+		descriptionADF, err = atlassian.ParseADFDocument(jiraEditDescriptionADF)
+		if err != nil {
+			return fmt.Errorf("invalid --raw-description-adf: %w", err)
+		}
+	}
+
+	// Check if at least one field is provided
+	if jiraEditSummary == "" && jiraEditDescription == "" && descriptionADF == nil && jiraEditAssignee == "" && jiraEditFields == "" {
+		return fmt.Errorf("at least one field must be provided (--summary, --description, --description-file, --raw-description-adf, --assignee, or --fields)")
+	}
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	// Build fields to update
+	fields := make(map[string]any)
+
+	// Parse additional fields first
+	if jiraEditFields != "" {
+		if err := json.Unmarshal([]byte(jiraEditFields), &fields); err != nil {
+			return fmt.Errorf("invalid --fields JSON: %w", err)
+		}
+	}
+
+	// Specific flags override --fields
+	if jiraEditSummary != "" {
+		fields["summary"] = jiraEditSummary
+	}
+
+	var imageCount int
+	if jiraEditDescription != "" {
+		// Check for local image references
+		imageRefs, cleanedDesc := atlassian.ExtractLocalImages(jiraEditDescription)
+
+		if len(imageRefs) > 0 {
+			// Check existing attachments to avoid re-uploading
+			existing := getExistingAttachments(client, issueKey)
+
+			// Upload images and build media nodes
+			var mediaNodes []map[string]any
+			for _, img := range imageRefs {
+				filePath := img.FilePath
+				var tmpPath string
+
+				// Download remote images to a temp file first
+				if img.Remote {
+					var err error
+					tmpPath, err = downloadImageToTemp(img.FilePath)
+					if err != nil {
+						fmt.Printf("Warning: %v\n", err)
+						continue
+					}
+					filePath = tmpPath
+				}
+
+				// Check if this file was already uploaded
+				filename := filepath.Base(filePath)
+				if att, ok := existing[filename]; ok {
+					mediaID, err := client.GetAttachmentMediaID(att)
+					if tmpPath != "" {
+						os.Remove(tmpPath)
+					}
+					if err == nil {
+						mediaNodes = append(mediaNodes, atlassian.BuildMediaSingleNode(mediaID, img.AltText))
+						imageCount++
+						continue
+					}
+				}
+
+				attachments, err := client.AddAttachment(issueKey, filePath)
+				if tmpPath != "" {
+					os.Remove(tmpPath)
+				}
+				if err != nil {
+					fmt.Printf("Warning: failed to upload %s: %v\n", img.FilePath, err)
+					continue
+				}
+				if len(attachments) == 0 {
+					fmt.Printf("Warning: no attachment returned for %s\n", img.FilePath)
+					continue
+				}
+				mediaID, err := client.GetAttachmentMediaID(&attachments[0])
+				if err != nil {
+					fmt.Printf("Warning: failed to get media ID for %s: %v\n", img.FilePath, err)
+					continue
+				}
+				mediaNodes = append(mediaNodes, atlassian.BuildMediaSingleNode(mediaID, img.AltText))
+				imageCount++
+			}
+
+			// Convert cleaned markdown to ADF with media nodes
+			adf, warnings, err := atlassian.MarkdownToADFWithImages(cleanedDesc, mediaNodes)
+			for _, w := range warnings {
+				fmt.Printf("Warning: %s\n", w)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to convert description to ADF: %w", err)
+			}
+			fields["description"] = adf
+		} else {
+			adf, warnings, err := atlassian.MarkdownToADF(jiraEditDescription)
+			for _, w := range warnings {
+				fmt.Printf("Warning: %s\n", w)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to convert description to ADF: %w", err)
+			}
+			fields["description"] = adf
+		}
+	}
+	if descriptionADF != nil {
+		fields["description"] = descriptionADF
+	}
+
+	if jiraEditAssignee != "" {
+		fields["assignee"] = map[string]any{
+			"id": jiraEditAssignee,
+		}
+	}
+
+	// Edit issue
+	editOpts := &atlassian.EditIssueOptions{SkipNotifications: jiraEditNoNotify}
+	err = client.EditJiraIssueWithOptions(issueKey, fields, editOpts)
+	if err != nil {
+		return fmt.Errorf("failed to edit issue: %w", err)
+	}
+
+	if err := printNoContentResult(true, fmt.Sprintf("Updated issue %s", issueKey)); err != nil {
+		return err
+	}
+	if !outputJSON {
+		if jiraEditSummary != "" {
+			fmt.Printf("  Summary: %s\n", jiraEditSummary)
+		}
+		if jiraEditDescription != "" || descriptionADF != nil {
+			fmt.Printf("  Description: updated\n")
+		}
+		if imageCount > 0 {
+			fmt.Printf("  Images: %d attached inline\n", imageCount)
+		}
+		if jiraEditAssignee != "" {
+			fmt.Printf("  Assignee: %s\n", jiraEditAssignee)
+		}
+		if jiraEditFields != "" {
+			fmt.Printf("  Additional fields: updated\n")
+		}
+	}
+
+	return nil
+}
+
+func runJiraAddAttachment(cmd *cobra.Command, args []string) error {
+	issueKey := args[0]
+	filePaths := args[1:]
+
+	// Check every file up front so a typo doesn't leave a partial upload
+	for _, filePath := range filePaths {
+		info, err := os.Stat(filePath)
+		if err != nil {
+			return fmt.Errorf("cannot attach %s: %w", filePath, err)
+		}
+		if info.IsDir() {
+			return fmt.Errorf("cannot attach %s: is a directory", filePath)
+		}
+	}
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	var allAttachments []atlassian.Attachment
+
+	for _, filePath := range filePaths {
+		attachments, err := client.AddAttachment(issueKey, filePath)
+		if err != nil {
+			return fmt.Errorf("failed to upload %s: %w", filePath, err)
+		}
+		allAttachments = append(allAttachments, attachments...)
+	}
+
+	if outputJSON {
+		if err := printJSON(allAttachments); err != nil {
+			return err
+		}
+	} else {
+		for _, att := range allAttachments {
+			fmt.Printf("✓ Attached %s (%s) to %s (attachment ID: %s)\n", att.Filename, formatByteSize(att.Size), issueKey, att.ID)
+		}
+	}
+
+	return nil
+}
+
+func runJiraGetAttachments(cmd *cobra.Command, args []string) error {
+	issueKey := args[0]
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	issue, err := client.GetJiraIssue(issueKey, &atlassian.GetIssueOptions{Fields: []string{"attachment"}})
+	if err != nil {
+		return fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	fields, _ := issue["fields"].(map[string]any)
+	raw, _ := json.Marshal(fields["attachment"])
+	attachments := []atlassian.Attachment{}
+	if err := json.Unmarshal(raw, &attachments); err != nil {
+		return fmt.Errorf("failed to read attachments: %w", err)
+	}
+
+	if outputJSON {
+		return printJSON(attachments)
+	}
+
+	if len(attachments) == 0 {
+		fmt.Printf("No attachments on %s\n", issueKey)
+		return nil
+	}
+
+	fmt.Printf("%d attachment(s) on %s:\n\n", len(attachments), issueKey)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tFILENAME\tSIZE\tTYPE\tAUTHOR")
+	for _, attachment := range attachments {
+		author := ""
+		if attachment.Author != nil {
+			author = attachment.Author.DisplayName
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", attachment.ID, attachment.Filename, formatByteSize(attachment.Size), attachment.MimeType, author)
+	}
+	return w.Flush()
+}
+
+func runJiraDownloadAttachment(cmd *cobra.Command, args []string) error {
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	attachment, err := client.GetAttachment(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to get attachment: %w", err)
+	}
+
+	output := jiraDownloadAttachmentOutput
+	if output == "-" {
+		if _, err := client.DownloadAttachment(attachment, os.Stdout); err != nil {
+			return fmt.Errorf("failed to download %s: %w", attachment.Filename, err)
+		}
+		return nil
+	}
+
+	var path string
+	if info, statErr := os.Stat(output); output == "" || (statErr == nil && info.IsDir()) {
+		dir := output
+		if dir == "" {
+			dir = "."
+		}
+		path, err = downloadAttachmentFile(client, attachment, dir, os.Stderr)
+	} else {
+		path = output
+		err = downloadAttachmentTo(client, attachment, path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to download %s: %w", attachment.Filename, err)
+	}
+
+	var size int64
+	if info, err := os.Stat(path); err == nil {
+		size = info.Size()
+	}
+
+	if outputJSON {
+		return printJSON(map[string]any{
+			"id":       attachment.ID,
+			"filename": attachment.Filename,
+			"path":     path,
+			"size":     size,
+		})
+	}
+
+	fmt.Printf("✓ Downloaded %s (%s) to %s\n", attachment.Filename, formatByteSize(size), path)
+	return nil
+}
+
+// downloadAttachmentTo writes an attachment to path, replacing any existing
+// file. A partial file is removed if the download fails.
+func downloadAttachmentTo(client *atlassian.Client, attachment *atlassian.Attachment, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DownloadAttachment(attachment, f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		return err
+	}
+	return nil
+}
+
+func runJiraGetTransitions(cmd *cobra.Command, args []string) error {
+	issueKey := args[0]
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	// Build options
+	opts := &atlassian.GetTransitionsOptions{
+		Expand:                        jiraGetTransitionsExpand,
+		TransitionID:                  jiraGetTransitionsTransitionID,
+		IncludeUnavailableTransitions: jiraGetTransitionsIncludeUnavailable,
+		SkipRemoteOnlyCondition:       jiraGetTransitionsSkipRemoteOnly,
+		SortByOpsBarAndStatus:         jiraGetTransitionsSortByOpsBarAndStatus,
+	}
+
+	// Get transitions
+	result, err := client.GetIssueTransitions(issueKey, opts)
+	if err != nil {
+		return fmt.Errorf("failed to get transitions: %w", err)
+	}
+
+	if outputJSON {
+		// JSON output
+		if err := printJSON(result); err != nil {
+			return err
+		}
+	} else {
+		// Pretty output (default)
+		transitions, _ := result["transitions"].([]any)
+
+		if len(transitions) == 0 {
+			fmt.Printf("No transitions available for %s\n", issueKey)
+			return nil
+		}
+
+		fmt.Printf("Available transitions for %s:\n\n", issueKey)
+
+		for _, t := range transitions {
+			if trans, ok := t.(map[string]any); ok {
+				id, _ := trans["id"].(string)
+				name, _ := trans["name"].(string)
+
+				// Get destination status
+				to, _ := trans["to"].(map[string]any)
+				toName, _ := to["name"].(string)
+
+				fmt.Printf("  ID: %-4s  → %s", id, name)
+				if toName != "" {
+					fmt.Printf(" (to: %s)", toName)
+				}
+				fmt.Println()
+			}
+		}
+
+		fmt.Printf("\nTo transition: atl jira transition-issue %s <transition-id>\n", issueKey)
+	}
+
+	return nil
+}
+
+func runJiraTransitionIssue(cmd *cobra.Command, args []string) error {
+	issueKey := args[0]
+	if len(args) < 2 && jiraTransitionToStatus == "" {
+		return fmt.Errorf("give a transition ID or --to-status")
+	}
+	if len(args) == 2 && jiraTransitionToStatus != "" {
+		return fmt.Errorf("a transition ID can't be given with --to-status")
+	}
+	transitionID := ""
+	if len(args) == 2 {
+		transitionID = args[1]
+	}
+
+	// Parse JSON parameters if provided
+	var fields, update, historyMetadata map[string]any
+
+	if jiraTransitionFields != "" {
+		if err := json.Unmarshal([]byte(jiraTransitionFields), &fields); err != nil {
+			return fmt.Errorf("invalid --fields JSON: %w", err)
+		}
+	}
+	if jiraTransitionUpdate != "" {
+		if err := json.Unmarshal([]byte(jiraTransitionUpdate), &update); err != nil {
+			return fmt.Errorf("invalid --update JSON: %w", err)
+		}
+	}
+	if jiraTransitionHistoryMetadata != "" {
+		if err := json.Unmarshal([]byte(jiraTransitionHistoryMetadata), &historyMetadata); err != nil {
+			return fmt.Errorf("invalid --history-metadata JSON: %w", err)
+		}
+	}
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	if jiraTransitionToStatus != "" {
+		// Only available transitions are listed, so no separate validation
+		result, err := client.GetIssueTransitions(issueKey, nil)
+		if err != nil {
+			return fmt.Errorf("failed to get transitions: %w", err)
+		}
+		transitions, _ := result["transitions"].([]any)
+		transitionID, err = atlassian.FindTransitionToStatus(transitions, jiraTransitionToStatus)
+		if err != nil {
+			return err
+		}
+	} else if !jiraTransitionNoValidate {
+		if err := validateTransition(client, issueKey, transitionID); err != nil {
+			return err
+		}
+	}
+
+	if jiraTransitionPromptFields {
+		fields, err = promptTransitionFields(client, os.Stdin, issueKey, transitionID, fields)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Build transition options
+	opts := &atlassian.TransitionIssueOptions{
+		TransitionID:    transitionID,
+		Fields:          fields,
+		Update:          update,
+		HistoryMetadata: historyMetadata,
+	}
+
+	// Transition issue
+	err = client.TransitionIssue(issueKey, opts)
+	if err != nil {
+		return fmt.Errorf("failed to transition issue: %w", err)
+	}
+
+	message := fmt.Sprintf("Transitioned issue %s", issueKey)
+	if jiraTransitionToStatus != "" {
+		message += fmt.Sprintf(" to %s", jiraTransitionToStatus)
+	}
+	if err := printNoContentResult(true, message); err != nil {
+		return err
+	}
+	if !outputJSON {
+		fmt.Printf("\nView updated issue: atl jira get-issue %s\n", issueKey)
+	}
+
+	return nil
+}
+
+// validateTransition checks that transitionID is one of the transitions
+// available from the issue's current status, listing the valid ones if not
+func validateTransition(client *atlassian.Client, issueKey, transitionID string) error {
+	result, err := client.GetIssueTransitions(issueKey, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get transitions: %w", err)
+	}
+
+	var available []string
+	transitions, _ := result["transitions"].([]any)
+	for _, t := range transitions {
+		tm, _ := t.(map[string]any)
+		id, _ := tm["id"].(string)
+		if id == transitionID {
+			return nil
+		}
+		name, _ := tm["name"].(string)
+		available = append(available, fmt.Sprintf("%s (%s)", id, name))
+	}
+
+	// The current status only makes the error clearer, so failing to fetch
+	// it isn't fatal
+	from := ""
+	if issue, err := client.GetJiraIssue(issueKey, &atlassian.GetIssueOptions{Fields: []string{"status"}}); err == nil {
+		if status, ok := lookupPath(issue, "fields.status.name"); ok {
+			from = fmt.Sprintf(" from status '%v'", status)
+		}
+	}
+
+	if len(available) == 0 {
+		return fmt.Errorf("transition %s is not available%s; no transitions are available", transitionID, from)
+	}
+	return fmt.Errorf("transition %s is not available%s; available: %s", transitionID, from, strings.Join(available, ", "))
+}
+
+// promptTransitionFields asks for each required transition screen field that
+// isn't already in fields, using the transition's field metadata to list
+// allowed values. Prompts go to stderr so they don't mix with --json output;
+// answers are read from in (stdin for the command) so they can also be piped.
+func promptTransitionFields(client *atlassian.Client, in io.Reader, issueKey, transitionID string, fields map[string]any) (map[string]any, error) {
+	result, err := client.GetIssueTransitions(issueKey, &atlassian.GetTransitionsOptions{
+		Expand:       "transitions.fields",
+		TransitionID: transitionID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transitions: %w", err)
+	}
+
+	var transition map[string]any
+	transitions, _ := result["transitions"].([]any)
+	for _, t := range transitions {
+		tm, _ := t.(map[string]any)
+		if id, _ := tm["id"].(string); id == transitionID {
+			transition = tm
+			break
+		}
+	}
+	if transition == nil {
+		return nil, fmt.Errorf("transition %s is not available for %s. Use 'atl jira get-transitions %s' to see valid transitions", transitionID, issueKey, issueKey)
+	}
+
+	screenFields, _ := transition["fields"].(map[string]any)
+
+	var keys []string
+	for key, f := range screenFields {
+		field, _ := f.(map[string]any)
+		required, _ := field["required"].(bool)
+		hasDefault, _ := field["hasDefaultValue"].(bool)
+		if _, provided := fields[key]; required && !hasDefault && !provided {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	if len(keys) == 0 {
+		return fields, nil
+	}
+
+	if fields == nil {
+		fields = map[string]any{}
+	}
+
+	reader := bufio.NewReader(in)
+	for _, key := range keys {
+		field, _ := screenFields[key].(map[string]any)
+		value, err := promptFieldValue(reader, os.Stderr, key, field)
+		if err != nil {
+			return nil, err
+		}
+		fields[key] = value
+	}
+	fmt.Fprintln(os.Stderr)
+
+	return fields, nil
+}
+
+// promptFieldValue prompts for a single field value. Fields with allowed
+// values are chosen by number; other fields are entered as text, numbers,
+// account IDs, or raw JSON depending on their schema type. Prompts are
+// written to out.
+func promptFieldValue(reader *bufio.Reader, out io.Writer, key string, field map[string]any) (any, error) {
+	name, _ := field["name"].(string)
+	schema, _ := field["schema"].(map[string]any)
+	fieldType, _ := schema["type"].(string)
+	allowed, _ := field["allowedValues"].([]any)
+
+	readLine := func() (string, error) {
+		line, err := reader.ReadString('\n')
+		line = strings.TrimSpace(line)
+		if err != nil && line == "" {
+			return "", fmt.Errorf("no value given for required field %s (%s)", name, key)
+		}
+		return line, nil
+	}
+
+	fmt.Fprintf(out, "\n%s (%s) is required:\n", name, key)
+
+	if len(allowed) > 0 {
+		for i, a := range allowed {
+			fmt.Fprintf(out, "  %d. %s\n", i+1, formatFieldValue(a))
+		}
+
+		prompt := "Choose a number: "
+		if fieldType == "array" {
+			prompt = "Choose numbers (comma-separated): "
+		}
+		fmt.Fprint(out, prompt)
+
+		line, err := readLine()
+		if err != nil {
+			return nil, err
+		}
+
+		var choices []any
+		for _, part := range strings.Split(line, ",") {
+			n, err := strconv.Atoi(strings.TrimSpace(part))
+			if err != nil || n < 1 || n > len(allowed) {
+				return nil, fmt.Errorf("invalid choice '%s' for %s", strings.TrimSpace(part), name)
+			}
+			option, _ := allowed[n-1].(map[string]any)
+			choices = append(choices, map[string]any{"id": option["id"]})
+		}
+
+		if fieldType == "array" {
+			return choices, nil
+		}
+		if len(choices) != 1 {
+			return nil, fmt.Errorf("%s takes a single value", name)
+		}
+		return choices[0], nil
+	}
+
+	switch fieldType {
+	case "string":
+		fmt.Fprint(out, "Value: ")
+		return readLine()
+	case "number":
+		fmt.Fprint(out, "Number: ")
+		line, err := readLine()
+		if err != nil {
+			return nil, err
+		}
+		n, err := strconv.ParseFloat(line, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number '%s' for %s", line, name)
+		}
+		return n, nil
+	case "user":
+		fmt.Fprint(out, "Account ID (see 'atl jira lookup-account-id'): ")
+		line, err := readLine()
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"accountId": line}, nil
+	default:
+		fmt.Fprintf(out, "Value as JSON (type %s): ", fieldType)
+		line, err := readLine()
+		if err != nil {
+			return nil, err
+		}
+		var value any
+		if err := json.Unmarshal([]byte(line), &value); err != nil {
+			return nil, fmt.Errorf("invalid JSON for %s: %w", name, err)
+		}
+		return value, nil
 	}
-
-	return nil
 }
 
-func runJiraAddComment(cmd *cobra.Command, args []string) error {
+func runJiraAddWatcher(cmd *cobra.Command, args []string) error {
 	issueKey := args[0]
-	comment := args[1]
 
-	// Validate visibility flags
-	if (jiraCommentVisibilityType != "" && jiraCommentVisibilityValue == "") ||
-		(jiraCommentVisibilityType == "" && jiraCommentVisibilityValue != "") {
-		return fmt.Errorf("both --visibility-type and --visibility-value must be provided together")
+	client, account, err := newClient()
+	if err != nil {
+		return err
 	}
 
-	if jiraCommentVisibilityType != "" && jiraCommentVisibilityType != "group" && jiraCommentVisibilityType != "role" {
-		return fmt.Errorf("--visibility-type must be 'group' or 'role'")
+	accountID, name, err := resolveUser(client, account, args[1])
+	if err != nil {
+		return err
 	}
 
-	// Load config and get active account
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+	if jiraWatcherRemove {
+		if err := client.RemoveWatcher(issueKey, accountID); err != nil {
+			return fmt.Errorf("failed to remove watcher: %w", err)
+		}
+		return printNoContentResult(true, fmt.Sprintf("Removed %s from the watchers of %s", name, issueKey))
 	}
 
-	account, err := cfg.GetActiveAccount()
-	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+	if err := client.AddWatcher(issueKey, accountID); err != nil {
+		return fmt.Errorf("failed to add watcher: %w", err)
 	}
+	return printNoContentResult(true, fmt.Sprintf("Added %s as a watcher of %s", name, issueKey))
+}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
+func runJiraListWatchers(cmd *cobra.Command, args []string) error {
+	issueKey := args[0]
 
-	// Add comment
-	opts := &atlassian.AddCommentOptions{
-		Comment:         comment,
-		VisibilityType:  jiraCommentVisibilityType,
-		VisibilityValue: jiraCommentVisibilityValue,
+	client, _, err := newClient()
+	if err != nil {
+		return err
 	}
 
-	result, err := client.AddCommentToIssue(issueKey, opts)
+	result, err := client.GetWatchers(issueKey)
 	if err != nil {
-		return fmt.Errorf("failed to add comment: %w", err)
+		return fmt.Errorf("failed to get watchers: %w", err)
 	}
 
+	watchers, _ := result["watchers"].([]any)
 	if outputJSON {
-		// JSON output
-		output, err := json.MarshalIndent(result, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if watchers == nil {
+			watchers = []any{}
 		}
-		fmt.Println(string(output))
-	} else {
-		// Pretty output (default)
-		id, _ := result["id"].(string)
-		fmt.Printf("✓ Added comment to %s\n", issueKey)
-		fmt.Printf("  Comment ID: %s\n", id)
+		return printJSON(watchers)
+	}
+
+	if len(watchers) == 0 {
+		fmt.Printf("No one is watching %s\n", issueKey)
+		return nil
 	}
 
+	fmt.Printf("%d watcher(s) on %s:\n", len(watchers), issueKey)
+	for _, w := range watchers {
+		watcher, _ := w.(map[string]any)
+		displayName, _ := watcher["displayName"].(string)
+		accountID, _ := watcher["accountId"].(string)
+		status := ""
+		if active, _ := watcher["active"].(bool); !active {
+			status = " [inactive]"
+		}
+		fmt.Printf("  %s (%s)%s\n", displayName, accountID, status)
+	}
 	return nil
 }
 
-func runJiraEditIssue(cmd *cobra.Command, args []string) error {
+func runJiraAddWorklog(cmd *cobra.Command, args []string) error {
 	issueKey := args[0]
 
-	// Check if at least one field is provided
-	if jiraEditSummary == "" && jiraEditDescription == "" && jiraEditAssignee == "" && jiraEditFields == "" {
-		return fmt.Errorf("at least one field must be provided (--summary, --description, --assignee, or --fields)")
+	if jiraWorklogStarted != "" {
+		if _, err := time.Parse(atlassian.JiraTimeLayout, jiraWorklogStarted); err != nil {
+			return fmt.Errorf("invalid --started %q: expected a time like 2024-01-15T09:00:00.000+0000", jiraWorklogStarted)
+		}
 	}
-
-	// Load config and get active account
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+	if jiraWorklogNewEstimate != "" && jiraWorklogAdjustEstimate != "new" {
+		return fmt.Errorf("--new-estimate requires --adjust-estimate new")
 	}
-
-	account, err := cfg.GetActiveAccount()
-	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+	if jiraWorklogReduceBy != "" && jiraWorklogAdjustEstimate != "manual" {
+		return fmt.Errorf("--reduce-by requires --adjust-estimate manual")
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
-	// Build fields to update
-	fields := make(map[string]any)
-
-	// Parse additional fields first
-	if jiraEditFields != "" {
-		if err := json.Unmarshal([]byte(jiraEditFields), &fields); err != nil {
-			return fmt.Errorf("invalid --fields JSON: %w", err)
+	opts := &atlassian.AddWorklogOptions{
+		TimeSpent:      jiraWorklogTimeSpent,
+		Started:        jiraWorklogStarted,
+		AdjustEstimate: jiraWorklogAdjustEstimate,
+		NewEstimate:    jiraWorklogNewEstimate,
+		ReduceBy:       jiraWorklogReduceBy,
+	}
+	if jiraWorklogComment != "" {
+		adf, warnings, err := atlassian.MarkdownToADF(jiraWorklogComment)
+		for _, w := range warnings {
+			fmt.Fprintf(os.Stderr, "Warning: %s\n", w)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to convert comment to ADF: %w", err)
 		}
+		opts.Comment = adf
 	}
 
-	// Specific flags override --fields
-	if jiraEditSummary != "" {
-		fields["summary"] = jiraEditSummary
+	client, _, err := newClient()
+	if err != nil {
+		return err
 	}
 
-	var imageCount int
-	if jiraEditDescription != "" {
-		// Check for local image references
-		imageRefs, cleanedDesc := atlassian.ExtractLocalImages(jiraEditDescription)
-
-		if len(imageRefs) > 0 {
-			// Check existing attachments to avoid re-uploading
-			existing := getExistingAttachments(client, issueKey)
-
-			// Upload images and build media nodes
-			var mediaNodes []map[string]any
-			for _, img := range imageRefs {
-				filePath := img.FilePath
-				var tmpPath string
-
-				// Download remote images to a temp file first
-				if img.Remote {
-					var err error
-					tmpPath, err = downloadImageToTemp(img.FilePath)
-					if err != nil {
-						fmt.Printf("Warning: %v\n", err)
-						continue
-					}
-					filePath = tmpPath
-				}
-
-				// Check if this file was already uploaded
-				filename := filepath.Base(filePath)
-				if att, ok := existing[filename]; ok {
-					mediaID, err := client.GetAttachmentMediaID(att)
-					if tmpPath != "" {
-						os.Remove(tmpPath)
-					}
-					if err == nil {
-						mediaNodes = append(mediaNodes, atlassian.BuildMediaSingleNode(mediaID, img.AltText))
-						imageCount++
-						continue
-					}
-				}
-
-				attachments, err := client.AddAttachment(issueKey, filePath)
-				if tmpPath != "" {
-					os.Remove(tmpPath)
-				}
-				if err != nil {
-					fmt.Printf("Warning: failed to upload %s: %v\n", img.FilePath, err)
-					continue
-				}
-				if len(attachments) == 0 {
-					fmt.Printf("Warning: no attachment returned for %s\n", img.FilePath)
-					continue
-				}
-				mediaID, err := client.GetAttachmentMediaID(&attachments[0])
-				if err != nil {
-					fmt.Printf("Warning: failed to get media ID for %s: %v\n", img.FilePath, err)
-					continue
-				}
-				mediaNodes = append(mediaNodes, atlassian.BuildMediaSingleNode(mediaID, img.AltText))
-				imageCount++
-			}
-
-			// Convert cleaned markdown to ADF with media nodes
-			adf, warnings, err := atlassian.MarkdownToADFWithImages(cleanedDesc, mediaNodes)
-			for _, w := range warnings {
-				fmt.Printf("Warning: %s\n", w)
-			}
-			if err != nil {
-				return fmt.Errorf("failed to convert description to ADF: %w", err)
-			}
-			fields["description"] = adf
-		} else {
-			adf, warnings, err := atlassian.MarkdownToADF(jiraEditDescription)
-			for _, w := range warnings {
-				fmt.Printf("Warning: %s\n", w)
-			}
-			if err != nil {
-				return fmt.Errorf("failed to convert description to ADF: %w", err)
-			}
-			fields["description"] = adf
-		}
+	worklog, err := client.AddWorklog(issueKey, opts)
+	if err != nil {
+		return fmt.Errorf("failed to add worklog: %w", err)
 	}
 
-	if jiraEditAssignee != "" {
-		fields["assignee"] = map[string]any{
-			"id": jiraEditAssignee,
+	// The worklog response doesn't include the issue's estimate, so fetch it
+	remaining := ""
+	issue, err := client.GetJiraIssue(issueKey, &atlassian.GetIssueOptions{Fields: []string{"timetracking"}})
+	if err == nil {
+		if value, ok := lookupPath(issue, "fields.timetracking.remainingEstimate"); ok {
+			remaining, _ = value.(string)
 		}
 	}
 
-	// Edit issue
-	err = client.EditJiraIssue(issueKey, fields)
-	if err != nil {
-		return fmt.Errorf("failed to edit issue: %w", err)
-	}
-
 	if outputJSON {
-		// JSON output - API returns 204 No Content
-		response := map[string]any{
-			"status":  204,
-			"success": true,
-			"message": "Issue updated successfully",
-		}
-		output, err := json.MarshalIndent(response, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
-		}
-		fmt.Println(string(output))
-	} else {
-		// Pretty output (default)
-		fmt.Printf("✓ Updated issue %s\n", issueKey)
-		if jiraEditSummary != "" {
-			fmt.Printf("  Summary: %s\n", jiraEditSummary)
-		}
-		if jiraEditDescription != "" {
-			fmt.Printf("  Description: updated\n")
-		}
-		if imageCount > 0 {
-			fmt.Printf("  Images: %d attached inline\n", imageCount)
-		}
-		if jiraEditAssignee != "" {
-			fmt.Printf("  Assignee: %s\n", jiraEditAssignee)
-		}
-		if jiraEditFields != "" {
-			fmt.Printf("  Additional fields: updated\n")
+		if remaining != "" {
+			worklog["remainingEstimate"] = remaining
 		}
+		return printJSON(worklog)
 	}
 
+	id, _ := worklog["id"].(string)
+	timeSpent, _ := worklog["timeSpent"].(string)
+	if timeSpent == "" {
+		timeSpent = jiraWorklogTimeSpent
+	}
+	fmt.Printf("✓ Logged %s on %s\n", timeSpent, issueKey)
+	fmt.Printf("  Worklog ID: %s\n", id)
+	if remaining != "" {
+		fmt.Printf("  Remaining estimate: %s\n", remaining)
+	}
 	return nil
 }
 
-func runJiraAddAttachment(cmd *cobra.Command, args []string) error {
+func runJiraGetWorklogs(cmd *cobra.Command, args []string) error {
 	issueKey := args[0]
-	filePaths := args[1:]
 
-	// Load config and get active account
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+	opts := &atlassian.GetWorklogsOptions{
+		StartAt:    jiraWorklogsStartAt,
+		MaxResults: jiraWorklogsMaxResults,
+	}
+	now := time.Now()
+	if jiraWorklogsStartedAfter != "" {
+		after, err := atlassian.ParseSince(jiraWorklogsStartedAfter, now)
+		if err != nil {
+			return fmt.Errorf("invalid --started-after: %w", err)
+		}
+		opts.StartedAfter = after
+	}
+	if jiraWorklogsStartedBefore != "" {
+		before, err := atlassian.ParseSince(jiraWorklogsStartedBefore, now)
+		if err != nil {
+			return fmt.Errorf("invalid --started-before: %w", err)
+		}
+		opts.StartedBefore = before
 	}
 
-	account, err := cfg.GetActiveAccount()
+	client, _, err := newClient()
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
-	var allAttachments []atlassian.Attachment
-
-	for _, filePath := range filePaths {
-		attachments, err := client.AddAttachment(issueKey, filePath)
-		if err != nil {
-			return fmt.Errorf("failed to upload %s: %w", filePath, err)
-		}
-		allAttachments = append(allAttachments, attachments...)
+	// Without explicit paging, fetch every worklog so the total covers them all
+	paged := cmd.Flags().Changed("start-at") || cmd.Flags().Changed("max-results")
+	listing, err := fetchWorklogs(client, issueKey, opts, !paged)
+	if err != nil {
+		return fmt.Errorf("failed to get worklogs: %w", err)
 	}
 
 	if outputJSON {
-		output, err := json.MarshalIndent(allAttachments, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
-		}
-		fmt.Println(string(output))
-	} else {
-		for _, att := range allAttachments {
-			fmt.Printf("✓ Attached %s to %s (attachment ID: %s)\n", att.Filename, issueKey, att.ID)
-		}
+		return printJSON(listing.raw)
 	}
 
+	printWorklogs(os.Stdout, issueKey, listing)
 	return nil
 }
 
-func runJiraGetTransitions(cmd *cobra.Command, args []string) error {
-	issueKey := args[0]
+// worklogListing holds the worklogs to print along with the payload --json emits
+type worklogListing struct {
+	raw      any   // A single API page, or every page when fetching all
+	worklogs []any // The worklogs across everything fetched
+	startAt  int
+	total    int
+}
 
-	// Load config and get active account
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+// fetchWorklogs gets a single page of worklogs, or every worklog when all is set
+func fetchWorklogs(client *atlassian.Client, issueKey string, opts *atlassian.GetWorklogsOptions, all bool) (*worklogListing, error) {
+	if !all {
+		result, err := client.GetWorklogs(issueKey, opts)
+		if err != nil {
+			return nil, err
+		}
+		worklogs, _ := result["worklogs"].([]any)
+		startAt, _ := result["startAt"].(float64)
+		total, _ := result["total"].(float64)
+		return &worklogListing{raw: result, worklogs: worklogs, startAt: int(startAt), total: int(total)}, nil
 	}
 
-	account, err := cfg.GetActiveAccount()
+	pages, err := client.GetAllWorklogs(issueKey, opts)
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return nil, err
 	}
-
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
-	// Build options
-	opts := &atlassian.GetTransitionsOptions{
-		Expand:                        jiraGetTransitionsExpand,
-		TransitionID:                  jiraGetTransitionsTransitionID,
-		IncludeUnavailableTransitions: jiraGetTransitionsIncludeUnavailable,
-		SkipRemoteOnlyCondition:       jiraGetTransitionsSkipRemoteOnly,
-		SortByOpsBarAndStatus:         jiraGetTransitionsSortByOpsBarAndStatus,
+	var worklogs []any
+	for _, page := range pages {
+		pageWorklogs, _ := page["worklogs"].([]any)
+		worklogs = append(worklogs, pageWorklogs...)
 	}
+	return &worklogListing{raw: pages, worklogs: worklogs, total: len(worklogs)}, nil
+}
 
-	// Get transitions
-	result, err := client.GetIssueTransitions(issueKey, opts)
-	if err != nil {
-		return fmt.Errorf("failed to get transitions: %w", err)
+// printWorklogs writes each worklog followed by the time logged across them
+func printWorklogs(out io.Writer, issueKey string, listing *worklogListing) {
+	worklogs := listing.worklogs
+	if len(worklogs) == 0 {
+		fmt.Fprintf(out, "No worklogs on %s\n", issueKey)
+		return
 	}
 
-	if outputJSON {
-		// JSON output
-		output, err := json.MarshalIndent(result, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
-		}
-		fmt.Println(string(output))
-	} else {
-		// Pretty output (default)
-		transitions, _ := result["transitions"].([]any)
+	startAt, total := listing.startAt, listing.total
+	fmt.Fprintf(out, "Worklogs on %s (showing %d-%d of %d):\n", issueKey, startAt+1, startAt+len(worklogs), total)
 
-		if len(transitions) == 0 {
-			fmt.Printf("No transitions available for %s\n", issueKey)
-			return nil
+	totalSeconds := 0
+	for _, w := range worklogs {
+		worklog, ok := w.(map[string]any)
+		if !ok {
+			continue
 		}
 
-		fmt.Printf("Available transitions for %s:\n\n", issueKey)
-
-		for _, t := range transitions {
-			if trans, ok := t.(map[string]any); ok {
-				id, _ := trans["id"].(string)
-				name, _ := trans["name"].(string)
+		authorInfo, _ := worklog["author"].(map[string]any)
+		authorName, _ := authorInfo["displayName"].(string)
+		timeSpent, _ := worklog["timeSpent"].(string)
+		seconds, _ := worklog["timeSpentSeconds"].(float64)
+		totalSeconds += int(seconds)
 
-				// Get destination status
-				to, _ := trans["to"].(map[string]any)
-				toName, _ := to["name"].(string)
+		started, _ := worklog["started"].(string)
+		if startedAt, err := time.Parse(atlassian.JiraTimeLayout, started); err == nil {
+			started = startedAt.Local().Format("2006-01-02 15:04")
+		}
 
-				fmt.Printf("  ID: %-4s  → %s", id, name)
-				if toName != "" {
-					fmt.Printf(" (to: %s)", toName)
-				}
-				fmt.Println()
+		fmt.Fprintf(out, "\n  [%s] %s: %s\n", started, authorName, timeSpent)
+		if text := strings.TrimSpace(atlassian.ADFToText(worklog["comment"])); text != "" {
+			for _, line := range strings.Split(text, "\n") {
+				fmt.Fprintf(out, "    %s\n", line)
 			}
 		}
-
-		fmt.Printf("\nTo transition: atl jira transition-issue %s <transition-id>\n", issueKey)
 	}
 
-	return nil
+	if startAt > 0 || startAt+len(worklogs) < total {
+		fmt.Fprintf(out, "\nTotal logged (this page): %s\n", formatWorkDuration(totalSeconds))
+	} else {
+		fmt.Fprintf(out, "\nTotal logged: %s\n", formatWorkDuration(totalSeconds))
+	}
 }
 
-func runJiraTransitionIssue(cmd *cobra.Command, args []string) error {
+func runJiraAssignIssue(cmd *cobra.Command, args []string) error {
 	issueKey := args[0]
-	transitionID := args[1]
-
-	// Parse JSON parameters if provided
-	var fields, update, historyMetadata map[string]any
 
-	if jiraTransitionFields != "" {
-		if err := json.Unmarshal([]byte(jiraTransitionFields), &fields); err != nil {
-			return fmt.Errorf("invalid --fields JSON: %w", err)
-		}
-	}
-	if jiraTransitionUpdate != "" {
-		if err := json.Unmarshal([]byte(jiraTransitionUpdate), &update); err != nil {
-			return fmt.Errorf("invalid --update JSON: %w", err)
-		}
+	if !jiraAssignUnassign && len(args) < 2 {
+		return fmt.Errorf("give an assignee, or --unassign to clear it")
 	}
-	if jiraTransitionHistoryMetadata != "" {
-		if err := json.Unmarshal([]byte(jiraTransitionHistoryMetadata), &historyMetadata); err != nil {
-			return fmt.Errorf("invalid --history-metadata JSON: %w", err)
-		}
+	if jiraAssignUnassign && len(args) == 2 {
+		return fmt.Errorf("an assignee can't be given with --unassign")
 	}
 
-	// Load config and get active account
-	cfg, err := config.Load()
+	client, account, err := newClient()
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return err
 	}
 
-	account, err := cfg.GetActiveAccount()
+	if jiraAssignUnassign {
+		if err := client.AssignIssue(issueKey, ""); err != nil {
+			return fmt.Errorf("failed to unassign issue: %w", err)
+		}
+		return printNoContentResult(true, fmt.Sprintf("Unassigned %s", issueKey))
+	}
+
+	accountID, name, err := resolveUser(client, account, args[1])
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
+	if err := client.AssignIssue(issueKey, accountID); err != nil {
+		return fmt.Errorf("failed to assign issue: %w", err)
+	}
+	return printNoContentResult(true, fmt.Sprintf("Assigned %s to %s", issueKey, name))
+}
 
-	// Build transition options
-	opts := &atlassian.TransitionIssueOptions{
-		TransitionID:    transitionID,
-		Fields:          fields,
-		Update:          update,
-		HistoryMetadata: historyMetadata,
+// resolveUser turns an account ID, "me", an email, or a display name
+// into an account ID and a name to show for it. Names must identify exactly
+// one active user.
+func resolveUser(client *atlassian.Client, account *config.Account, query string) (string, string, error) {
+	if query == "me" {
+		accountID, err := currentAccountID(client, account)
+		return accountID, "you", err
+	}
+	if atlassian.LooksLikeAccountID(query) {
+		return query, query, nil
 	}
 
-	// Transition issue
-	err = client.TransitionIssue(issueKey, opts)
+	users, err := client.LookupAccountID(query)
 	if err != nil {
-		return fmt.Errorf("failed to transition issue: %w", err)
+		return "", "", fmt.Errorf("failed to lookup account: %w", err)
 	}
+	users = atlassian.FilterUsers(users, &atlassian.FilterUsersOptions{ActiveOnly: true})
 
-	if outputJSON {
-		// JSON output - API returns 204 No Content
-		response := map[string]any{
-			"status":  204,
-			"success": true,
-			"message": "Issue transitioned successfully",
+	// A partial search can match several people; prefer an exact match
+	if len(users) > 1 {
+		if exact := atlassian.FilterUsers(users, &atlassian.FilterUsersOptions{Exact: query}); len(exact) == 1 {
+			users = exact
 		}
-		output, err := json.MarshalIndent(response, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
-		}
-		fmt.Println(string(output))
-	} else {
-		// Pretty output (default)
-		fmt.Printf("✓ Transitioned issue %s\n", issueKey)
-		fmt.Printf("\nView updated issue: atl jira get-issue %s\n", issueKey)
 	}
 
-	return nil
+	switch len(users) {
+	case 0:
+		return "", "", fmt.Errorf("no active users found for '%s'", query)
+	case 1:
+		accountID, _ := users[0]["accountId"].(string)
+		displayName, _ := users[0]["displayName"].(string)
+		return accountID, displayName, nil
+	}
+
+	fmt.Fprintf(os.Stderr, "%d users match '%s':\n", len(users), query)
+	for _, user := range users {
+		accountID, _ := user["accountId"].(string)
+		displayName, _ := user["displayName"].(string)
+		email, _ := user["emailAddress"].(string)
+		if email != "" {
+			fmt.Fprintf(os.Stderr, "  %s <%s> (%s)\n", displayName, email, accountID)
+		} else {
+			fmt.Fprintf(os.Stderr, "  %s (%s)\n", displayName, accountID)
+		}
+	}
+	return "", "", fmt.Errorf("'%s' matches more than one user; use an email or account ID", query)
 }
 
 func runJiraLookupAccountID(cmd *cobra.Command, args []string) error {
 	searchString := args[0]
 
-	// Load config and get active account
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
-	}
-
-	account, err := cfg.GetActiveAccount()
+	client, _, err := newClient()
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
 	// Lookup users
 	users, err := client.LookupAccountID(searchString)
 	if err != nil {
 		return fmt.Errorf("failed to lookup account: %w", err)
 	}
 
+	filterOpts := &atlassian.FilterUsersOptions{ActiveOnly: jiraLookupActiveOnly}
+	if jiraLookupExact {
+		filterOpts.Exact = searchString
+	}
+	users = atlassian.FilterUsers(users, filterOpts)
+
+	if jiraLookupFirst {
+		switch len(users) {
+		case 0:
+			return fmt.Errorf("no users found for '%s'", searchString)
+		case 1:
+			accountID, _ := users[0]["accountId"].(string)
+			fmt.Println(accountID)
+			return nil
+		default:
+			return fmt.Errorf("%d users match '%s'; narrow the search or add --exact or --active-only", len(users), searchString)
+		}
+	}
+
 	if outputJSON {
 		// JSON output
-		output, err := json.MarshalIndent(users, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(users); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
 	} else {
 		// Pretty output (default)
 		if len(users) == 0 {
@@ -1352,20 +3883,15 @@ func runJiraLookupAccountID(cmd *cobra.Command, args []string) error {
 }
 
 func runJiraGetProjects(cmd *cobra.Command, args []string) error {
-	// Load config and get active account
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+	if err := resolveTableOutput(jiraProjectsOutput, jiraProjectsMaxColWidth); err != nil {
+		return err
 	}
 
-	account, err := cfg.GetActiveAccount()
+	client, _, err := newClient()
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
 	// Get projects
 	opts := &atlassian.GetVisibleProjectsOptions{
 		Action:           jiraProjectsAction,
@@ -1381,11 +3907,15 @@ func runJiraGetProjects(cmd *cobra.Command, args []string) error {
 	}
 
 	if outputJSON {
-		output, err := json.MarshalIndent(projects, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(projects); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
+	} else if jiraProjectsOutput == "table" {
+		rows := make([][]string, len(projects))
+		for i, proj := range projects {
+			rows[i] = tableRow(proj, jiraProjectsColumns)
+		}
+		printTable(tableHeaders(jiraProjectsColumns), rows, jiraProjectsMaxColWidth)
 	} else {
 		if len(projects) == 0 {
 			fmt.Println("No projects found.")
@@ -1427,20 +3957,11 @@ func runJiraGetProjects(cmd *cobra.Command, args []string) error {
 func runJiraGetProjectIssueTypes(cmd *cobra.Command, args []string) error {
 	projectKey := args[0]
 
-	// Load config and get active account
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
-	}
-
-	account, err := cfg.GetActiveAccount()
+	client, _, err := newClient()
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
 	// Get issue types
 	opts := &atlassian.GetProjectIssueTypesOptions{
 		MaxResults: jiraIssueTypesMaxResults,
@@ -1453,11 +3974,9 @@ func runJiraGetProjectIssueTypes(cmd *cobra.Command, args []string) error {
 	}
 
 	if outputJSON {
-		output, err := json.MarshalIndent(issueTypes, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(issueTypes); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
 	} else {
 		if len(issueTypes) == 0 {
 			fmt.Printf("No issue types found for project %s\n", projectKey)
@@ -1488,22 +4007,375 @@ func runJiraGetProjectIssueTypes(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runJiraGetRemoteLinks(cmd *cobra.Command, args []string) error {
-	issueKey := args[0]
+func runJiraGetProjectRoles(cmd *cobra.Command, args []string) error {
+	projectKey := args[0]
 
-	// Load config and get active account
-	cfg, err := config.Load()
+	client, _, err := newClient()
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return err
+	}
+
+	roles, err := client.GetProjectRoles(projectKey)
+	if err != nil {
+		return fmt.Errorf("failed to get project roles: %w", err)
+	}
+
+	names := make([]string, 0, len(roles))
+	for name := range roles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if !jiraProjectRolesMembers {
+		if outputJSON {
+			return printJSON(roles)
+		}
+
+		if len(names) == 0 {
+			fmt.Printf("No roles found for project %s\n", projectKey)
+			return nil
+		}
+
+		fmt.Printf("Roles for project %s:\n\n", projectKey)
+		for i, name := range names {
+			fmt.Printf("%d. %s (ID: %s)\n", i+1, name, roleIDFromURL(roles[name]))
+			fmt.Printf("   %s\n", roles[name])
+		}
+		fmt.Printf("\nTo list members: atl jira get-role-members %s <role-id>\n", projectKey)
+		return nil
+	}
+
+	details := make([]map[string]any, 0, len(names))
+	for _, name := range names {
+		role, err := client.GetProjectRole(projectKey, roleIDFromURL(roles[name]))
+		if err != nil {
+			return fmt.Errorf("failed to get members of role %s: %w", name, err)
+		}
+		details = append(details, role)
+	}
+
+	if outputJSON {
+		return printJSON(details)
+	}
+
+	if len(details) == 0 {
+		fmt.Printf("No roles found for project %s\n", projectKey)
+		return nil
+	}
+
+	fmt.Printf("Roles for project %s:\n\n", projectKey)
+	for i, role := range details {
+		name, _ := role["name"].(string)
+		id, _ := role["id"].(float64)
+		actors, _ := role["actors"].([]any)
+
+		fmt.Printf("%d. %s (ID: %.0f)\n", i+1, name, id)
+		if len(actors) == 0 {
+			fmt.Println("   (no members)")
+		}
+		for _, a := range actors {
+			if actor, ok := a.(map[string]any); ok {
+				fmt.Printf("   - %s\n", formatRoleActor(actor))
+			}
+		}
+		fmt.Println()
+	}
+
+	return nil
+}
+
+func runJiraGetRoleMembers(cmd *cobra.Command, args []string) error {
+	projectKey := args[0]
+	roleID := args[1]
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	role, err := client.GetProjectRole(projectKey, roleID)
+	if err != nil {
+		return fmt.Errorf("failed to get role members: %w", err)
+	}
+
+	// The role endpoint returns every actor at once, so page through them here
+	// to keep large roles readable
+	actors, _ := role["actors"].([]any)
+	total := len(actors)
+	start := min(max(jiraRoleMembersStartAt, 0), total)
+	end := total
+	if jiraRoleMembersMaxResults > 0 {
+		end = min(start+jiraRoleMembersMaxResults, total)
+	}
+	page := actors[start:end]
+
+	if outputJSON {
+		return printJSON(map[string]any{
+			"startAt":    start,
+			"maxResults": jiraRoleMembersMaxResults,
+			"total":      total,
+			"isLast":     end >= total,
+			"values":     page,
+		})
+	}
+
+	name, _ := role["name"].(string)
+	if total == 0 {
+		fmt.Printf("Role %s has no members in project %s\n", name, projectKey)
+		return nil
 	}
 
-	account, err := cfg.GetActiveAccount()
+	fmt.Printf("Members of %s in project %s (showing %d-%d of %d):\n\n", name, projectKey, start+1, end, total)
+	for i, a := range page {
+		if actor, ok := a.(map[string]any); ok {
+			fmt.Printf("%d. %s\n", start+i+1, formatRoleActor(actor))
+		}
+	}
+
+	if end < total {
+		fmt.Printf("\nMore members available. Use --start-at %d to see the next page.\n", end)
+	}
+
+	return nil
+}
+
+// formatRoleActor renders a project role actor as its display name plus the
+// account or group ID needed to reference it elsewhere
+func formatRoleActor(actor map[string]any) string {
+	displayName, _ := actor["displayName"].(string)
+
+	if user, ok := actor["actorUser"].(map[string]any); ok {
+		accountID, _ := user["accountId"].(string)
+		return fmt.Sprintf("%s (user, account ID: %s)", displayName, accountID)
+	}
+	if group, ok := actor["actorGroup"].(map[string]any); ok {
+		groupID, _ := group["groupId"].(string)
+		if groupID == "" {
+			groupID, _ = group["name"].(string)
+		}
+		return fmt.Sprintf("%s (group: %s)", displayName, groupID)
+	}
+
+	return displayName
+}
+
+// roleIDFromURL extracts the role ID from a project role URL such as
+// https://site.atlassian.net/rest/api/3/project/ABC/role/10002
+func roleIDFromURL(roleURL string) string {
+	return roleURL[strings.LastIndex(roleURL, "/")+1:]
+}
+
+func runJiraServerInfo(cmd *cobra.Command, args []string) error {
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	info, err := client.GetServerInfo()
+	if err != nil {
+		return fmt.Errorf("failed to get server info: %w", err)
+	}
+
+	deploymentType, _ := info["deploymentType"].(string)
+	if err := saveDeploymentType(deploymentType); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to save deployment type: %v\n", err)
+	}
+
+	if outputJSON {
+		return printJSON(info)
+	}
+
+	baseURL, _ := info["baseUrl"].(string)
+	version, _ := info["version"].(string)
+	buildNumber, _ := info["buildNumber"].(float64)
+	buildDate, _ := info["buildDate"].(string)
+	title, _ := info["serverTitle"].(string)
+
+	fmt.Printf("Server: %s\n", title)
+	fmt.Printf("Base URL: %s\n", baseURL)
+	fmt.Printf("Deployment Type: %s\n", deploymentType)
+	fmt.Printf("Version: %s\n", version)
+	fmt.Printf("Build: %d\n", int64(buildNumber))
+	if buildDate != "" {
+		fmt.Printf("Build Date: %s\n", buildDate)
+	}
+	return nil
+}
+
+// saveDeploymentType records the site's deployment type on the active
+// account. Nothing is saved when --base-url points the request elsewhere.
+func saveDeploymentType(deploymentType string) error {
+	if deploymentType == "" {
+		return nil
+	}
+	return updateActiveAccount(func(account *config.Account) bool {
+		if account.DeploymentType == deploymentType {
+			return false
+		}
+		account.DeploymentType = deploymentType
+		return true
+	})
+}
+
+func runJiraWhoami(cmd *cobra.Command, args []string) error {
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	user, err := client.GetCurrentUser()
+	if err != nil {
+		return fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	if jiraWhoamiSave {
+		if baseURLOverridden() {
+			return fmt.Errorf("--save can't be used with --base-url or ATLASSIAN_BASE_URL")
+		}
+		err := updateActiveAccount(func(account *config.Account) bool {
+			if account.AccountID == user.AccountID {
+				return false
+			}
+			account.AccountID = user.AccountID
+			return true
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save account ID: %w", err)
+		}
+	}
+
+	if outputJSON {
+		return printJSON(user)
+	}
+
+	fmt.Printf("User: %s\n", user.DisplayName)
+	fmt.Printf("Account ID: %s\n", user.AccountID)
+	if user.Email != "" {
+		fmt.Printf("Email: %s\n", user.Email)
+	}
+	if jiraWhoamiSave {
+		fmt.Printf("\n✓ Saved account ID with the active account\n")
+	}
+	return nil
+}
+
+// resolveProjectKey returns the --project value, falling back to the
+// jira-default-project setting
+func resolveProjectKey(flagValue string) (string, error) {
+	project, err := resolveSetting("jira-default-project", flagValue)
+	if err != nil {
+		return "", err
+	}
+	if project == "" {
+		return "", fmt.Errorf("--project is required (or set a default with 'atl config set jira-default-project KEY')")
+	}
+	return project, nil
+}
+
+func runJiraCreateVersion(cmd *cobra.Command, args []string) error {
+	var err error
+	jiraVersionProject, err = resolveProjectKey(jiraVersionProject)
+	if err != nil {
+		return err
+	}
+
+	if err := validateVersionDate("--start-date", jiraVersionStartDate); err != nil {
+		return err
+	}
+	if err := validateVersionDate("--release-date", jiraVersionReleaseDate); err != nil {
+		return err
+	}
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	version, err := client.CreateProjectVersion(&atlassian.CreateVersionOptions{
+		ProjectKey:  jiraVersionProject,
+		Name:        jiraVersionName,
+		Description: jiraVersionDescription,
+		StartDate:   jiraVersionStartDate,
+		ReleaseDate: jiraVersionReleaseDate,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create version: %w", err)
+	}
+
+	if outputJSON {
+		return printJSON(version)
+	}
+
+	fmt.Printf("✓ Created version %s in project %s\n", jiraVersionName, jiraVersionProject)
+	printVersionSummary(version)
+	return nil
+}
+
+func runJiraReleaseVersion(cmd *cobra.Command, args []string) error {
+	versionID := args[0]
+
+	releaseDate := jiraVersionReleaseDate
+	if releaseDate == "" {
+		releaseDate = time.Now().Format("2006-01-02")
+	}
+	if err := validateVersionDate("--release-date", releaseDate); err != nil {
+		return err
+	}
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	version, err := client.ReleaseVersion(versionID, releaseDate)
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return fmt.Errorf("failed to release version: %w", err)
+	}
+
+	if outputJSON {
+		return printJSON(version)
+	}
+
+	name, _ := version["name"].(string)
+	fmt.Printf("✓ Released version %s\n", name)
+	printVersionSummary(version)
+	return nil
+}
+
+// validateVersionDate checks that a version date flag uses YYYY-MM-DD
+func validateVersionDate(flag, value string) error {
+	if value == "" {
+		return nil
+	}
+	if _, err := time.Parse("2006-01-02", value); err != nil {
+		return fmt.Errorf("invalid %s '%s': expected YYYY-MM-DD", flag, value)
+	}
+	return nil
+}
+
+func printVersionSummary(version map[string]any) {
+	id, _ := version["id"].(string)
+	fmt.Printf("  ID: %s\n", id)
+	if description, ok := version["description"].(string); ok && description != "" {
+		fmt.Printf("  Description: %s\n", description)
+	}
+	if startDate, ok := version["startDate"].(string); ok && startDate != "" {
+		fmt.Printf("  Start Date: %s\n", startDate)
 	}
+	if releaseDate, ok := version["releaseDate"].(string); ok && releaseDate != "" {
+		fmt.Printf("  Release Date: %s\n", releaseDate)
+	}
+	released, _ := version["released"].(bool)
+	fmt.Printf("  Released: %t\n", released)
+}
+
+func runJiraGetRemoteLinks(cmd *cobra.Command, args []string) error {
+	issueKey := args[0]
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
 
 	// Get remote links
 	opts := &atlassian.GetRemoteLinksOptions{
@@ -1516,11 +4388,9 @@ func runJiraGetRemoteLinks(cmd *cobra.Command, args []string) error {
 	}
 
 	if outputJSON {
-		output, err := json.MarshalIndent(links, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(links); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
 	} else {
 		if len(links) == 0 {
 			fmt.Printf("No remote links found for %s\n", issueKey)
@@ -1549,20 +4419,11 @@ func runJiraGetCreateMeta(cmd *cobra.Command, args []string) error {
 	projectKey := args[0]
 	issueTypeID := args[1]
 
-	// Load config and get active account
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
-	}
-
-	account, err := cfg.GetActiveAccount()
+	client, _, err := newClient()
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
 	// Get create metadata
 	metadata, err := client.GetCreateMeta(projectKey, issueTypeID)
 	if err != nil {
@@ -1570,11 +4431,9 @@ func runJiraGetCreateMeta(cmd *cobra.Command, args []string) error {
 	}
 
 	if outputJSON {
-		output, err := json.MarshalIndent(metadata, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(metadata); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
 	} else {
 		// Pretty output - fields are returned as an array, not a map
 		fieldsArray, _ := metadata["fields"].([]any)
@@ -1658,26 +4517,17 @@ func runJiraGetFieldOptions(cmd *cobra.Command, args []string) error {
 
 	// Validate required flags
 	if jiraFieldOptionsProject == "" {
-		return fmt.Errorf("--project flag is required")
-	}
-	if jiraFieldOptionsIssueTypeID == "" {
-		return fmt.Errorf("--issue-type-id flag is required")
-	}
-
-	// Load config and get active account
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return fmt.Errorf("--project flag is required")
+	}
+	if jiraFieldOptionsIssueTypeID == "" {
+		return fmt.Errorf("--issue-type-id flag is required")
 	}
 
-	account, err := cfg.GetActiveAccount()
+	client, _, err := newClient()
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
 	// Get field options
 	options, err := client.GetFieldOptions(fieldKey, jiraFieldOptionsProject, jiraFieldOptionsIssueTypeID)
 	if err != nil {
@@ -1685,11 +4535,9 @@ func runJiraGetFieldOptions(cmd *cobra.Command, args []string) error {
 	}
 
 	if outputJSON {
-		output, err := json.MarshalIndent(options, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(options); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
 	} else {
 		// Pretty output
 		fieldName, _ := options["name"].(string)
@@ -1727,20 +4575,11 @@ func runJiraGetFieldOptions(cmd *cobra.Command, args []string) error {
 }
 
 func runJiraGetLinkTypes(cmd *cobra.Command, args []string) error {
-	// Load config and get active account
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
-	}
-
-	account, err := cfg.GetActiveAccount()
+	client, _, err := newClient()
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
 	// Get link types
 	linkTypes, err := client.GetIssueLinkTypes()
 	if err != nil {
@@ -1748,11 +4587,9 @@ func runJiraGetLinkTypes(cmd *cobra.Command, args []string) error {
 	}
 
 	if outputJSON {
-		output, err := json.MarshalIndent(linkTypes, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(linkTypes); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
 	} else {
 		if len(linkTypes) == 0 {
 			fmt.Println("No link types found.")
@@ -1777,20 +4614,11 @@ func runJiraGetLinkTypes(cmd *cobra.Command, args []string) error {
 func runJiraGetIssueLinks(cmd *cobra.Command, args []string) error {
 	issueKey := args[0]
 
-	// Load config and get active account
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
-	}
-
-	account, err := cfg.GetActiveAccount()
+	client, _, err := newClient()
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
 	// Get all links for the issue
 	links, err := client.GetIssueLinks(issueKey)
 	if err != nil {
@@ -1803,11 +4631,9 @@ func runJiraGetIssueLinks(cmd *cobra.Command, args []string) error {
 	}
 
 	if outputJSON {
-		output, err := json.MarshalIndent(links, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(links); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
 	} else {
 		fmt.Printf("Found %d link(s) for %s:\n\n", len(links), issueKey)
 
@@ -1841,20 +4667,11 @@ func runJiraCreateIssueLink(cmd *cobra.Command, args []string) error {
 	issueKey := args[0]
 	linkedIssue := jiraCreateLinkIssue
 
-	// Load config and get active account
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
-	}
-
-	account, err := cfg.GetActiveAccount()
+	client, _, err := newClient()
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
 	// Get all link types to resolve the type
 	linkTypes, err := client.GetIssueLinkTypes()
 	if err != nil {
@@ -1926,11 +4743,13 @@ func runJiraCreateIssueLink(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to link issues: %w", err)
 	}
 
-	// Pretty output to confirm what was created
-	if isOutward {
-		fmt.Printf("✓ Linked: %s %s %s\n", issueKey, matchedType.Outward, linkedIssue)
-	} else {
-		fmt.Printf("✓ Linked: %s %s %s\n", issueKey, matchedType.Inward, linkedIssue)
+	// Confirm what was created
+	direction := matchedType.Outward
+	if !isOutward {
+		direction = matchedType.Inward
+	}
+	if err := printNoContentResult(true, fmt.Sprintf("Linked: %s %s %s", issueKey, direction, linkedIssue)); err != nil {
+		return err
 	}
 
 	if jiraCreateLinkComment != "" {
@@ -1944,20 +4763,11 @@ func runJiraRemoveIssueLink(cmd *cobra.Command, args []string) error {
 	issueKey := args[0]
 	linkedIssue := jiraRemoveLinkIssue
 
-	// Load config and get active account
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
-	}
-
-	account, err := cfg.GetActiveAccount()
+	client, _, err := newClient()
 	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
+		return err
 	}
 
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
 	// Get all links for the issue
 	links, err := client.GetIssueLinks(issueKey)
 	if err != nil {
@@ -1965,8 +4775,7 @@ func runJiraRemoveIssueLink(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(links) == 0 {
-		fmt.Printf("No links found for %s\n", issueKey)
-		return nil
+		return printNoContentResult(false, fmt.Sprintf("No links found for %s", issueKey))
 	}
 
 	// Filter links to find those matching the criteria
@@ -2002,15 +4811,15 @@ func runJiraRemoveIssueLink(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(linksToDelete) == 0 {
-		fmt.Printf("No matching links found between %s and %s", issueKey, linkedIssue)
+		message := fmt.Sprintf("No matching links found between %s and %s", issueKey, linkedIssue)
 		if jiraRemoveLinkType != "" {
-			fmt.Printf(" with type '%s'", jiraRemoveLinkType)
+			message += fmt.Sprintf(" with type '%s'", jiraRemoveLinkType)
 		}
-		fmt.Println()
-		return nil
+		return printNoContentResult(false, message)
 	}
 
 	// Delete the links
+	var removed []string
 	for _, link := range linksToDelete {
 		if err := client.DeleteIssueLink(link.ID); err != nil {
 			return fmt.Errorf("failed to delete link %s: %w", link.ID, err)
@@ -2031,13 +4840,15 @@ func runJiraRemoveIssueLink(cmd *cobra.Command, args []string) error {
 		}
 
 		if otherIssue != nil {
-			fmt.Printf("✓ Removed link: %s %s %s\n", issueKey, direction, otherIssue.Key)
+			removed = append(removed, fmt.Sprintf("%s %s %s", issueKey, direction, otherIssue.Key))
 		}
 	}
 
-	fmt.Printf("\nRemoved %d link(s)\n", len(linksToDelete))
-
-	return nil
+	message := fmt.Sprintf("Removed %d link(s)", len(linksToDelete))
+	if len(removed) > 0 {
+		message += ": " + strings.Join(removed, ", ")
+	}
+	return printNoContentResult(true, message)
 }
 
 // getExistingAttachments fetches the issue's attachments and returns a map of
@@ -2109,3 +4920,487 @@ func downloadImageToTemp(rawURL string) (string, error) {
 
 	return tmpPath, nil
 }
+
+// issueComments groups the matching comments for one issue
+type issueComments struct {
+	Key      string           `json:"key"`
+	Summary  string           `json:"summary"`
+	Comments []map[string]any `json:"comments"`
+}
+
+func runJiraCommentsSince(cmd *cobra.Command, args []string) error {
+	if jiraCommentsSinceMaxIssues > 100 {
+		return fmt.Errorf("max-issues cannot exceed 100")
+	}
+	if jiraCommentsSinceMaxIssues < 0 {
+		return fmt.Errorf("max-issues must not be negative")
+	}
+
+	if atlassian.HasOrderBy(jiraCommentsSinceJQL) {
+		return fmt.Errorf("--jql must not contain an ORDER BY clause")
+	}
+
+	since, err := atlassian.ParseSince(jiraCommentsSinceSince, time.Now())
+	if err != nil {
+		return err
+	}
+
+	client, account, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	author := jiraCommentsSinceAuthor
+	if author == "me" {
+		author, err = currentAccountID(client, account)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Only issues updated since the cutoff can have new comments. The cutoff
+	// goes into the JQL as a relative offset so Jira reads it as the same
+	// instant the comment filter below uses, whatever the profile timezone.
+	jql := fmt.Sprintf("(%s) AND updated >= \"%s\" ORDER BY updated DESC", jiraCommentsSinceJQL, atlassian.JQLRelativeTime(since, time.Now()))
+
+	// Without --max-issues every matching issue is checked
+	searchOpts := &atlassian.SearchJQLOptions{
+		Fields:     []string{"summary"},
+		MaxResults: jiraCommentsSinceMaxIssues,
+		FetchAll:   jiraCommentsSinceMaxIssues == 0,
+	}
+	if searchOpts.FetchAll {
+		searchOpts.MaxResults = 100
+	}
+
+	result, err := client.SearchJiraIssuesJQL(jql, searchOpts)
+	if err != nil {
+		return fmt.Errorf("failed to search issues: %w", err)
+	}
+
+	issues, _ := result["issues"].([]any)
+	if isLast, ok := result["isLast"].(bool); ok && !isLast {
+		fmt.Fprintf(os.Stderr, "Warning: only the %d most recently updated issues were checked; raise --max-issues or omit it to check them all\n", len(issues))
+	}
+
+	var report []issueComments
+	for _, item := range issues {
+		issue, _ := item.(map[string]any)
+		key, _ := issue["key"].(string)
+		fields, _ := issue["fields"].(map[string]any)
+		summary, _ := fields["summary"].(string)
+
+		comments, err := client.GetAllIssueComments(key)
+		if err != nil {
+			return fmt.Errorf("failed to get comments for %s: %w", key, err)
+		}
+
+		var matching []map[string]any
+		for _, comment := range comments {
+			created, _ := comment["created"].(string)
+			createdAt, err := time.Parse(atlassian.JiraTimeLayout, created)
+			if err != nil || createdAt.Before(since) {
+				continue
+			}
+			if author != "" && !commentAuthorMatches(comment, author) {
+				continue
+			}
+			matching = append(matching, comment)
+		}
+
+		if len(matching) > 0 {
+			report = append(report, issueComments{Key: key, Summary: summary, Comments: matching})
+		}
+	}
+
+	if outputJSON {
+		if report == nil {
+			report = []issueComments{}
+		}
+		return printJSON(report)
+	}
+
+	if len(report) == 0 {
+		fmt.Printf("No comments since %s\n", since.Format("2006-01-02 15:04"))
+		return nil
+	}
+
+	total := 0
+	for _, entry := range report {
+		total += len(entry.Comments)
+	}
+	fmt.Printf("%d comment(s) on %d issue(s) since %s:\n", total, len(report), since.Format("2006-01-02 15:04"))
+
+	for _, entry := range report {
+		fmt.Printf("\n%s: %s\n", entry.Key, entry.Summary)
+		for _, comment := range entry.Comments {
+			authorInfo, _ := comment["author"].(map[string]any)
+			authorName, _ := authorInfo["displayName"].(string)
+
+			created, _ := comment["created"].(string)
+			if createdAt, err := time.Parse(atlassian.JiraTimeLayout, created); err == nil {
+				created = createdAt.Local().Format("2006-01-02 15:04")
+			}
+
+			fmt.Printf("  [%s] %s:\n", created, authorName)
+			text := atlassian.ADFToText(comment["body"])
+			for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+				fmt.Printf("    %s\n", line)
+			}
+		}
+	}
+
+	return nil
+}
+
+// commentAuthorMatches reports whether a comment was written by author,
+// compared against the account ID, display name, and email address
+func commentAuthorMatches(comment map[string]any, author string) bool {
+	authorInfo, _ := comment["author"].(map[string]any)
+	for _, key := range []string{"accountId", "displayName", "emailAddress"} {
+		if value, _ := authorInfo[key].(string); value != "" && strings.EqualFold(value, author) {
+			return true
+		}
+	}
+	return false
+}
+
+func runJiraWatchJQL(cmd *cobra.Command, args []string) error {
+	if jiraWatchInterval < time.Second {
+		return fmt.Errorf("--interval must be at least 1s")
+	}
+	if jiraWatchMaxResults > 100 {
+		return fmt.Errorf("max-results cannot exceed 100")
+	}
+
+	// Oldest first, so new issues print in the order they were created
+	jql, err := atlassian.ApplyJQLOrderBy(args[0], "created ASC", false)
+	if err != nil {
+		return err
+	}
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	// Ctrl-C cancels the root command's context, which ends the watch
+	ctx := cmd.Context()
+
+	seen := map[string]bool{}
+	baselined := false
+
+	// Every page is read on each poll, so an older issue that starts
+	// matching is noticed however many issues match
+	opts := &atlassian.SearchJQLOptions{
+		Fields:     []string{"summary", "status", "issuetype", "priority", "created"},
+		MaxResults: jiraWatchMaxResults,
+		FetchAll:   true,
+		OnPage: func(issues []any) error {
+			for _, item := range issues {
+				issue, _ := item.(map[string]any)
+				key, _ := issue["key"].(string)
+				if key == "" || seen[key] {
+					continue
+				}
+				seen[key] = true
+
+				// The first successful poll only establishes what already matches
+				if !baselined {
+					continue
+				}
+
+				if outputJSON {
+					if err := printJSON(issue); err != nil {
+						return err
+					}
+					continue
+				}
+
+				fields, _ := issue["fields"].(map[string]any)
+				summary, _ := fields["summary"].(string)
+				status := formatFieldValue(fields["status"])
+				fmt.Printf("[%s] %s [%s] %s\n", time.Now().Format("15:04:05"), key, status, summary)
+			}
+			return nil
+		},
+	}
+
+	for poll := 1; ; poll++ {
+		if _, err := client.SearchJiraIssuesJQL(jql, opts); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			// A transient failure shouldn't end the watch; try again next poll
+			fmt.Fprintf(os.Stderr, "[%s] Warning: failed to search issues: %v\n", time.Now().Format("15:04:05"), err)
+		} else if !baselined {
+			baselined = true
+			if !outputJSON {
+				fmt.Fprintf(os.Stderr, "Watching %d matching issue(s); polling every %s. Press Ctrl-C to stop.\n", len(seen), jiraWatchInterval)
+			}
+		}
+
+		if jiraWatchMaxPolls > 0 && poll >= jiraWatchMaxPolls {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(jiraWatchInterval):
+		}
+	}
+}
+
+// bulkAssignment is the planned or attempted assignment of one issue
+type bulkAssignment struct {
+	Issue     string `json:"issue"`
+	Summary   string `json:"summary"`
+	AccountID string `json:"accountId"`
+	Success   bool   `json:"success"`
+	Error     string `json:"error,omitempty"`
+}
+
+func runJiraBulkAssign(cmd *cobra.Command, args []string) error {
+	if jiraBulkAssignMaxIssues > 100 {
+		return fmt.Errorf("max-issues cannot exceed 100")
+	}
+	if jiraBulkAssignSaveTeam != "" && jiraBulkAssignTeam != "" {
+		return fmt.Errorf("--save-team requires --round-robin")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	team := jiraBulkAssignRoundRobin
+	if jiraBulkAssignTeam != "" {
+		team, err = cfg.GetTeam(jiraBulkAssignTeam)
+		if err != nil {
+			return err
+		}
+	}
+
+	var accountIDs []string
+	for _, id := range team {
+		if id = strings.TrimSpace(id); id != "" {
+			accountIDs = append(accountIDs, id)
+		}
+	}
+	if len(accountIDs) == 0 {
+		return fmt.Errorf("no account IDs to assign to")
+	}
+
+	// A dry run changes nothing, including the config
+	if jiraBulkAssignSaveTeam != "" && jiraBulkAssignDryRun {
+		if !outputJSON {
+			fmt.Printf("Dry run: would save team '%s' (%d accounts)\n\n", jiraBulkAssignSaveTeam, len(accountIDs))
+		}
+	} else if jiraBulkAssignSaveTeam != "" {
+		cfg.SetTeam(jiraBulkAssignSaveTeam, accountIDs)
+		if err := cfg.Save(); err != nil {
+			return fmt.Errorf("failed to save config: %w", err)
+		}
+		if !outputJSON {
+			fmt.Printf("✓ Saved team '%s' (%d accounts)\n\n", jiraBulkAssignSaveTeam, len(accountIDs))
+		}
+	}
+
+	// Oldest first, so the longest-waiting issues are spread out first
+	jql, err := atlassian.ApplyJQLOrderBy(jiraBulkAssignJQL, "created ASC", false)
+	if err != nil {
+		return err
+	}
+
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	result, err := client.SearchJiraIssuesJQL(jql, &atlassian.SearchJQLOptions{
+		Fields:     []string{"summary"},
+		MaxResults: jiraBulkAssignMaxIssues,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to search issues: %w", err)
+	}
+
+	issues, _ := result["issues"].([]any)
+	assignments := make([]bulkAssignment, 0, len(issues))
+	for i, item := range issues {
+		issue, _ := item.(map[string]any)
+		key, _ := issue["key"].(string)
+		fields, _ := issue["fields"].(map[string]any)
+		summary, _ := fields["summary"].(string)
+
+		assignments = append(assignments, bulkAssignment{
+			Issue:     key,
+			Summary:   summary,
+			AccountID: accountIDs[i%len(accountIDs)],
+		})
+	}
+
+	if len(assignments) == 0 {
+		if outputJSON {
+			return printJSON(assignments)
+		}
+		fmt.Println("No issues match the query.")
+		return nil
+	}
+
+	if jiraBulkAssignDryRun {
+		if outputJSON {
+			return printJSON(assignments)
+		}
+		fmt.Printf("Dry run: would assign %d issue(s) across %d account(s):\n\n", len(assignments), len(accountIDs))
+		for _, a := range assignments {
+			fmt.Printf("  %s → %s  %s\n", a.Issue, a.AccountID, a.Summary)
+		}
+		return nil
+	}
+
+	failed := 0
+	for i := range assignments {
+		a := &assignments[i]
+		fields := map[string]any{"assignee": map[string]any{"id": a.AccountID}}
+		err := client.EditJiraIssueWithOptions(a.Issue, fields, &atlassian.EditIssueOptions{SkipNotifications: jiraBulkAssignNoNotify})
+		if err != nil {
+			a.Error = err.Error()
+			failed++
+		} else {
+			a.Success = true
+		}
+
+		if !outputJSON {
+			if a.Success {
+				fmt.Printf("✓ %s → %s\n", a.Issue, a.AccountID)
+			} else {
+				fmt.Printf("✗ %s → %s: %s\n", a.Issue, a.AccountID, a.Error)
+			}
+		}
+	}
+
+	if outputJSON {
+		if err := printJSON(assignments); err != nil {
+			return err
+		}
+	} else {
+		fmt.Printf("\nAssigned %d of %d issue(s)\n", len(assignments)-failed, len(assignments))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d assignment(s) failed", failed, len(assignments))
+	}
+	return nil
+}
+
+// bulkTransition is one issue's outcome in bulk-transition. Skipped issues
+// weren't attempted because an earlier one failed.
+type bulkTransition struct {
+	Issue   string `json:"issue"`
+	Summary string `json:"summary"`
+	Status  string `json:"status"`
+	Success bool   `json:"success"`
+	Skipped bool   `json:"skipped,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+func runJiraBulkTransition(cmd *cobra.Command, args []string) error {
+	client, _, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	// Collect every page before changing anything, since transitioned issues
+	// may drop out of the query and shift later pages
+	result, err := client.SearchJiraIssuesJQL(jiraBulkTransitionJQL, &atlassian.SearchJQLOptions{
+		Fields:     []string{"summary", "status"},
+		MaxResults: 100,
+		FetchAll:   true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to search issues: %w", err)
+	}
+
+	issues, _ := result["issues"].([]any)
+	transitions := make([]bulkTransition, 0, len(issues))
+	for _, item := range issues {
+		issue, _ := item.(map[string]any)
+		key, _ := issue["key"].(string)
+		fields, _ := issue["fields"].(map[string]any)
+		summary, _ := fields["summary"].(string)
+		status, _ := lookupPath(fields, "status.name")
+		statusName, _ := status.(string)
+
+		transitions = append(transitions, bulkTransition{Issue: key, Summary: summary, Status: statusName})
+	}
+
+	if len(transitions) == 0 {
+		if outputJSON {
+			return printJSON(transitions)
+		}
+		fmt.Println("No issues match the query.")
+		return nil
+	}
+
+	if jiraBulkTransitionDryRun {
+		if outputJSON {
+			return printJSON(transitions)
+		}
+		fmt.Printf("Dry run: would apply transition %s to %d issue(s):\n\n", jiraBulkTransitionID, len(transitions))
+		for _, t := range transitions {
+			fmt.Printf("  %s [%s]  %s\n", t.Issue, t.Status, t.Summary)
+		}
+		return nil
+	}
+
+	succeeded, failed := 0, 0
+	for i := range transitions {
+		t := &transitions[i]
+		if failed > 0 && !jiraBulkTransitionContinueOnError {
+			t.Skipped = true
+			continue
+		}
+
+		err := client.TransitionIssue(t.Issue, &atlassian.TransitionIssueOptions{
+			TransitionID: jiraBulkTransitionID,
+		})
+		if err != nil {
+			t.Error = err.Error()
+			failed++
+		} else {
+			t.Success = true
+			succeeded++
+		}
+
+		if !outputJSON {
+			if t.Success {
+				fmt.Printf("✓ %s\n", t.Issue)
+			} else {
+				fmt.Printf("✗ %s: %s\n", t.Issue, t.Error)
+			}
+		}
+	}
+
+	skipped := len(transitions) - succeeded - failed
+	if outputJSON {
+		if err := printJSON(transitions); err != nil {
+			return err
+		}
+	} else {
+		fmt.Printf("\nTransitioned %d of %d issue(s)", succeeded, len(transitions))
+		if failed > 0 {
+			fmt.Printf(", %d failed", failed)
+		}
+		if skipped > 0 {
+			fmt.Printf(", %d skipped (use --continue-on-error to attempt them)", skipped)
+		}
+		fmt.Println()
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d transition(s) failed", failed, len(transitions))
+	}
+	return nil
+}
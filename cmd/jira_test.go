@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/doughughes/atlassian-cli/internal/atlassian"
+)
+
+func TestGetWorklogs_AllPagesHeaderTotal(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("startAt") == "" {
+			w.Write([]byte(`{"startAt":0,"maxResults":2,"total":3,"worklogs":[{"id":"1","timeSpentSeconds":3600},{"id":"2","timeSpentSeconds":1800}]}`))
+			return
+		}
+		w.Write([]byte(`{"startAt":2,"maxResults":2,"total":3,"worklogs":[{"id":"3","timeSpentSeconds":1800}]}`))
+	}))
+	defer server.Close()
+
+	client := atlassian.NewClient("user@example.com", "token", server.URL)
+
+	listing, err := fetchWorklogs(client, "PROJ-1", &atlassian.GetWorklogsOptions{}, true)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if pages, _ := listing.raw.([]map[string]any); len(pages) != 2 {
+		t.Errorf("Expected both raw pages for --json, got %v", listing.raw)
+	}
+
+	var out bytes.Buffer
+	printWorklogs(&out, "PROJ-1", listing)
+	if !strings.Contains(out.String(), "(showing 1-3 of 3)") {
+		t.Errorf("Expected header to count all 3 worklogs, got:\n%s", out.String())
+	}
+	if !strings.Contains(out.String(), "Total logged: ") || strings.Contains(out.String(), "(this page)") {
+		t.Errorf("Expected an unqualified total, got:\n%s", out.String())
+	}
+}
+
+func TestPromptFieldValue(t *testing.T) {
+	options := []any{
+		map[string]any{"id": "1", "name": "Fixed"},
+		map[string]any{"id": "2", "name": "Won't Fix"},
+		map[string]any{"id": "3", "name": "Duplicate"},
+	}
+
+	tests := []struct {
+		name     string
+		field    map[string]any
+		input    string
+		expected any
+		wantErr  bool
+	}{
+		{"Option by number", map[string]any{"schema": map[string]any{"type": "resolution"}, "allowedValues": options}, "2\n", map[string]any{"id": "2"}, false},
+		{"Option out of range", map[string]any{"schema": map[string]any{"type": "resolution"}, "allowedValues": options}, "4\n", nil, true},
+		{"Option not a number", map[string]any{"schema": map[string]any{"type": "resolution"}, "allowedValues": options}, "Fixed\n", nil, true},
+		{"Several options for a single value", map[string]any{"schema": map[string]any{"type": "option"}, "allowedValues": options}, "1,2\n", nil, true},
+		{"Array of options", map[string]any{"schema": map[string]any{"type": "array"}, "allowedValues": options}, "1, 3\n", []any{map[string]any{"id": "1"}, map[string]any{"id": "3"}}, false},
+		{"String", map[string]any{"schema": map[string]any{"type": "string"}}, "  Needs more info \n", "Needs more info", false},
+		{"Number", map[string]any{"schema": map[string]any{"type": "number"}}, "2.5\n", 2.5, false},
+		{"Invalid number", map[string]any{"schema": map[string]any{"type": "number"}}, "lots\n", nil, true},
+		{"User", map[string]any{"schema": map[string]any{"type": "user"}}, "abc123\n", map[string]any{"accountId": "abc123"}, false},
+		{"JSON", map[string]any{"schema": map[string]any{"type": "array"}}, `["a","b"]` + "\n", []any{"a", "b"}, false},
+		{"Invalid JSON", map[string]any{"schema": map[string]any{"type": "array"}}, "[a\n", nil, true},
+		{"Last line without newline", map[string]any{"schema": map[string]any{"type": "string"}}, "done", "done", false},
+		{"No input", map[string]any{"schema": map[string]any{"type": "string"}}, "", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.field["name"] = "Field"
+			value, err := promptFieldValue(bufio.NewReader(strings.NewReader(tt.input)), io.Discard, "customfield_1", tt.field)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected an error, got %v", value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !reflect.DeepEqual(value, tt.expected) {
+				t.Errorf("Expected %#v, got %#v", tt.expected, value)
+			}
+		})
+	}
+}
+
+func TestPromptTransitionFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("expand") != "transitions.fields" {
+			t.Errorf("Expected transitions.fields expanded, got %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"transitions":[{"id":"31","fields":{
+			"resolution":{"name":"Resolution","required":true,"schema":{"type":"resolution"},"allowedValues":[{"id":"1","name":"Fixed"},{"id":"2","name":"Won't Fix"}]},
+			"customfield_2":{"name":"Reason","required":true,"schema":{"type":"string"}},
+			"customfield_3":{"name":"Defaulted","required":true,"hasDefaultValue":true,"schema":{"type":"string"}},
+			"comment":{"name":"Comment","required":false,"schema":{"type":"comment"}}
+		}}]}`))
+	}))
+	defer server.Close()
+
+	client := atlassian.NewClient("user@example.com", "token", server.URL)
+
+	// Prompts come in key order: customfield_2, then resolution
+	fields, err := promptTransitionFields(client, strings.NewReader("Duplicate of PROJ-2\n2\n"), "PROJ-1", "31", map[string]any{"labels": []any{"x"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := map[string]any{
+		"labels":        []any{"x"},
+		"customfield_2": "Duplicate of PROJ-2",
+		"resolution":    map[string]any{"id": "2"},
+	}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("Expected %v, got %v", expected, fields)
+	}
+}
@@ -1,10 +1,14 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/doughughes/atlassian-cli/internal/atlassian"
+	"github.com/doughughes/atlassian-cli/internal/cache"
 	"github.com/doughughes/atlassian-cli/internal/config"
 	"github.com/spf13/cobra"
 )
@@ -38,31 +42,130 @@ Examples:
 	RunE: runMetaGetResources,
 }
 
+var metaSearchCmd = &cobra.Command{
+	Use:   "search [query]",
+	Short: "Search Jira and Confluence at once",
+	Long: `Run a text search across Jira issues and Confluence content.
+
+Searches can be saved by name with --save and re-run later with --run.
+Saved searches are stored in the config file along with their scoping flags.
+
+Examples:
+  atl meta search "deploy runbook"
+  atl meta search "deploy runbook" --product confluence --space OPS
+  atl meta search "deploy runbook" --project OPS --save deploy-runbook
+  atl meta search --run deploy-runbook
+  atl meta search --list`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runMetaSearch,
+}
+
+var metaResolveARICmd = &cobra.Command{
+	Use:   "resolve-ari <ari>",
+	Short: "Parse an Atlassian Resource Identifier",
+	Long: `Split an ARI into its product, cloud ID, resource type, and resource ID.
+
+No network request is made; the ARI is only validated and parsed.
+
+Examples:
+  atl meta resolve-ari ari:cloud:jira:a436116f-02ce-4520-8fbb-7301462a1674:issue/10001
+  atl meta resolve-ari ari:cloud:confluence:a436116f-02ce-4520-8fbb-7301462a1674:page/123456 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMetaResolveARI,
+}
+
+var metaFetchCmd = &cobra.Command{
+	Use:   "fetch <ari>",
+	Short: "Fetch a Jira issue or Confluence page by ARI",
+	Long: `Fetch the resource an ARI points to. Supported resource types are Jira
+issues and Confluence pages and blog posts.
+
+--cache-ttl reuses a previous response for the same ARI if it is younger than
+the given duration, which saves requests when a script fetches the same
+resources repeatedly. Cached responses are stored in your user cache directory.
+
+Examples:
+  atl meta fetch ari:cloud:jira:a436116f-02ce-4520-8fbb-7301462a1674:issue/10001
+  atl meta fetch ari:cloud:confluence:a436116f-02ce-4520-8fbb-7301462a1674:page/123456 --json
+  atl meta fetch ari:cloud:jira:a436116f-02ce-4520-8fbb-7301462a1674:issue/10001 --cache-ttl 5m`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMetaFetch,
+}
+
+var metaPingCmd = &cobra.Command{
+	Use:   "ping",
+	Short: "Check connectivity and credentials",
+	Long: `Make a minimal authenticated request to the site and report the round-trip
+time. Exits non-zero if any request fails, so it works as a CI gate.
+
+Failed pings are not retried, so --max-retries doesn't apply.
+
+Examples:
+  atl meta ping
+  atl meta ping --count 5
+  atl meta ping --count 5 --json`,
+	Args: cobra.NoArgs,
+	RunE: runMetaPing,
+}
+
+var (
+	// Flags for ping
+	metaPingCount int
+
+	// Flags for fetch
+	metaFetchCacheTTL time.Duration
+
+	// Flags for search
+	metaSearchProduct string
+	metaSearchProject string
+	metaSearchSpace   string
+	metaSearchLimit   int
+	metaSearchSave    string
+	metaSearchRun     string
+	metaSearchList    bool
+)
+
 func init() {
 	rootCmd.AddCommand(metaCmd)
 	metaCmd.AddCommand(metaUserInfoCmd)
 	metaCmd.AddCommand(metaGetResourcesCmd)
+	metaCmd.AddCommand(metaSearchCmd)
+	metaCmd.AddCommand(metaResolveARICmd)
+	metaCmd.AddCommand(metaFetchCmd)
+	metaCmd.AddCommand(metaPingCmd)
 
 	// Flags
 	metaUserInfoCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
 	metaGetResourcesCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for search
+	metaSearchCmd.Flags().StringVar(&metaSearchProduct, "product", "", "Only search one product (jira or confluence)")
+	metaSearchCmd.Flags().StringVar(&metaSearchProject, "project", "", "Limit Jira results to a project key")
+	metaSearchCmd.Flags().StringVar(&metaSearchSpace, "space", "", "Limit Confluence results to a space key")
+	metaSearchCmd.Flags().IntVar(&metaSearchLimit, "limit", 10, "Maximum results per product")
+	metaSearchCmd.Flags().StringVar(&metaSearchSave, "save", "", "Save this search under a name")
+	metaSearchCmd.Flags().StringVar(&metaSearchRun, "run", "", "Run a saved search by name")
+	metaSearchCmd.Flags().BoolVar(&metaSearchList, "list", false, "List saved searches")
+	metaSearchCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for resolve-ari
+	metaResolveARICmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for fetch
+	metaFetchCmd.Flags().DurationVar(&metaFetchCacheTTL, "cache-ttl", 0, "Reuse a cached response younger than this (e.g. 30s, 5m); 0 disables caching")
+	metaFetchCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
+
+	// Flags for ping
+	metaPingCmd.Flags().IntVar(&metaPingCount, "count", 1, "Number of requests to make")
+	metaPingCmd.Flags().BoolVar(&outputJSON, "json", false, "Output as JSON")
 }
 
 func runMetaUserInfo(cmd *cobra.Command, args []string) error {
-	// Load config and get active account
-	cfg, err := config.Load()
+	client, _, err := newClient()
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return err
 	}
 
-	account, err := cfg.GetActiveAccount()
-	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
-	}
-
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
 	// Get user info
 	user, err := client.GetCurrentUser()
 	if err != nil {
@@ -70,11 +173,9 @@ func runMetaUserInfo(cmd *cobra.Command, args []string) error {
 	}
 
 	if outputJSON {
-		output, err := json.MarshalIndent(user, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(user); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
 	} else {
 		fmt.Printf("User: %s\n", user.DisplayName)
 		fmt.Printf("Account ID: %s\n", user.AccountID)
@@ -88,20 +189,11 @@ func runMetaUserInfo(cmd *cobra.Command, args []string) error {
 }
 
 func runMetaGetResources(cmd *cobra.Command, args []string) error {
-	// Load config and get active account
-	cfg, err := config.Load()
+	client, _, err := newClient()
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return err
 	}
 
-	account, err := cfg.GetActiveAccount()
-	if err != nil {
-		return fmt.Errorf("not logged in. Run 'atl auth login' first")
-	}
-
-	// Create client
-	client := atlassian.NewClient(account.Email, account.Token, account.Site)
-
 	// Get resources (Note: This may fail with Basic Auth)
 	resources, err := client.GetAccessibleResources()
 	if err != nil {
@@ -109,11 +201,9 @@ func runMetaGetResources(cmd *cobra.Command, args []string) error {
 	}
 
 	if outputJSON {
-		output, err := json.MarshalIndent(resources, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format output: %w", err)
+		if err := printJSON(resources); err != nil {
+			return err
 		}
-		fmt.Println(string(output))
 	} else {
 		if len(resources) == 0 {
 			fmt.Println("No accessible resources found.")
@@ -132,3 +222,434 @@ func runMetaGetResources(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func runMetaSearch(cmd *cobra.Command, args []string) error {
+	if metaSearchList {
+		return listSavedSearches()
+	}
+
+	var search *config.SavedSearch
+
+	if metaSearchRun != "" {
+		if len(args) > 0 || metaSearchSave != "" {
+			return fmt.Errorf("--run cannot be combined with a query or --save")
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+
+		search, err = cfg.GetSavedSearch(metaSearchRun)
+		if err != nil {
+			return fmt.Errorf("%w. Use 'atl meta search --list' to see saved searches", err)
+		}
+	} else {
+		if len(args) == 0 {
+			return fmt.Errorf("a query is required (or use --run or --list)")
+		}
+
+		search = &config.SavedSearch{
+			Query:   args[0],
+			Product: metaSearchProduct,
+			Project: metaSearchProject,
+			Space:   metaSearchSpace,
+			Limit:   metaSearchLimit,
+		}
+	}
+
+	if search.Product != "" && search.Product != "jira" && search.Product != "confluence" {
+		return fmt.Errorf("invalid --product '%s'. Valid products: jira, confluence", search.Product)
+	}
+
+	if metaSearchSave != "" {
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+
+		cfg.SetSavedSearch(metaSearchSave, search)
+		if err := cfg.Save(); err != nil {
+			return fmt.Errorf("failed to save config: %w", err)
+		}
+
+		if !outputJSON {
+			fmt.Printf("✓ Saved search '%s'\n\n", metaSearchSave)
+		}
+	}
+
+	client, account, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	limit := search.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	output := map[string]any{"query": search.Query}
+
+	var jiraResult, confluenceResult map[string]any
+
+	if search.Product == "" || search.Product == "jira" {
+		jql := "text ~ " + atlassian.QuoteQueryValue(search.Query)
+		if search.Project != "" {
+			jql += " AND project = " + atlassian.QuoteQueryValue(search.Project)
+		}
+		jql += " ORDER BY updated DESC"
+
+		jiraResult, err = client.SearchJiraIssuesJQL(jql, &atlassian.SearchJQLOptions{
+			Fields:     []string{"summary", "status", "issuetype"},
+			MaxResults: limit,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to search Jira: %w", err)
+		}
+		output["jira"] = jiraResult
+	}
+
+	if search.Product == "" || search.Product == "confluence" {
+		cql := "text ~ " + atlassian.QuoteQueryValue(search.Query) + " AND type IN (page, blogpost)"
+		if search.Space != "" {
+			cql += " AND space = " + atlassian.QuoteQueryValue(search.Space)
+		}
+
+		confluenceResult, err = client.SearchConfluenceCQL(cql, &atlassian.SearchCQLOptions{
+			Limit:  limit,
+			Expand: "space",
+		})
+		if err != nil {
+			return fmt.Errorf("failed to search Confluence: %w", err)
+		}
+		output["confluence"] = confluenceResult
+	}
+
+	if outputJSON {
+		return printJSON(output)
+	}
+
+	if jiraResult != nil {
+		issues, _ := jiraResult["issues"].([]any)
+		fmt.Printf("Jira (%d):\n", len(issues))
+		if len(issues) == 0 {
+			fmt.Println("  (no matches)")
+		}
+		for _, item := range issues {
+			issue, _ := item.(map[string]any)
+			key, _ := issue["key"].(string)
+			fields, _ := issue["fields"].(map[string]any)
+			summary, _ := fields["summary"].(string)
+			status, _ := fields["status"].(map[string]any)
+			statusName, _ := status["name"].(string)
+			fmt.Printf("  %s [%s] %s\n", key, statusName, summary)
+		}
+	}
+
+	if confluenceResult != nil {
+		if jiraResult != nil {
+			fmt.Println()
+		}
+		results, _ := confluenceResult["results"].([]any)
+		fmt.Printf("Confluence (%d):\n", len(results))
+		if len(results) == 0 {
+			fmt.Println("  (no matches)")
+		}
+		for _, item := range results {
+			content, _ := item.(map[string]any)
+			id, _ := content["id"].(string)
+			title, _ := content["title"].(string)
+			space, _ := content["space"].(map[string]any)
+			spaceKey, _ := space["key"].(string)
+
+			fmt.Printf("  %s (ID: %s, Space: %s)\n", title, id, spaceKey)
+			if links, ok := content["_links"].(map[string]any); ok {
+				if webui, ok := links["webui"].(string); ok {
+					webURL := fmt.Sprintf("%s/wiki%s", account.Site, webui)
+					if !strings.HasPrefix(account.Site, "http") {
+						webURL = "https://" + webURL
+					}
+					fmt.Printf("    %s\n", webURL)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func listSavedSearches() error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	if outputJSON {
+		searches := cfg.SavedSearches
+		if searches == nil {
+			searches = map[string]*config.SavedSearch{}
+		}
+		return printJSON(searches)
+	}
+
+	if len(cfg.SavedSearches) == 0 {
+		fmt.Println("No saved searches. Save one with: atl meta search \"<query>\" --save <name>")
+		return nil
+	}
+
+	names := make([]string, 0, len(cfg.SavedSearches))
+	for name := range cfg.SavedSearches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Println("Saved searches:")
+	for _, name := range names {
+		search := cfg.SavedSearches[name]
+
+		var scope []string
+		if search.Product != "" {
+			scope = append(scope, "product: "+search.Product)
+		}
+		if search.Project != "" {
+			scope = append(scope, "project: "+search.Project)
+		}
+		if search.Space != "" {
+			scope = append(scope, "space: "+search.Space)
+		}
+
+		fmt.Printf("  %s: \"%s\"", name, search.Query)
+		if len(scope) > 0 {
+			fmt.Printf(" (%s)", strings.Join(scope, ", "))
+		}
+		fmt.Println()
+	}
+
+	return nil
+}
+
+func runMetaResolveARI(cmd *cobra.Command, args []string) error {
+	ari, err := atlassian.ParseARI(args[0])
+	if err != nil {
+		return err
+	}
+
+	if outputJSON {
+		return printJSON(ari)
+	}
+
+	cloudID := ari.CloudID
+	if cloudID == "" {
+		cloudID = "(none)"
+	}
+
+	fmt.Printf("Product: %s\n", ari.Product)
+	fmt.Printf("Cloud ID: %s\n", cloudID)
+	fmt.Printf("Type: %s\n", ari.ResourceType)
+	fmt.Printf("ID: %s\n", ari.ResourceID)
+
+	return nil
+}
+
+func runMetaFetch(cmd *cobra.Command, args []string) error {
+	ari, err := atlassian.ParseARI(args[0])
+	if err != nil {
+		return err
+	}
+
+	kind := ari.Product + ":" + ari.ResourceType
+	switch kind {
+	case "jira:issue", "confluence:page", "confluence:blogpost":
+	default:
+		return fmt.Errorf("unsupported resource type '%s'. Supported: jira issue, confluence page, confluence blogpost", kind)
+	}
+
+	client, account, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	if err := checkARISite(ari, account, client); err != nil {
+		return err
+	}
+
+	var store *cache.Store
+	cacheKey := account.Site + " " + ari.String()
+	if metaFetchCacheTTL > 0 {
+		store, err = cache.DefaultStore()
+		if err != nil {
+			return err
+		}
+	}
+
+	var result map[string]any
+	cached := false
+	if store != nil {
+		cached, err = store.Get(cacheKey, metaFetchCacheTTL, &result)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !cached {
+		if ari.Product == "jira" {
+			result, err = client.GetJiraIssue(ari.ResourceID, nil)
+		} else {
+			var expand []string
+			expand, err = resolvePageExpand(nil, "space", "version")
+			if err == nil {
+				result, err = client.GetConfluencePage(ari.ResourceID, &atlassian.GetPageOptions{Expand: expand})
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("failed to fetch %s: %w", ari, err)
+		}
+
+		if store != nil {
+			if err := store.Put(cacheKey, result); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			}
+		}
+	}
+
+	if outputJSON {
+		return printJSON(result)
+	}
+
+	if ari.Product == "jira" {
+		printIssuePretty(result)
+	} else {
+		id, _ := result["id"].(string)
+		title, _ := result["title"].(string)
+		space, _ := result["space"].(map[string]any)
+		spaceKey, _ := space["key"].(string)
+		version, _ := result["version"].(map[string]any)
+		versionNumber, _ := version["number"].(float64)
+
+		fmt.Printf("Title: %s\n", title)
+		fmt.Printf("ID: %s\n", id)
+		fmt.Printf("Type: %s\n", ari.ResourceType)
+		fmt.Printf("Space: %s\n", spaceKey)
+		fmt.Printf("Version: %.0f\n", versionNumber)
+		fmt.Printf("\nView content: atl confluence get-page %s\n", id)
+	}
+
+	if cached {
+		fmt.Fprintf(os.Stderr, "(cached response)\n")
+	}
+
+	return nil
+}
+
+// checkARISite returns an error when an ARI names a different cloud ID than
+// the account's site. Resource IDs are only unique within a site, so fetching
+// it from the wrong site would return an unrelated issue or page.
+func checkARISite(ari *atlassian.ARI, account *config.Account, client *atlassian.Client) error {
+	if ari.CloudID == "" {
+		return nil
+	}
+
+	cloudID := account.CloudID
+	if cloudID == "" {
+		var err error
+		cloudID, err = client.GetCloudID()
+		if err != nil {
+			return fmt.Errorf("failed to get the cloud ID of %s to check the ARI against: %w", account.Site, err)
+		}
+	}
+
+	if !strings.EqualFold(cloudID, ari.CloudID) {
+		return fmt.Errorf("%s belongs to cloud %s, but %s is cloud %s. Use --account to fetch it with the account for that site", ari, ari.CloudID, account.Site, cloudID)
+	}
+	return nil
+}
+
+func runMetaPing(cmd *cobra.Command, args []string) error {
+	if metaPingCount < 1 {
+		return fmt.Errorf("--count must be at least 1")
+	}
+
+	client, account, err := newClient()
+	if err != nil {
+		return err
+	}
+	client.Retry = atlassian.RetryPolicy{}
+
+	type pingResult struct {
+		Seq       int     `json:"seq"`
+		OK        bool    `json:"ok"`
+		LatencyMs float64 `json:"latencyMs"`
+		Error     string  `json:"error,omitempty"`
+	}
+
+	var results []pingResult
+	var latencies []time.Duration
+	for seq := 1; seq <= metaPingCount; seq++ {
+		start := time.Now()
+		err := client.Ping()
+		elapsed := time.Since(start)
+
+		result := pingResult{Seq: seq, OK: err == nil, LatencyMs: durationMs(elapsed)}
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			latencies = append(latencies, elapsed)
+		}
+		results = append(results, result)
+
+		if !outputJSON {
+			if err != nil {
+				fmt.Printf("✗ %s: seq=%d %v\n", account.Site, seq, err)
+			} else {
+				fmt.Printf("✓ %s: seq=%d time=%s\n", account.Site, seq, elapsed.Round(time.Millisecond))
+			}
+		}
+	}
+
+	failed := metaPingCount - len(latencies)
+	var minLatency, avgLatency, maxLatency time.Duration
+	if len(latencies) > 0 {
+		minLatency, maxLatency = latencies[0], latencies[0]
+		var total time.Duration
+		for _, latency := range latencies {
+			minLatency = min(minLatency, latency)
+			maxLatency = max(maxLatency, latency)
+			total += latency
+		}
+		avgLatency = total / time.Duration(len(latencies))
+	}
+
+	if outputJSON {
+		summary := map[string]any{
+			"site":      account.Site,
+			"count":     metaPingCount,
+			"succeeded": len(latencies),
+			"failed":    failed,
+			"results":   results,
+		}
+		if len(latencies) > 0 {
+			summary["minMs"] = durationMs(minLatency)
+			summary["avgMs"] = durationMs(avgLatency)
+			summary["maxMs"] = durationMs(maxLatency)
+		}
+		if err := printJSON(summary); err != nil {
+			return err
+		}
+	} else if metaPingCount > 1 {
+		fmt.Printf("\n%d request(s), %d succeeded, %d failed\n", metaPingCount, len(latencies), failed)
+		if len(latencies) > 0 {
+			fmt.Printf("min/avg/max = %s/%s/%s\n",
+				minLatency.Round(time.Millisecond), avgLatency.Round(time.Millisecond), maxLatency.Round(time.Millisecond))
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d ping(s) failed", failed, metaPingCount)
+	}
+	return nil
+}
+
+// durationMs converts d to fractional milliseconds, rounded to 0.1ms
+func durationMs(d time.Duration) float64 {
+	return float64(d.Round(100*time.Microsecond)) / float64(time.Millisecond)
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/doughughes/atlassian-cli/internal/atlassian"
+	"github.com/doughughes/atlassian-cli/internal/config"
+)
+
+func TestCheckARISite(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/_edge/tenant_info" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"cloudId":"b536116f-02ce-4520-8fbb-7301462a1674"}`))
+	}))
+	defer server.Close()
+
+	client := atlassian.NewClient("user@example.com", "token", server.URL)
+
+	tests := []struct {
+		name    string
+		ari     string
+		cloudID string // Saved on the account; empty looks it up from the site
+		wantErr bool
+	}{
+		{"Saved cloud ID matches", "ari:cloud:jira:a436116f-02ce-4520-8fbb-7301462a1674:issue/10001", "a436116f-02ce-4520-8fbb-7301462a1674", false},
+		{"Saved cloud ID differs", "ari:cloud:jira:b536116f-02ce-4520-8fbb-7301462a1674:issue/10001", "a436116f-02ce-4520-8fbb-7301462a1674", true},
+		{"Looked-up cloud ID matches", "ari:cloud:confluence:b536116f-02ce-4520-8fbb-7301462a1674:page/123", "", false},
+		{"Looked-up cloud ID differs", "ari:cloud:confluence:a436116f-02ce-4520-8fbb-7301462a1674:page/123", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ari, err := atlassian.ParseARI(tt.ari)
+			if err != nil {
+				t.Fatalf("Expected a valid ARI, got %v", err)
+			}
+			account := &config.Account{Site: "example.atlassian.net", CloudID: tt.cloudID}
+
+			err = checkARISite(ari, account, client)
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "--account") {
+					t.Errorf("Expected an error suggesting --account, got %v", err)
+				}
+			} else if err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,472 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"github.com/doughughes/atlassian-cli/internal/atlassian"
+	"github.com/itchyny/gojq"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// jqCode holds the compiled --jq expression, set by the root command before
+// any subcommand runs
+var jqCode *gojq.Code
+
+// compileJQ parses and compiles a --jq expression so syntax errors are
+// reported before any API request is made
+func compileJQ(expr string) (*gojq.Code, error) {
+	query, err := gojq.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --jq expression: %w", err)
+	}
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --jq expression: %w", err)
+	}
+	return code, nil
+}
+
+// outputTemplate holds the parsed --template or --template-file, set by the
+// root command before any subcommand runs
+var outputTemplate *template.Template
+
+// outputTemplateFuncs are the helper functions available to --template
+var outputTemplateFuncs = template.FuncMap{
+	"adf2text": atlassian.ADFToText,
+	"date":     formatTemplateDate,
+}
+
+// parseOutputTemplate parses a --template so syntax errors are reported
+// before any API request is made
+func parseOutputTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("output").Funcs(outputTemplateFuncs).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// formatTemplateDate reformats a Jira or Confluence timestamp with a Go time
+// layout, in local time. Missing values format as "".
+func formatTemplateDate(layout string, value any) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("date: expected a timestamp string, got %T", value)
+	}
+	if s == "" {
+		return "", nil
+	}
+	for _, parseLayout := range []string{atlassian.JiraTimeLayout, time.RFC3339Nano, "2006-01-02"} {
+		if t, err := time.Parse(parseLayout, s); err == nil {
+			return t.Local().Format(layout), nil
+		}
+	}
+	return "", fmt.Errorf("date: can't parse timestamp %q", s)
+}
+
+// printJSON prints v as indented JSON, or on one line with --compact. When
+// --jq is set the expression is run against v and each result is printed
+// instead, and when --template is set v is rendered through the template.
+func printJSON(v any) error {
+	if jqCode != nil {
+		return printJQ(v)
+	}
+	if outputTemplate != nil {
+		return printTemplate(v)
+	}
+
+	output, err := marshalOutput(v)
+	if err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+// marshalOutput encodes v for printing, honoring --compact
+func marshalOutput(v any) ([]byte, error) {
+	if compactJSON {
+		return json.Marshal(v)
+	}
+	return json.MarshalIndent(v, "", "  ")
+}
+
+func printJQ(v any) error {
+	// gojq only understands plain JSON values, so round-trip typed structs
+	// through encoding/json first
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+	var input any
+	if err := json.Unmarshal(data, &input); err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+
+	iter := jqCode.Run(input)
+	for {
+		result, ok := iter.Next()
+		if !ok {
+			return nil
+		}
+		if err, ok := result.(error); ok {
+			if err, ok := err.(*gojq.HaltError); ok && err.Value() == nil {
+				return nil
+			}
+			return fmt.Errorf("--jq: %w", err)
+		}
+		output, err := marshalOutput(result)
+		if err != nil {
+			return fmt.Errorf("failed to format output: %w", err)
+		}
+		fmt.Println(string(output))
+	}
+}
+
+func printTemplate(v any) error {
+	// Templates see the same plain JSON values --jq does, so typed structs
+	// are addressed by their JSON field names
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+	var input any
+	if err := json.Unmarshal(data, &input); err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+
+	var buf strings.Builder
+	if err := outputTemplate.Execute(&buf, input); err != nil {
+		return fmt.Errorf("--template: %w", err)
+	}
+	fmt.Print(buf.String())
+	return nil
+}
+
+// printNoContentResult prints the outcome of an operation whose API returns
+// no body (typically 204 No Content). In JSON mode a small status object is
+// synthesized so scripts always receive valid JSON; otherwise the message is
+// printed with a success or failure marker.
+func printNoContentResult(success bool, message string) error {
+	if outputJSON {
+		response := map[string]any{
+			"success": success,
+			"message": message,
+		}
+		if success {
+			response["status"] = http.StatusNoContent
+		}
+		return printJSON(response)
+	}
+
+	if success {
+		fmt.Printf("✓ %s\n", message)
+	} else {
+		fmt.Printf("✗ %s\n", message)
+	}
+	return nil
+}
+
+// lookupPath follows a dotted path (e.g. "fields.status.name") into nested
+// maps and reports whether it was found
+func lookupPath(data any, path string) (any, bool) {
+	value := data
+	for _, part := range strings.Split(path, ".") {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = m[part]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// sortByPath sorts items by the value at a dotted path. Numbers compare
+// numerically and everything else by its display text, case-insensitively.
+// Items with a missing or empty value sort last in either direction.
+func sortByPath(items []any, path string, desc bool) {
+	type keyed struct {
+		item    any
+		number  float64
+		text    string
+		numeric bool
+		missing bool
+	}
+
+	keys := make([]keyed, len(items))
+	for i, item := range items {
+		k := keyed{item: item}
+		value, ok := lookupPath(item, path)
+		switch v := value.(type) {
+		case float64:
+			k.number, k.numeric = v, true
+		default:
+			k.text = strings.ToLower(formatFieldValue(v))
+			k.missing = !ok || k.text == ""
+		}
+		keys[i] = k
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.missing || b.missing {
+			return !a.missing && b.missing
+		}
+		var less bool
+		if a.numeric && b.numeric {
+			less = a.number < b.number
+			if desc {
+				less = b.number < a.number
+			}
+		} else {
+			less = a.text < b.text
+			if desc {
+				less = b.text < a.text
+			}
+		}
+		return less
+	})
+
+	for i, k := range keys {
+		items[i] = k.item
+	}
+}
+
+// selectFields projects data down to the given dotted paths (e.g.
+// "version.number"), keeping the original nesting. Paths that don't exist
+// are omitted.
+func selectFields(data map[string]any, paths []string) map[string]any {
+	result := map[string]any{}
+	for _, path := range paths {
+		parts := strings.Split(strings.TrimSpace(path), ".")
+
+		var value any = data
+		found := true
+		for _, part := range parts {
+			m, ok := value.(map[string]any)
+			if !ok {
+				found = false
+				break
+			}
+			if value, ok = m[part]; !ok {
+				found = false
+				break
+			}
+		}
+		if !found {
+			continue
+		}
+
+		// Rebuild the nested structure down to the selected value
+		target := result
+		for _, part := range parts[:len(parts)-1] {
+			next, ok := target[part].(map[string]any)
+			if !ok {
+				next = map[string]any{}
+				target[part] = next
+			}
+			target = next
+		}
+		target[parts[len(parts)-1]] = value
+	}
+	return result
+}
+
+// printFlattened prints every scalar leaf of v as a dotted key=value line
+// (e.g. fields.status.name=In Progress), sorted by key. Array elements use
+// their index as the key segment and null values are skipped. Newlines in
+// values are escaped so each entry stays on one line.
+func printFlattened(v any) error {
+	// Round-trip through encoding/json so typed structs flatten too
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("failed to format output: %w", err)
+	}
+
+	lines := map[string]string{}
+	flattenValue("", value, lines)
+
+	keys := make([]string, 0, len(lines))
+	for key := range lines {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	escaper := strings.NewReplacer("\\", "\\\\", "\n", "\\n", "\r", "\\r")
+	for _, key := range keys {
+		fmt.Printf("%s=%s\n", key, escaper.Replace(lines[key]))
+	}
+	return nil
+}
+
+// flattenValue walks maps and arrays, recording scalar leaves in out under
+// their dotted path
+func flattenValue(prefix string, value any, out map[string]string) {
+	join := func(key string) string {
+		if prefix == "" {
+			return key
+		}
+		return prefix + "." + key
+	}
+
+	switch v := value.(type) {
+	case nil:
+	case map[string]any:
+		for key, child := range v {
+			flattenValue(join(key), child, out)
+		}
+	case []any:
+		for i, child := range v {
+			flattenValue(join(strconv.Itoa(i)), child, out)
+		}
+	case float64:
+		out[prefix] = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		out[prefix] = fmt.Sprint(v)
+	}
+}
+
+// resolveWrapWidth returns the width to wrap body text to: the --wrap value
+// when it was given, otherwise the terminal width. 0 means no wrapping,
+// which is also the default when stdout isn't a terminal.
+func resolveWrapWidth(cmd *cobra.Command, wrap int) (int, error) {
+	if cmd.Flags().Changed("wrap") {
+		if wrap < 0 {
+			return 0, fmt.Errorf("--wrap must not be negative")
+		}
+		return wrap, nil
+	}
+
+	width, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		return 0, nil
+	}
+	return width, nil
+}
+
+// printIndentedText prints text indented by two spaces, wrapped to fit
+// within width columns (0 disables wrapping)
+func printIndentedText(text string, width int) {
+	if width > 2 {
+		text = atlassian.WrapText(text, width-2)
+	}
+	for _, line := range strings.Split(text, "\n") {
+		fmt.Printf("  %s\n", line)
+	}
+}
+
+// defaultMaxColWidth is the default --max-col-width for --output table
+const defaultMaxColWidth = 50
+
+// resolveTableOutput validates --output for commands offering pretty, json,
+// and table output, turning on JSON output for "json"
+func resolveTableOutput(output string, maxColWidth int) error {
+	switch output {
+	case "pretty":
+	case "table":
+		if outputJSON {
+			return fmt.Errorf("--json can't be combined with --output table")
+		}
+	case "json":
+		outputJSON = true
+	default:
+		return fmt.Errorf("invalid --output '%s'. Valid formats: pretty, json, table", output)
+	}
+	if maxColWidth < 0 {
+		return fmt.Errorf("--max-col-width must not be negative")
+	}
+	return nil
+}
+
+// printTable prints rows as aligned columns under a header row. Cells longer
+// than maxWidth runes are cut short with an ellipsis (0 disables truncation).
+func printTable(headers []string, rows [][]string, maxWidth int) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	writeRow := func(cells []string) {
+		for i, cell := range cells {
+			if i > 0 {
+				fmt.Fprint(w, "\t")
+			}
+			fmt.Fprint(w, truncateCell(cell, maxWidth))
+		}
+		fmt.Fprintln(w)
+	}
+
+	writeRow(headers)
+	for _, row := range rows {
+		writeRow(row)
+	}
+	w.Flush()
+}
+
+// truncateCell flattens a value onto one line and shortens it to width runes,
+// ending with an ellipsis when it was cut
+func truncateCell(s string, width int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if width <= 0 || utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	if width == 1 {
+		return "…"
+	}
+	runes := []rune(s)
+	return string(runes[:width-1]) + "…"
+}
+
+// tableRow returns the display value at each dotted path in item, for use
+// as a table row
+func tableRow(item map[string]any, columns []string) []string {
+	row := make([]string, len(columns))
+	for i, column := range columns {
+		value, _ := lookupPath(item, column)
+		row[i] = formatFieldValue(value)
+	}
+	return row
+}
+
+// tableHeaders returns upper-cased column names for a table header row
+func tableHeaders(columns []string) []string {
+	headers := make([]string, len(columns))
+	for i, column := range columns {
+		headers[i] = strings.ToUpper(column)
+	}
+	return headers
+}
+
+// confirmAction asks the user to confirm a destructive action on stderr and
+// reports whether they answered yes. It refuses to guess when stdin isn't a
+// terminal, so scripts must pass --yes instead.
+func confirmAction(prompt string) (bool, error) {
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return false, fmt.Errorf("refusing to continue without confirmation; use --yes when not running interactively")
+	}
+
+	fmt.Fprintf(os.Stderr, "%s [y/N] ", prompt)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && answer == "" {
+		return false, nil
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes", nil
+}
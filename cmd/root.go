@@ -1,6 +1,14 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/doughughes/atlassian-cli/internal/atlassian"
 	"github.com/spf13/cobra"
 )
 
@@ -9,12 +17,117 @@ var rootCmd = &cobra.Command{
 	Short: "CLI tool for Atlassian Jira and Confluence",
 	Long: `A command-line interface for interacting with Atlassian products.
 Supports Jira and Confluence with 1:1 mapping to their REST APIs.`,
+	PersistentPreRunE: runRootPersistentPreRun,
 }
 
+// Global flags
+var (
+	jqExpression     string
+	compactJSON      bool
+	templateText     string
+	templateFilePath string
+	accountFlag      string
+)
+
 func Execute() error {
-	return rootCmd.Execute()
+	// Ctrl-C cancels in-flight requests so commands stop cleanly. After the
+	// first signal the default handling is restored, so a second one kills
+	// the process outright.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	return rootCmd.ExecuteContext(ctx)
 }
 
 func init() {
-	// Global flags can be added here if needed
+	rootCmd.PersistentFlags().StringVar(&jqExpression, "jq", "", "Filter JSON output with a jq expression (implies --json)")
+	rootCmd.PersistentFlags().BoolVar(&compactJSON, "compact", false, "Print JSON output on a single line instead of indented")
+	rootCmd.PersistentFlags().StringVar(&templateText, "template", "", "Format JSON output with a Go template (implies --json)")
+	rootCmd.PersistentFlags().StringVar(&templateFilePath, "template-file", "", "Read the --template from this file (\"-\" for stdin)")
+	rootCmd.PersistentFlags().IntVar(&maxRetriesFlag, "max-retries", atlassian.DefaultMaxRetries, "Times to retry a failed request (0 disables retries)")
+	rootCmd.PersistentFlags().StringVar(&retryOnFlag, "retry-on", atlassian.FormatRetryStatuses(atlassian.DefaultRetryStatuses), "Comma-separated HTTP status codes to retry")
+	rootCmd.PersistentFlags().StringArrayVar(&headerFlags, "header", nil, "Extra request header as \"Key: Value\" (repeatable)")
+	rootCmd.PersistentFlags().StringVar(&accountFlag, "account", "", "Run as this configured account instead of the active one")
+	rootCmd.PersistentFlags().DurationVar(&timeoutFlag, "timeout", atlassian.DefaultTimeout, "Time limit for each request, e.g. 90s or 5m (0 disables the limit)")
+
+	// Advanced/testing only: point a single invocation at a mock server or
+	// staging instance instead of the logged-in site
+	rootCmd.PersistentFlags().StringVar(&baseURLOverride, "base-url", "", "Override the site URL for this invocation (testing only; also ATLASSIAN_BASE_URL)")
+	rootCmd.PersistentFlags().MarkHidden("base-url")
+}
+
+// stdinFileFlags are the command flags that read their content from stdin
+// when given "-"
+var stdinFileFlags = []string{"body-file", "comment-file", "description-file"}
+
+// stdinPromptFlags are the command flags that, when set, prompt for answers
+// on stdin
+var stdinPromptFlags = []string{"fields-from-meta"}
+
+func runRootPersistentPreRun(cmd *cobra.Command, args []string) error {
+	headers, err := atlassian.ParseHeaders(headerFlags)
+	if err != nil {
+		return fmt.Errorf("invalid --header: %w", err)
+	}
+	extraHeaders = headers
+
+	if timeoutFlag < 0 {
+		return fmt.Errorf("--timeout must not be negative")
+	}
+	requestContext = cmd.Context()
+
+	if jqExpression != "" {
+		code, err := compileJQ(jqExpression)
+		if err != nil {
+			return err
+		}
+		jqCode = code
+		outputJSON = true
+	}
+
+	outputTemplateText := templateText
+	if templateFilePath != "" {
+		if outputTemplateText != "" {
+			return fmt.Errorf("--template and --template-file can't be used together")
+		}
+		if templateFilePath == "-" {
+			for _, name := range stdinFileFlags {
+				if f := cmd.Flags().Lookup(name); f != nil && f.Value.String() == "-" {
+					return fmt.Errorf("--template-file and --%s can't both read from stdin", name)
+				}
+			}
+			for _, name := range stdinPromptFlags {
+				if f := cmd.Flags().Lookup(name); f != nil && f.Value.String() == "true" {
+					return fmt.Errorf("--template-file - and --%s can't both read from stdin", name)
+				}
+			}
+		}
+		// Read the file as is, since trailing newlines matter in a template
+		var data []byte
+		if templateFilePath == "-" {
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(templateFilePath)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read template file: %w", err)
+		}
+		outputTemplateText = string(data)
+	}
+	if outputTemplateText != "" {
+		if jqExpression != "" {
+			return fmt.Errorf("--jq and --template can't be used together")
+		}
+		tmpl, err := parseOutputTemplate(outputTemplateText)
+		if err != nil {
+			return err
+		}
+		outputTemplate = tmpl
+		outputJSON = true
+	}
+	return nil
 }
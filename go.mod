@@ -3,6 +3,7 @@ module github.com/doughughes/atlassian-cli
 go 1.25.4
 
 require (
+	github.com/itchyny/gojq v0.12.19
 	github.com/spf13/cobra v1.10.1
 	github.com/yuin/goldmark v1.5.4
 	golang.org/x/term v0.37.0
@@ -10,6 +11,7 @@ require (
 
 require (
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
+	github.com/itchyny/timefmt-go v0.1.8 // indirect
 	github.com/spf13/pflag v1.0.9 // indirect
 	golang.org/x/sys v0.38.0 // indirect
 )
@@ -1,13 +1,32 @@
 package atlassian
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 // ADFToText converts Atlassian Document Format (ADF) to plain text with basic formatting
 // ADF is used by both Jira and Confluence for rich text content
 func ADFToText(adf any) string {
+	return ADFToTextWithOptions(adf, nil)
+}
+
+// ADFToTextOptions contains options for converting ADF to text
+type ADFToTextOptions struct {
+	// MarkdownSafe escapes characters in text that markdown would otherwise
+	// read as formatting (*, _, `, and # at the start of a line), so the
+	// output renders as intended in a CommonMark renderer
+	MarkdownSafe bool
+}
+
+// ADFToTextWithOptions converts ADF to plain text like ADFToText, with
+// additional options
+func ADFToTextWithOptions(adf any, opts *ADFToTextOptions) string {
 	if adf == nil {
 		return ""
 	}
@@ -17,12 +36,87 @@ func ADFToText(adf any) string {
 		return ""
 	}
 
+	escape := opts != nil && opts.MarkdownSafe
+
 	var sb strings.Builder
-	processNode(doc, &sb, 0)
+	processNode(doc, &sb, 0, escape)
 	return strings.TrimSpace(sb.String())
 }
 
-func processNode(node map[string]any, sb *strings.Builder, indent int) {
+// adfTextNodeTypes lists the node types ADFToText knows how to render
+var adfTextNodeTypes = map[string]bool{
+	"doc": true, "paragraph": true, "heading": true, "text": true,
+	"bulletList": true, "orderedList": true, "listItem": true,
+	"codeBlock": true, "blockquote": true, "hardBreak": true, "rule": true,
+	"mention": true, "emoji": true, "mediaSingle": true, "media": true,
+	"mediaInline": true, "inlineCard": true, "blockCard": true, "panel": true,
+	"table": true, "tableRow": true, "tableHeader": true, "tableCell": true,
+	"taskList": true, "taskItem": true, "status": true, "date": true,
+}
+
+// ADFUnhandledNodeTypes returns the sorted, de-duplicated node types in adf
+// that ADFToText doesn't recognize. Their children are still rendered, but
+// any text they carry themselves is dropped, so these explain gaps in the
+// converted output.
+func ADFUnhandledNodeTypes(adf any) []string {
+	seen := map[string]bool{}
+	var walk func(node any)
+	walk = func(node any) {
+		m, ok := node.(map[string]any)
+		if !ok {
+			return
+		}
+		if nodeType, _ := m["type"].(string); !adfTextNodeTypes[nodeType] {
+			if nodeType == "" {
+				nodeType = "(missing type)"
+			}
+			seen[nodeType] = true
+		}
+		content, _ := m["content"].([]any)
+		for _, child := range content {
+			walk(child)
+		}
+	}
+	walk(adf)
+
+	types := make([]string, 0, len(seen))
+	for nodeType := range seen {
+		types = append(types, nodeType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// ParseADFDocument parses raw JSON as an ADF document, checking that its
+// root node is a doc so it can be sent as a rich text field as-is
+func ParseADFDocument(data string) (map[string]any, error) {
+	var doc map[string]any
+	if err := json.Unmarshal([]byte(data), &doc); err != nil {
+		return nil, fmt.Errorf("invalid ADF JSON: %w", err)
+	}
+	if nodeType, _ := doc["type"].(string); nodeType != "doc" {
+		return nil, fmt.Errorf("invalid ADF: root node must have type \"doc\"")
+	}
+	if content, ok := doc["content"]; ok {
+		if _, ok := content.([]any); !ok {
+			return nil, fmt.Errorf("invalid ADF: doc content must be an array")
+		}
+	}
+	return doc, nil
+}
+
+// ADFHasContent reports whether adf is a document with at least one node,
+// as opposed to an empty or missing document
+func ADFHasContent(adf any) bool {
+	doc, ok := adf.(map[string]any)
+	if !ok {
+		return false
+	}
+	content, _ := doc["content"].([]any)
+	return len(content) > 0
+}
+
+func processNode(node map[string]any, sb *strings.Builder, indent int, escape bool) {
 	nodeType, _ := node["type"].(string)
 	content, _ := node["content"].([]any)
 
@@ -31,7 +125,7 @@ func processNode(node map[string]any, sb *strings.Builder, indent int) {
 		// Root document node
 		for _, child := range content {
 			if childMap, ok := child.(map[string]any); ok {
-				processNode(childMap, sb, indent)
+				processNode(childMap, sb, indent, escape)
 			}
 		}
 
@@ -39,7 +133,7 @@ func processNode(node map[string]any, sb *strings.Builder, indent int) {
 		writeIndent(sb, indent)
 		for _, child := range content {
 			if childMap, ok := child.(map[string]any); ok {
-				processNode(childMap, sb, indent)
+				processNode(childMap, sb, indent, escape)
 			}
 		}
 		sb.WriteString("\n")
@@ -53,7 +147,7 @@ func processNode(node map[string]any, sb *strings.Builder, indent int) {
 		sb.WriteString(" ")
 		for _, child := range content {
 			if childMap, ok := child.(map[string]any); ok {
-				processNode(childMap, sb, indent)
+				processNode(childMap, sb, indent, escape)
 			}
 		}
 		sb.WriteString("\n")
@@ -62,8 +156,13 @@ func processNode(node map[string]any, sb *strings.Builder, indent int) {
 		text, _ := node["text"].(string)
 		marks, _ := node["marks"].([]any)
 
+		if escape && !hasMark(marks, "code") {
+			text = escapeMarkdownText(text, atLineStart(sb))
+		}
+
 		// Apply text formatting based on marks
 		formatted := text
+		href := ""
 		for _, mark := range marks {
 			if markMap, ok := mark.(map[string]any); ok {
 				markType, _ := markMap["type"].(string)
@@ -76,33 +175,31 @@ func processNode(node map[string]any, sb *strings.Builder, indent int) {
 					formatted = "`" + formatted + "`"
 				case "strike":
 					formatted = "~~" + formatted + "~~"
+				case "link":
+					attrs, _ := markMap["attrs"].(map[string]any)
+					href, _ = attrs["href"].(string)
 				}
 			}
 		}
+		// Links wrap the other marks so formatting stays inside the link text
+		if href != "" {
+			formatted = "[" + formatted + "](" + href + ")"
+		}
 		sb.WriteString(formatted)
 
-	case "bulletList":
-		for _, child := range content {
-			if childMap, ok := child.(map[string]any); ok {
-				processListItem(childMap, sb, indent, "•")
-			}
-		}
+	case "bulletList", "orderedList", "taskList":
+		processList(node, sb, indent, escape)
 		sb.WriteString("\n")
 
-	case "orderedList":
-		for i, child := range content {
-			if childMap, ok := child.(map[string]any); ok {
-				marker := fmt.Sprintf("%d.", i+1)
-				processListItem(childMap, sb, indent, marker)
-			}
-		}
-		sb.WriteString("\n")
+	case "taskItem":
+		// Only reached for a stray item outside a task list
+		processTaskItem(node, sb, indent, escape)
 
 	case "listItem":
 		// Handled by parent list nodes
 		for _, child := range content {
 			if childMap, ok := child.(map[string]any); ok {
-				processNode(childMap, sb, indent)
+				processNode(childMap, sb, indent, escape)
 			}
 		}
 
@@ -112,7 +209,8 @@ func processNode(node map[string]any, sb *strings.Builder, indent int) {
 		sb.WriteString("```\n")
 		for _, child := range content {
 			if childMap, ok := child.(map[string]any); ok {
-				processNode(childMap, sb, indent)
+				// Code is shown verbatim, so it is never escaped
+				processNode(childMap, sb, indent, false)
 			}
 		}
 		writeIndent(sb, indent)
@@ -123,7 +221,7 @@ func processNode(node map[string]any, sb *strings.Builder, indent int) {
 			if childMap, ok := child.(map[string]any); ok {
 				writeIndent(sb, indent)
 				sb.WriteString("> ")
-				processNode(childMap, sb, indent)
+				processNode(childMap, sb, indent, escape)
 			}
 		}
 
@@ -146,10 +244,19 @@ func processNode(node map[string]any, sb *strings.Builder, indent int) {
 		shortName, _ := attrs["shortName"].(string)
 		sb.WriteString(shortName)
 
+	case "status":
+		attrs, _ := node["attrs"].(map[string]any)
+		text, _ := attrs["text"].(string)
+		sb.WriteString("[" + strings.ToUpper(text) + "]")
+
+	case "date":
+		attrs, _ := node["attrs"].(map[string]any)
+		sb.WriteString(formatADFDate(attrs["timestamp"]))
+
 	case "mediaSingle":
 		for _, child := range content {
 			if childMap, ok := child.(map[string]any); ok {
-				processNode(childMap, sb, indent)
+				processNode(childMap, sb, indent, escape)
 			}
 		}
 		sb.WriteString("\n")
@@ -161,9 +268,14 @@ func processNode(node map[string]any, sb *strings.Builder, indent int) {
 			sb.WriteString(fmt.Sprintf("[Image: %s]", alt))
 		} else {
 			filename, _ := attrs["filename"].(string)
-			if filename != "" {
+			id, _ := attrs["id"].(string)
+			switch {
+			case filename != "":
 				sb.WriteString(fmt.Sprintf("[Attached image: %s]", filename))
-			} else {
+			case id != "":
+				// The media ID at least identifies which attachment it was
+				sb.WriteString(fmt.Sprintf("[Attachment: %s]", id))
+			default:
 				sb.WriteString("[Attached image]")
 			}
 		}
@@ -180,7 +292,9 @@ func processNode(node map[string]any, sb *strings.Builder, indent int) {
 	case "inlineCard", "blockCard":
 		attrs, _ := node["attrs"].(map[string]any)
 		url, _ := attrs["url"].(string)
-		sb.WriteString(url)
+		if url != "" {
+			sb.WriteString("<" + url + ">")
+		}
 
 	case "panel":
 		attrs, _ := node["attrs"].(map[string]any)
@@ -190,71 +304,292 @@ func processNode(node map[string]any, sb *strings.Builder, indent int) {
 		sb.WriteString(fmt.Sprintf("[%s]\n", strings.ToUpper(panelType)))
 		for _, child := range content {
 			if childMap, ok := child.(map[string]any); ok {
-				processNode(childMap, sb, indent+2)
+				processNode(childMap, sb, indent+2, escape)
 			}
 		}
 		sb.WriteString("\n")
 
 	case "table":
-		// Simple table rendering - just show content
+		writeTable(node, sb, indent, escape)
+
+	case "tableRow", "tableHeader", "tableCell":
+		// Only reached for stray cells outside a table
 		for _, child := range content {
 			if childMap, ok := child.(map[string]any); ok {
-				processNode(childMap, sb, indent)
+				processNode(childMap, sb, indent, escape)
 			}
 		}
 
-	case "tableRow":
-		writeIndent(sb, indent)
+	default:
+		// For unknown nodes, process children if they exist
 		for _, child := range content {
 			if childMap, ok := child.(map[string]any); ok {
-				processNode(childMap, sb, indent)
-				sb.WriteString(" | ")
+				processNode(childMap, sb, indent, escape)
+			}
+		}
+	}
+}
+
+// writeTable renders an ADF table as a GitHub-flavored markdown table with
+// padded columns, laid out the same way as ADFToMarkdown's tables
+func writeTable(node map[string]any, sb *strings.Builder, indent int, escape bool) {
+	var rows [][]string
+	rowNodes, _ := node["content"].([]any)
+	for _, r := range rowNodes {
+		row, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+		cellNodes, _ := row["content"].([]any)
+		var cells []string
+		for _, c := range cellNodes {
+			if cell, ok := c.(map[string]any); ok {
+				cells = append(cells, tableCellText(cell, escape))
 			}
 		}
+		rows = append(rows, cells)
+	}
+
+	lines := formatMarkdownTable(rows)
+	if len(lines) == 0 {
+		return
+	}
+
+	sb.WriteString("\n")
+	for _, line := range lines {
+		writeIndent(sb, indent)
+		sb.WriteString(line)
 		sb.WriteString("\n")
+	}
+	sb.WriteString("\n")
+}
 
-	case "tableHeader", "tableCell":
-		for _, child := range content {
-			if childMap, ok := child.(map[string]any); ok {
-				processNode(childMap, sb, indent)
+// formatMarkdownTable lays out rows of cell text as the lines of a
+// GitHub-flavored markdown table with padded columns. Markdown tables need a
+// header, so the first row is always used as one. Rows with fewer cells than
+// the widest row are padded with empty cells.
+func formatMarkdownTable(rows [][]string) []string {
+	// Separator rows need at least three dashes per column
+	var widths []int
+	for _, row := range rows {
+		for i, cell := range row {
+			if i == len(widths) {
+				widths = append(widths, 3)
+			}
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+	if len(widths) == 0 {
+		return nil
+	}
+
+	formatRow := func(row []string, pad string) string {
+		var sb strings.Builder
+		for i, width := range widths {
+			cell := ""
+			if i < len(row) {
+				cell = row[i]
 			}
+			sb.WriteString("| ")
+			sb.WriteString(cell)
+			sb.WriteString(strings.Repeat(pad, width-utf8.RuneCountInString(cell)))
+			sb.WriteString(" ")
 		}
+		sb.WriteString("|")
+		return sb.String()
+	}
 
-	default:
-		// For unknown nodes, process children if they exist
+	lines := []string{formatRow(rows[0], " "), formatRow(nil, "-")}
+	for _, row := range rows[1:] {
+		lines = append(lines, formatRow(row, " "))
+	}
+	return lines
+}
+
+// tableCellText renders a table cell's content on a single line. Separate
+// lines, such as the cell's paragraphs, are joined with <br>, and pipes are
+// escaped so they don't end the cell.
+func tableCellText(cell map[string]any, escape bool) string {
+	var lines []string
+	content, _ := cell["content"].([]any)
+	for _, child := range content {
+		childMap, ok := child.(map[string]any)
+		if !ok {
+			continue
+		}
+		var childSB strings.Builder
+		processNode(childMap, &childSB, 0, escape)
+		for _, line := range strings.Split(childSB.String(), "\n") {
+			if line = strings.Join(strings.Fields(line), " "); line != "" {
+				lines = append(lines, line)
+			}
+		}
+	}
+	return strings.ReplaceAll(strings.Join(lines, "<br>"), "|", `\|`)
+}
+
+// processList renders the items of a bulletList, orderedList, or taskList at
+// indent. Ordered lists are numbered from their order attribute, which
+// defaults to 1.
+func processList(node map[string]any, sb *strings.Builder, indent int, escape bool) {
+	nodeType, _ := node["type"].(string)
+	content, _ := node["content"].([]any)
+
+	if nodeType == "taskList" {
+		// A nested task list is a sibling of the items it belongs under
 		for _, child := range content {
-			if childMap, ok := child.(map[string]any); ok {
-				processNode(childMap, sb, indent)
+			childMap, ok := child.(map[string]any)
+			if !ok {
+				continue
+			}
+			if childType, _ := childMap["type"].(string); childType == "taskList" {
+				processList(childMap, sb, indent+2, escape)
+			} else {
+				processTaskItem(childMap, sb, indent, escape)
 			}
 		}
+		return
+	}
+
+	number := 1
+	if attrs, ok := node["attrs"].(map[string]any); ok {
+		if order, ok := attrs["order"].(float64); ok && order >= 1 {
+			number = int(order)
+		}
+	}
+	// Pad markers to the same width so item text lines up ("9. " and "10.")
+	width := len(strconv.Itoa(number+len(content)-1)) + 1
+
+	for _, child := range content {
+		if childMap, ok := child.(map[string]any); ok {
+			marker := "•"
+			if nodeType == "orderedList" {
+				marker = fmt.Sprintf("%-*s", width, strconv.Itoa(number)+".")
+				number++
+			}
+			processListItem(childMap, sb, indent, marker, escape)
+		}
 	}
 }
 
-func processListItem(node map[string]any, sb *strings.Builder, indent int, marker string) {
+// processListItem writes the item's marker followed by its first paragraph
+// on the same line. Anything after that, including nested lists, goes on
+// its own lines indented two more spaces.
+func processListItem(node map[string]any, sb *strings.Builder, indent int, marker string, escape bool) {
 	content, _ := node["content"].([]any)
 	writeIndent(sb, indent)
 	sb.WriteString(marker)
 	sb.WriteString(" ")
 
-	for _, child := range content {
-		if childMap, ok := child.(map[string]any); ok {
-			childType, _ := childMap["type"].(string)
-			if childType == "paragraph" {
-				// For paragraphs in list items, don't add extra newline
-				childContent, _ := childMap["content"].([]any)
-				for _, grandChild := range childContent {
-					if grandChildMap, ok := grandChild.(map[string]any); ok {
-						processNode(grandChildMap, sb, indent)
-					}
+	for i, child := range content {
+		childMap, ok := child.(map[string]any)
+		if !ok {
+			continue
+		}
+		childType, _ := childMap["type"].(string)
+
+		switch {
+		case i == 0 && childType == "paragraph":
+			childContent, _ := childMap["content"].([]any)
+			for _, grandChild := range childContent {
+				if grandChildMap, ok := grandChild.(map[string]any); ok {
+					processNode(grandChildMap, sb, indent, escape)
 				}
-			} else {
-				processNode(childMap, sb, indent+2)
 			}
+		case childType == "bulletList" || childType == "orderedList" || childType == "taskList":
+			// Nested lists follow directly, without the blank line that ends
+			// a top-level list
+			if !atLineStart(sb) {
+				sb.WriteString("\n")
+			}
+			processList(childMap, sb, indent+2, escape)
+		default:
+			if !atLineStart(sb) {
+				sb.WriteString("\n")
+			}
+			processNode(childMap, sb, indent+2, escape)
 		}
 	}
-	sb.WriteString("\n")
+	if !atLineStart(sb) {
+		sb.WriteString("\n")
+	}
+}
+
+// processTaskItem writes a task as a markdown checkbox, "- [x] text" when
+// its state is DONE and "- [ ] text" otherwise
+func processTaskItem(node map[string]any, sb *strings.Builder, indent int, escape bool) {
+	attrs, _ := node["attrs"].(map[string]any)
+	checkbox := "[ ]"
+	if state, _ := attrs["state"].(string); state == "DONE" {
+		checkbox = "[x]"
+	}
+
+	writeIndent(sb, indent)
+	sb.WriteString("- " + checkbox + " ")
+	content, _ := node["content"].([]any)
+	for _, child := range content {
+		if childMap, ok := child.(map[string]any); ok {
+			processNode(childMap, sb, indent+2, escape)
+		}
+	}
+	if !atLineStart(sb) {
+		sb.WriteString("\n")
+	}
+}
+
+// formatADFDate formats a date node's timestamp, milliseconds since the
+// epoch sent as a string, as a YYYY-MM-DD date. A timestamp that can't be
+// parsed is returned as it is.
+func formatADFDate(timestamp any) string {
+	var ms int64
+	switch v := timestamp.(type) {
+	case string:
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return v
+		}
+		ms = parsed
+	case float64:
+		ms = int64(v)
+	default:
+		return ""
+	}
+	return time.UnixMilli(ms).UTC().Format("2006-01-02")
 }
 
 func writeIndent(sb *strings.Builder, indent int) {
 	sb.WriteString(strings.Repeat(" ", indent))
 }
+
+// hasMark reports whether an ADF text node's marks include markType
+func hasMark(marks []any, markType string) bool {
+	for _, mark := range marks {
+		if markMap, ok := mark.(map[string]any); ok && markMap["type"] == markType {
+			return true
+		}
+	}
+	return false
+}
+
+// markdownEscaper escapes the characters that markdown reads as inline
+// formatting anywhere in a line
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`")
+
+// escapeMarkdownText escapes literal text so markdown shows it as written.
+// A leading # is only special at the start of a line, so lineStart says
+// whether the text begins one.
+func escapeMarkdownText(text string, lineStart bool) string {
+	text = markdownEscaper.Replace(text)
+	if lineStart && strings.HasPrefix(text, "#") {
+		text = `\` + text
+	}
+	return text
+}
+
+// atLineStart reports whether only indentation has been written since the
+// last newline
+func atLineStart(sb *strings.Builder) bool {
+	written := sb.String()
+	lastLine := written[strings.LastIndex(written, "\n")+1:]
+	return strings.TrimLeft(lastLine, " ") == ""
+}
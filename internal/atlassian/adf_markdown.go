@@ -0,0 +1,275 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ADFToMarkdown converts Atlassian Document Format (ADF) to GitHub-flavored
+// markdown. Unlike ADFToText, the output is meant to be rendered again, so
+// lists, links, tables, and code blocks use real markdown syntax.
+func ADFToMarkdown(adf any) string {
+	doc, ok := adf.(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	return strings.TrimSpace(strings.Join(markdownBlocks(adfChildren(doc)), "\n\n"))
+}
+
+// adfChildren returns a node's content as a slice of nodes
+func adfChildren(node map[string]any) []map[string]any {
+	content, _ := node["content"].([]any)
+	children := make([]map[string]any, 0, len(content))
+	for _, child := range content {
+		if childMap, ok := child.(map[string]any); ok {
+			children = append(children, childMap)
+		}
+	}
+	return children
+}
+
+func markdownBlocks(nodes []map[string]any) []string {
+	var blocks []string
+	for _, node := range nodes {
+		if block := markdownBlock(node); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
+func markdownBlock(node map[string]any) string {
+	nodeType, _ := node["type"].(string)
+	attrs, _ := node["attrs"].(map[string]any)
+
+	switch nodeType {
+	case "paragraph":
+		return markdownInline(adfChildren(node))
+
+	case "heading":
+		level, _ := attrs["level"].(float64)
+		if level < 1 {
+			level = 1
+		}
+		return strings.Repeat("#", int(level)) + " " + markdownInline(adfChildren(node))
+
+	case "bulletList":
+		var items []string
+		for _, item := range adfChildren(node) {
+			items = append(items, markdownListItem("- ", item))
+		}
+		return strings.Join(items, "\n")
+
+	case "orderedList":
+		start := 1
+		if order, ok := attrs["order"].(float64); ok && order > 0 {
+			start = int(order)
+		}
+		var items []string
+		for i, item := range adfChildren(node) {
+			items = append(items, markdownListItem(fmt.Sprintf("%d. ", start+i), item))
+		}
+		return strings.Join(items, "\n")
+
+	case "taskList":
+		var items []string
+		for _, item := range adfChildren(node) {
+			itemAttrs, _ := item["attrs"].(map[string]any)
+			marker := "- [ ] "
+			if state, _ := itemAttrs["state"].(string); state == "DONE" {
+				marker = "- [x] "
+			}
+			items = append(items, prefixLines(markdownInline(adfChildren(item)), marker, "      "))
+		}
+		return strings.Join(items, "\n")
+
+	case "codeBlock":
+		language, _ := attrs["language"].(string)
+		var sb strings.Builder
+		for _, child := range adfChildren(node) {
+			text, _ := child["text"].(string)
+			sb.WriteString(text)
+		}
+		return "```" + language + "\n" + strings.TrimRight(sb.String(), "\n") + "\n```"
+
+	case "blockquote":
+		return prefixLines(strings.Join(markdownBlocks(adfChildren(node)), "\n\n"), "> ", "> ")
+
+	case "panel":
+		panelType, _ := attrs["panelType"].(string)
+		if panelType == "" {
+			panelType = "info"
+		}
+		body := strings.Join(markdownBlocks(adfChildren(node)), "\n\n")
+		return prefixLines(fmt.Sprintf("**%s:** %s", strings.ToUpper(panelType[:1])+panelType[1:], body), "> ", "> ")
+
+	case "expand", "nestedExpand":
+		title, _ := attrs["title"].(string)
+		body := strings.Join(markdownBlocks(adfChildren(node)), "\n\n")
+		if title == "" {
+			return body
+		}
+		return "**" + title + "**\n\n" + body
+
+	case "rule":
+		return "---"
+
+	case "table":
+		return markdownTable(node)
+
+	case "mediaSingle", "mediaGroup":
+		return markdownInline(adfChildren(node))
+
+	default:
+		// Block containers hold block children; anything else is treated as
+		// inline content
+		children := adfChildren(node)
+		if len(children) == 0 {
+			return markdownInline([]map[string]any{node})
+		}
+		if isInlineNode(children[0]) {
+			return markdownInline(children)
+		}
+		return strings.Join(markdownBlocks(children), "\n\n")
+	}
+}
+
+// markdownListItem renders a list item, indenting continuation lines and
+// nested lists under the marker
+func markdownListItem(marker string, item map[string]any) string {
+	body := strings.Join(markdownBlocks(adfChildren(item)), "\n")
+	return prefixLines(body, marker, strings.Repeat(" ", len(marker)))
+}
+
+// prefixLines prefixes the first line of s with first and every following
+// non-empty line with rest
+func prefixLines(s, first, rest string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		switch {
+		case i == 0:
+			lines[i] = first + line
+		case line == "":
+			lines[i] = strings.TrimRight(rest, " ")
+		default:
+			lines[i] = rest + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func isInlineNode(node map[string]any) bool {
+	switch nodeType, _ := node["type"].(string); nodeType {
+	case "text", "hardBreak", "mention", "emoji", "inlineCard", "date", "status", "mediaInline", "media":
+		return true
+	}
+	return false
+}
+
+func markdownInline(nodes []map[string]any) string {
+	var sb strings.Builder
+	for _, node := range nodes {
+		nodeType, _ := node["type"].(string)
+		attrs, _ := node["attrs"].(map[string]any)
+
+		switch nodeType {
+		case "text":
+			text, _ := node["text"].(string)
+			sb.WriteString(markdownMarks(text, node["marks"]))
+
+		case "hardBreak":
+			sb.WriteString("  \n")
+
+		case "mention":
+			text, _ := attrs["text"].(string)
+			sb.WriteString("@" + strings.TrimPrefix(text, "@"))
+
+		case "emoji":
+			if text, ok := attrs["text"].(string); ok && text != "" {
+				sb.WriteString(text)
+			} else {
+				shortName, _ := attrs["shortName"].(string)
+				sb.WriteString(shortName)
+			}
+
+		case "inlineCard", "blockCard":
+			if url, ok := attrs["url"].(string); ok && url != "" {
+				sb.WriteString("<" + url + ">")
+			}
+
+		case "date":
+			sb.WriteString(formatADFDate(attrs["timestamp"]))
+
+		case "status":
+			text, _ := attrs["text"].(string)
+			sb.WriteString("`" + text + "`")
+
+		case "media", "mediaInline":
+			alt, _ := attrs["alt"].(string)
+			filename, _ := attrs["filename"].(string)
+			switch {
+			case alt != "":
+				sb.WriteString(fmt.Sprintf("[Image: %s]", alt))
+			case filename != "":
+				sb.WriteString(fmt.Sprintf("[Attached image: %s]", filename))
+			default:
+				sb.WriteString("[Attached image]")
+			}
+
+		default:
+			sb.WriteString(markdownInline(adfChildren(node)))
+		}
+	}
+	return sb.String()
+}
+
+// markdownMarks wraps text in the markdown syntax for its ADF marks
+func markdownMarks(text string, rawMarks any) string {
+	marks, _ := rawMarks.([]any)
+
+	var href string
+	formatted := text
+	for _, mark := range marks {
+		markMap, ok := mark.(map[string]any)
+		if !ok {
+			continue
+		}
+		switch markType, _ := markMap["type"].(string); markType {
+		case "code":
+			formatted = "`" + formatted + "`"
+		case "strong":
+			formatted = "**" + formatted + "**"
+		case "em":
+			formatted = "*" + formatted + "*"
+		case "strike":
+			formatted = "~~" + formatted + "~~"
+		case "link":
+			attrs, _ := markMap["attrs"].(map[string]any)
+			href, _ = attrs["href"].(string)
+		}
+	}
+
+	// Links wrap everything else so formatting stays inside the link text
+	if href != "" {
+		formatted = "[" + formatted + "](" + href + ")"
+	}
+	return formatted
+}
+
+// markdownTable renders an ADF table as a GFM table, laid out by
+// formatMarkdownTable like ADFToText's tables
+func markdownTable(node map[string]any) string {
+	var rows [][]string
+	for _, row := range adfChildren(node) {
+		var cells []string
+		for _, cell := range adfChildren(row) {
+			text := strings.Join(markdownBlocks(adfChildren(cell)), "<br>")
+			text = strings.ReplaceAll(text, "  \n", "<br>")
+			text = strings.ReplaceAll(text, "\n", "<br>")
+			cells = append(cells, strings.ReplaceAll(text, "|", `\|`))
+		}
+		rows = append(rows, cells)
+	}
+	return strings.Join(formatMarkdownTable(rows), "\n")
+}
@@ -0,0 +1,146 @@
+package atlassian
+
+import (
+	"testing"
+)
+
+func TestADFToMarkdown_Nil(t *testing.T) {
+	if result := ADFToMarkdown(nil); result != "" {
+		t.Errorf("Expected empty string, got %q", result)
+	}
+}
+
+func TestADFToMarkdown_Blocks(t *testing.T) {
+	text := func(s string, marks ...map[string]any) map[string]any {
+		node := map[string]any{"type": "text", "text": s}
+		if len(marks) > 0 {
+			anyMarks := make([]any, len(marks))
+			for i, m := range marks {
+				anyMarks[i] = m
+			}
+			node["marks"] = anyMarks
+		}
+		return node
+	}
+	para := func(children ...any) map[string]any {
+		return map[string]any{"type": "paragraph", "content": children}
+	}
+	item := func(children ...any) map[string]any {
+		return map[string]any{"type": "listItem", "content": children}
+	}
+
+	tests := []struct {
+		name     string
+		node     map[string]any
+		expected string
+	}{
+		{
+			name: "Heading",
+			node: map[string]any{
+				"type":    "heading",
+				"attrs":   map[string]any{"level": float64(2)},
+				"content": []any{text("Overview")},
+			},
+			expected: "## Overview",
+		},
+		{
+			name:     "Marks and link",
+			node:     para(text("bold", map[string]any{"type": "strong"}), text(" and "), text("docs", map[string]any{"type": "link", "attrs": map[string]any{"href": "https://example.com"}})),
+			expected: "**bold** and [docs](https://example.com)",
+		},
+		{
+			name: "Nested bullet list",
+			node: map[string]any{
+				"type": "bulletList",
+				"content": []any{
+					item(para(text("one")), map[string]any{
+						"type":    "bulletList",
+						"content": []any{item(para(text("nested")))},
+					}),
+					item(para(text("two"))),
+				},
+			},
+			expected: "- one\n  - nested\n- two",
+		},
+		{
+			name: "Ordered list with start",
+			node: map[string]any{
+				"type":    "orderedList",
+				"attrs":   map[string]any{"order": float64(3)},
+				"content": []any{item(para(text("three"))), item(para(text("four")))},
+			},
+			expected: "3. three\n4. four",
+		},
+		{
+			name: "Task list",
+			node: map[string]any{
+				"type": "taskList",
+				"content": []any{
+					map[string]any{"type": "taskItem", "attrs": map[string]any{"state": "DONE"}, "content": []any{text("done")}},
+					map[string]any{"type": "taskItem", "attrs": map[string]any{"state": "TODO"}, "content": []any{text("todo")}},
+				},
+			},
+			expected: "- [x] done\n- [ ] todo",
+		},
+		{
+			name: "Code block with language",
+			node: map[string]any{
+				"type":    "codeBlock",
+				"attrs":   map[string]any{"language": "go"},
+				"content": []any{text("fmt.Println(1)")},
+			},
+			expected: "```go\nfmt.Println(1)\n```",
+		},
+		{
+			name: "Panel",
+			node: map[string]any{
+				"type":    "panel",
+				"attrs":   map[string]any{"panelType": "warning"},
+				"content": []any{para(text("Careful"))},
+			},
+			expected: "> **Warning:** Careful",
+		},
+		{
+			name: "Table",
+			node: map[string]any{
+				"type": "table",
+				"content": []any{
+					map[string]any{"type": "tableRow", "content": []any{
+						map[string]any{"type": "tableHeader", "content": []any{para(text("Name"))}},
+						map[string]any{"type": "tableHeader", "content": []any{para(text("Value"))}},
+					}},
+					map[string]any{"type": "tableRow", "content": []any{
+						map[string]any{"type": "tableCell", "content": []any{para(text("a|b"))}},
+						map[string]any{"type": "tableCell", "content": []any{para(text("1"))}},
+					}},
+				},
+			},
+			expected: "| Name | Value |\n| ---- | ----- |\n| a\\|b | 1     |",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := map[string]any{"type": "doc", "content": []any{tt.node}}
+			if result := ADFToMarkdown(doc); result != tt.expected {
+				t.Errorf("Expected:\n%s\n\nGot:\n%s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestADFToMarkdown_SeparatesBlocks(t *testing.T) {
+	doc := map[string]any{
+		"type": "doc",
+		"content": []any{
+			map[string]any{"type": "paragraph", "content": []any{map[string]any{"type": "text", "text": "First"}}},
+			map[string]any{"type": "rule"},
+			map[string]any{"type": "paragraph", "content": []any{map[string]any{"type": "text", "text": "Second"}}},
+		},
+	}
+
+	expected := "First\n\n---\n\nSecond"
+	if result := ADFToMarkdown(doc); result != expected {
+		t.Errorf("Expected %q, got %q", expected, result)
+	}
+}
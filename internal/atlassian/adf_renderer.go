@@ -2,9 +2,11 @@ package atlassian
 
 import (
 	"bytes"
+	"crypto/rand"
 	"encoding/json"
 	"fmt"
 	"io"
+	"regexp"
 	"strings"
 
 	"github.com/yuin/goldmark"
@@ -23,6 +25,10 @@ type adfRenderer struct {
 	markStack []adfMark
 	warnings  []string
 	seenWarns map[string]bool
+
+	// quotePushed records, for each open blockquote, whether it produced a
+	// node (blockquote or panel) rather than being flattened
+	quotePushed []bool
 }
 
 type adfNode struct {
@@ -149,15 +155,101 @@ func (r *adfRenderer) Render(w io.Writer, source []byte, n ast.Node) error {
 	return err
 }
 
-func listHasTaskChildren(n *ast.List) bool {
-	for child := n.FirstChild(); child != nil; child = child.NextSibling() {
-		if li, ok := child.(*ast.ListItem); ok {
-			for gc := li.FirstChild(); gc != nil; gc = gc.NextSibling() {
-				if fc := gc.FirstChild(); fc != nil {
-					if _, ok := fc.(*extAst.TaskCheckBox); ok {
-						return true
-					}
-				}
+// codeLanguageAliases maps common fence info strings to the language names
+// Jira's code block highlighter recognizes
+var codeLanguageAliases = map[string]string{
+	"js":     "javascript",
+	"jsx":    "javascript",
+	"ts":     "typescript",
+	"tsx":    "typescript",
+	"sh":     "bash",
+	"shell":  "bash",
+	"zsh":    "bash",
+	"yml":    "yaml",
+	"py":     "python",
+	"rb":     "ruby",
+	"golang": "go",
+	"kt":     "kotlin",
+	"rs":     "rust",
+	"md":     "markdown",
+	"ps1":    "powershell",
+}
+
+// codeBlockLanguage normalizes a fence info string to a Jira language name
+func codeBlockLanguage(lang string) string {
+	lang = strings.ToLower(lang)
+	if alias, ok := codeLanguageAliases[lang]; ok {
+		return alias
+	}
+	return lang
+}
+
+// admonitionRegexp matches a GitHub-style admonition marker such as [!NOTE]
+// on the first line of a blockquote
+var admonitionRegexp = regexp.MustCompile(`(?i)^\[!(info|note|warning|success|error)\]$`)
+
+// admonitionPanelType returns the ADF panelType for a blockquote whose first
+// line is an admonition marker, or "" for an ordinary blockquote
+func admonitionPanelType(n *ast.Blockquote, source []byte) string {
+	para, ok := n.FirstChild().(*ast.Paragraph)
+	if !ok || para.Lines().Len() == 0 {
+		return ""
+	}
+	first := para.Lines().At(0)
+	line := strings.TrimSpace(string(first.Value(source)))
+	if m := admonitionRegexp.FindStringSubmatch(line); m != nil {
+		return strings.ToLower(m[1])
+	}
+	return ""
+}
+
+// stripAdmonitionMarker removes the marker line from the start of a
+// blockquote so only the text after it ends up in the panel
+func stripAdmonitionMarker(n *ast.Blockquote) {
+	para := n.FirstChild()
+	for child := para.FirstChild(); child != nil; {
+		next := child.NextSibling()
+		para.RemoveChild(para, child)
+		if text, ok := child.(*ast.Text); ok && (text.SoftLineBreak() || text.HardLineBreak()) {
+			return
+		}
+		child = next
+	}
+	// The marker was the whole paragraph
+	n.RemoveChild(n, para)
+}
+
+// newLocalID returns a random UUID for the localId attribute Jira expects on
+// task lists and items
+func newLocalID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// newListNode returns an empty taskList or bulletList node
+func newListNode(task bool) *adfNode {
+	if task {
+		return &adfNode{
+			Type:  "taskList",
+			Attrs: map[string]any{"localId": newLocalID()},
+		}
+	}
+	return &adfNode{Type: "bulletList"}
+}
+
+// listItemIsTask reports whether a list item starts with a task checkbox
+func listItemIsTask(n ast.Node) bool {
+	li, ok := n.(*ast.ListItem)
+	if !ok {
+		return false
+	}
+	for gc := li.FirstChild(); gc != nil; gc = gc.NextSibling() {
+		if fc := gc.FirstChild(); fc != nil {
+			if _, ok := fc.(*extAst.TaskCheckBox); ok {
+				return true
 			}
 		}
 	}
@@ -300,7 +392,7 @@ func (r *adfRenderer) walkNode(source []byte, node ast.Node, entering bool) (ast
 
 	case *ast.FencedCodeBlock:
 		if entering {
-			lang := string(n.Language(source))
+			lang := codeBlockLanguage(string(n.Language(source)))
 			var content string
 			lines := n.Lines()
 			for i := 0; i < lines.Len(); i++ {
@@ -334,21 +426,19 @@ func (r *adfRenderer) walkNode(source []byte, node ast.Node, entering bool) (ast
 
 	case *ast.List:
 		if entering {
-			if !n.IsOrdered() && listHasTaskChildren(n) {
+			if n.IsOrdered() {
+				r.push(&adfNode{Type: "orderedList"})
+			} else {
+				// The list type follows its first item; mixed lists are
+				// split when the items switch kind (see ListItem below)
+				task := listItemIsTask(n.FirstChild())
 				// If we're inside a taskItem, pop it first so the nested
 				// taskList becomes a sibling in the parent taskList (Jira
 				// requires nested taskLists as siblings, not children).
-				if r.current().Type == "taskItem" {
+				if task && r.current().Type == "taskItem" {
 					r.pop()
 				}
-				r.push(&adfNode{
-					Type:  "taskList",
-					Attrs: map[string]any{"localId": ""},
-				})
-			} else if n.IsOrdered() {
-				r.push(&adfNode{Type: "orderedList"})
-			} else {
-				r.push(&adfNode{Type: "bulletList"})
+				r.push(newListNode(task))
 			}
 		} else {
 			r.pop()
@@ -356,10 +446,19 @@ func (r *adfRenderer) walkNode(source []byte, node ast.Node, entering bool) (ast
 
 	case *ast.ListItem:
 		if entering {
+			// ADF lists can't mix task and plain items, so start a new
+			// sibling list whenever an unordered list switches kind
+			if cur := r.currentType(); cur == "taskList" || cur == "bulletList" {
+				if task := listItemIsTask(n); task != (cur == "taskList") {
+					r.pop()
+					r.push(newListNode(task))
+				}
+			}
+
 			if r.current().Type == "taskList" {
 				r.push(&adfNode{
 					Type:  "taskItem",
-					Attrs: map[string]any{"localId": "", "state": "TODO"},
+					Attrs: map[string]any{"localId": newLocalID(), "state": "TODO"},
 				})
 			} else {
 				r.push(&adfNode{Type: "listItem"})
@@ -376,21 +475,31 @@ func (r *adfRenderer) walkNode(source []byte, node ast.Node, entering bool) (ast
 
 	case *ast.Blockquote:
 		if entering {
-			if r.ancestorHasType("blockquote") {
+			nested := r.ancestorHasType("blockquote") || r.ancestorHasType("panel")
+			if nested {
 				r.warn("nested blockquote flattened (not supported in ADF)")
 			} else if r.currentType() == "listItem" {
 				r.warn("blockquote inside list item flattened (not supported in ADF)")
 			}
-			if r.ancestorHasType("blockquote") || r.currentType() == "listItem" {
+
+			if nested || r.currentType() == "listItem" {
+				r.quotePushed = append(r.quotePushed, false)
+			} else if panelType := admonitionPanelType(n, source); panelType != "" {
+				stripAdmonitionMarker(n)
+				r.push(&adfNode{
+					Type:  "panel",
+					Attrs: map[string]any{"panelType": panelType},
+				})
+				r.quotePushed = append(r.quotePushed, true)
 			} else {
 				r.push(&adfNode{Type: "blockquote"})
+				r.quotePushed = append(r.quotePushed, true)
 			}
 		} else {
-			if !r.ancestorHasType("blockquote") {
-				// Only pop if we actually pushed a blockquote (not flattened).
-				// We need to check by looking at current node type.
-			}
-			if r.current().Type == "blockquote" {
+			// Only pop if we actually pushed a node (not flattened)
+			pushed := r.quotePushed[len(r.quotePushed)-1]
+			r.quotePushed = r.quotePushed[:len(r.quotePushed)-1]
+			if pushed {
 				r.pop()
 			}
 		}
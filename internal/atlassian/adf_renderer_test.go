@@ -223,6 +223,25 @@ func TestRenderer_FencedCodeBlock(t *testing.T) {
 	}
 }
 
+func TestRenderer_FencedCodeBlockLanguageAliases(t *testing.T) {
+	tests := map[string]string{
+		"python": "python",
+		"js":     "javascript",
+		"sh":     "bash",
+		"yml":    "yaml",
+		"Go":     "go",
+	}
+
+	for fence, expected := range tests {
+		adf := mustRenderADF(t, "```"+fence+"\ncode\n```")
+		nodes := contentNodes(t, adf)
+		attrs, _ := nodes[0]["attrs"].(map[string]any)
+		if attrs["language"] != expected {
+			t.Errorf("```%s: expected language %q, got %v", fence, expected, attrs["language"])
+		}
+	}
+}
+
 func TestRenderer_FencedCodeBlockNoLanguage(t *testing.T) {
 	adf := mustRenderADF(t, "```\nsome code\n```")
 	nodes := contentNodes(t, adf)
@@ -324,6 +343,83 @@ func TestRenderer_Blockquote(t *testing.T) {
 	}
 }
 
+func TestRenderer_AdmonitionPanel(t *testing.T) {
+	tests := []struct {
+		marker    string
+		panelType string
+	}{
+		{"[!INFO]", "info"},
+		{"[!NOTE]", "note"},
+		{"[!WARNING]", "warning"},
+		{"[!SUCCESS]", "success"},
+		{"[!ERROR]", "error"},
+		{"[!warning]", "warning"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.marker, func(t *testing.T) {
+			adf := mustRenderADF(t, "> "+tt.marker+"\n> Mind the gap")
+			nodes := contentNodes(t, adf)
+
+			if len(nodes) != 1 || nodes[0]["type"] != "panel" {
+				t.Fatalf("expected a single panel, got %s", adfJSON(t, adf))
+			}
+			attrs, _ := nodes[0]["attrs"].(map[string]any)
+			if attrs["panelType"] != tt.panelType {
+				t.Errorf("expected panelType %q, got %v", tt.panelType, attrs["panelType"])
+			}
+
+			s := adfJSON(t, adf)
+			if strings.Contains(s, "!") {
+				t.Errorf("expected marker to be removed, got %s", s)
+			}
+			if !strings.Contains(s, "Mind the") {
+				t.Errorf("expected panel text, got %s", s)
+			}
+		})
+	}
+}
+
+func TestRenderer_AdmonitionPanel_Paragraphs(t *testing.T) {
+	adf := mustRenderADF(t, "> [!NOTE]\n>\n> First\n>\n> Second")
+	nodes := contentNodes(t, adf)
+
+	if nodes[0]["type"] != "panel" {
+		t.Fatalf("expected panel, got %v", nodes[0]["type"])
+	}
+	children := nodeContent(t, nodes[0])
+	if len(children) != 2 {
+		t.Fatalf("expected 2 paragraphs in panel, got %d: %s", len(children), adfJSON(t, adf))
+	}
+	for _, child := range children {
+		if child["type"] != "paragraph" {
+			t.Errorf("expected paragraph in panel, got %v", child["type"])
+		}
+	}
+}
+
+func TestRenderer_AdmonitionPanel_FallsBackToBlockquote(t *testing.T) {
+	for _, md := range []string{"> Just a quote", "> [!TIP]\n> Unknown marker", "> [!NOTE] inline text"} {
+		adf := mustRenderADF(t, md)
+		nodes := contentNodes(t, adf)
+		if nodes[0]["type"] != "blockquote" {
+			t.Errorf("%q: expected blockquote, got %v", md, nodes[0]["type"])
+		}
+	}
+}
+
+func TestRenderer_NestedBlockquote_KeepsFollowingContent(t *testing.T) {
+	adf := mustRenderADF(t, "> outer\n> > inner\n>\n> after")
+	nodes := contentNodes(t, adf)
+
+	if len(nodes) != 1 || nodes[0]["type"] != "blockquote" {
+		t.Fatalf("expected a single blockquote, got %s", adfJSON(t, adf))
+	}
+	if children := nodeContent(t, nodes[0]); len(children) != 3 {
+		t.Errorf("expected 3 paragraphs in blockquote, got %d", len(children))
+	}
+}
+
 func TestRenderer_NestedBlockquote_Flattened(t *testing.T) {
 	adf := mustRenderADF(t, "> outer\n> > nested")
 	s := adfJSON(t, adf)
@@ -534,6 +630,39 @@ func TestRenderer_TableCellContent(t *testing.T) {
 	}
 }
 
+func TestRenderer_TableEmptyCells(t *testing.T) {
+	md := "| Name | Value |\n| --- | --- |\n| foo |  |\n|  | bar |"
+	adf := mustRenderADF(t, md)
+	nodes := contentNodes(t, adf)
+	rows := nodeContent(t, nodes[0])
+
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(rows))
+	}
+
+	// Empty cells still need a paragraph for Jira to accept the table
+	for i, row := range rows[1:] {
+		cells := nodeContent(t, row)
+		if len(cells) != 2 {
+			t.Fatalf("row %d: expected 2 cells, got %d", i+1, len(cells))
+		}
+		for _, cell := range cells {
+			paras := nodeContent(t, cell)
+			if len(paras) != 1 || paras[0]["type"] != "paragraph" {
+				t.Errorf("row %d: expected one paragraph in cell, got %v", i+1, cell["content"])
+			}
+		}
+	}
+
+	empty := nodeContent(t, nodeContent(t, rows[1])[1])[0]
+	if len(nodeContent(t, empty)) != 0 {
+		t.Errorf("expected empty paragraph, got %v", empty["content"])
+	}
+	if text := nodeContent(t, nodeContent(t, nodeContent(t, rows[2])[1])[0]); len(text) == 0 || text[0]["text"] != "bar" {
+		t.Errorf("expected 'bar' in second cell, got %v", text)
+	}
+}
+
 func TestRenderer_TableWithFormatting(t *testing.T) {
 	md := "| **Bold** | *Italic* |\n| --- | --- |\n| `code` | ~~strike~~ |"
 	adf := mustRenderADF(t, md)
@@ -635,6 +764,71 @@ func TestRenderer_TaskList_Mixed(t *testing.T) {
 	}
 }
 
+func TestRenderer_TaskList_LocalIDs(t *testing.T) {
+	md := "- [ ] One\n- [x] Two"
+	adf := mustRenderADF(t, md)
+	nodes := contentNodes(t, adf)
+
+	seen := map[string]bool{}
+	check := func(node map[string]any) {
+		id, _ := node["attrs"].(map[string]any)["localId"].(string)
+		if id == "" {
+			t.Errorf("expected generated localId on %v", node["type"])
+		}
+		if seen[id] {
+			t.Errorf("expected unique localId, got %q twice", id)
+		}
+		seen[id] = true
+	}
+
+	check(nodes[0])
+	for _, item := range nodeContent(t, nodes[0]) {
+		check(item)
+	}
+}
+
+func TestRenderer_TaskList_SplitsPlainItems(t *testing.T) {
+	md := "- [ ] Todo\n- [x] Done\n- Plain\n- Another plain\n- [ ] Later"
+	adf := mustRenderADF(t, md)
+	nodes := contentNodes(t, adf)
+
+	expected := []struct {
+		listType string
+		items    []string
+	}{
+		{"taskList", []string{"TODO", "DONE"}},
+		{"bulletList", []string{"", ""}},
+		{"taskList", []string{"TODO"}},
+	}
+	if len(nodes) != len(expected) {
+		t.Fatalf("expected %d lists, got %d: %s", len(expected), len(nodes), adfJSON(t, adf))
+	}
+
+	for i, want := range expected {
+		if nodes[i]["type"] != want.listType {
+			t.Errorf("list %d: expected %s, got %v", i, want.listType, nodes[i]["type"])
+			continue
+		}
+		items := nodeContent(t, nodes[i])
+		if len(items) != len(want.items) {
+			t.Errorf("list %d: expected %d items, got %d", i, len(want.items), len(items))
+			continue
+		}
+		for j, state := range want.items {
+			if state == "" {
+				if items[j]["type"] != "listItem" {
+					t.Errorf("list %d item %d: expected listItem, got %v", i, j, items[j]["type"])
+				}
+				continue
+			}
+			attrs, _ := items[j]["attrs"].(map[string]any)
+			if items[j]["type"] != "taskItem" || attrs["state"] != state {
+				t.Errorf("list %d item %d: expected taskItem %s, got %v %v", i, j, state, items[j]["type"], attrs["state"])
+			}
+		}
+	}
+}
+
 func TestRenderer_TaskItem_InlineContent(t *testing.T) {
 	md := "- [ ] Task text here"
 	adf := mustRenderADF(t, md)
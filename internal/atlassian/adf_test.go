@@ -223,6 +223,149 @@ func TestADFToText_OrderedList(t *testing.T) {
 	}
 }
 
+// adfListItem builds a listItem whose first paragraph holds text, followed by
+// any nested nodes
+func adfListItem(text string, nested ...any) map[string]any {
+	content := []any{
+		map[string]any{
+			"type":    "paragraph",
+			"content": []any{map[string]any{"type": "text", "text": text}},
+		},
+	}
+	return map[string]any{"type": "listItem", "content": append(content, nested...)}
+}
+
+func TestADFToText_NestedBulletList(t *testing.T) {
+	adf := map[string]any{
+		"type": "doc",
+		"content": []any{
+			map[string]any{
+				"type": "bulletList",
+				"content": []any{
+					adfListItem("Fruit", map[string]any{
+						"type":    "bulletList",
+						"content": []any{adfListItem("Apple"), adfListItem("Pear")},
+					}),
+					adfListItem("Vegetables"),
+				},
+			},
+		},
+	}
+
+	expected := "• Fruit\n  • Apple\n  • Pear\n• Vegetables"
+	if result := ADFToText(adf); result != expected {
+		t.Errorf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestADFToText_BulletListWithOrderedSubList(t *testing.T) {
+	adf := map[string]any{
+		"type": "doc",
+		"content": []any{
+			map[string]any{
+				"type": "bulletList",
+				"content": []any{
+					adfListItem("Setup", map[string]any{
+						"type":    "orderedList",
+						"content": []any{adfListItem("Install"), adfListItem("Configure")},
+					}),
+					adfListItem("Release", map[string]any{
+						"type":    "orderedList",
+						"attrs":   map[string]any{"order": float64(3)},
+						"content": []any{adfListItem("Tag"), adfListItem("Publish")},
+					}),
+				},
+			},
+			map[string]any{
+				"type":    "paragraph",
+				"content": []any{map[string]any{"type": "text", "text": "Done"}},
+			},
+		},
+	}
+
+	expected := "• Setup\n  1. Install\n  2. Configure\n• Release\n  3. Tag\n  4. Publish\n\nDone"
+	if result := ADFToText(adf); result != expected {
+		t.Errorf("Expected %q, got %q", expected, result)
+	}
+}
+
+// adfTaskItem builds a taskItem with the given state ("DONE" or "TODO")
+func adfTaskItem(state, text string) map[string]any {
+	return map[string]any{
+		"type":    "taskItem",
+		"attrs":   map[string]any{"localId": text, "state": state},
+		"content": []any{map[string]any{"type": "text", "text": text}},
+	}
+}
+
+func TestADFToText_TaskList(t *testing.T) {
+	adf := map[string]any{
+		"type": "doc",
+		"content": []any{
+			map[string]any{
+				"type": "taskList",
+				"content": []any{
+					adfTaskItem("DONE", "Write tests"),
+					adfTaskItem("TODO", "Update docs"),
+					map[string]any{
+						"type":    "taskList",
+						"content": []any{adfTaskItem("DONE", "README"), adfTaskItem("TODO", "Changelog")},
+					},
+					adfTaskItem("TODO", "Release"),
+				},
+			},
+			map[string]any{
+				"type":    "paragraph",
+				"content": []any{map[string]any{"type": "text", "text": "Thanks"}},
+			},
+		},
+	}
+
+	expected := "- [x] Write tests\n- [ ] Update docs\n  - [x] README\n  - [ ] Changelog\n- [ ] Release\n\nThanks"
+	if result := ADFToText(adf); result != expected {
+		t.Errorf("Expected %q, got %q", expected, result)
+	}
+	if unhandled := ADFUnhandledNodeTypes(adf); len(unhandled) != 0 {
+		t.Errorf("Expected task nodes to be handled, got %v", unhandled)
+	}
+}
+
+func TestADFToText_OrderedListStartNumber(t *testing.T) {
+	adf := map[string]any{
+		"type": "doc",
+		"content": []any{
+			map[string]any{
+				"type":    "orderedList",
+				"attrs":   map[string]any{"order": float64(5)},
+				"content": []any{adfListItem("Fifth"), adfListItem("Sixth")},
+			},
+		},
+	}
+
+	expected := "5. Fifth\n6. Sixth"
+	if result := ADFToText(adf); result != expected {
+		t.Errorf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestADFToText_OrderedListAlignsMarkers(t *testing.T) {
+	adf := map[string]any{
+		"type": "doc",
+		"content": []any{
+			map[string]any{
+				"type":    "orderedList",
+				"attrs":   map[string]any{"order": float64(9)},
+				"content": []any{adfListItem("Nine"), adfListItem("Ten"), adfListItem("Eleven")},
+			},
+		},
+	}
+
+	expected := "9.  Nine\n10. Ten\n11. Eleven"
+	if result := ADFToText(adf); result != expected {
+		t.Errorf("Expected %q, got %q", expected, result)
+	}
+}
+
 func TestADFToText_CodeBlock(t *testing.T) {
 	adf := map[string]any{
 		"type": "doc",
@@ -489,8 +632,150 @@ func TestADFToText_Table(t *testing.T) {
 	if !strings.Contains(result, "Cell 1") || !strings.Contains(result, "Cell 2") {
 		t.Errorf("Expected table cells in output, got %q", result)
 	}
-	if !strings.Contains(result, "|") {
-		t.Errorf("Expected table separator '|' in output, got %q", result)
+	expected := "| Header 1 | Header 2 |\n| -------- | -------- |\n| Cell 1   | Cell 2   |"
+	if result != expected {
+		t.Errorf("Expected markdown table:\n%s\ngot:\n%s", expected, result)
+	}
+}
+
+func TestADFToText_Links(t *testing.T) {
+	link := map[string]any{"type": "link", "attrs": map[string]any{"href": "https://example.com/docs"}}
+	adf := map[string]any{
+		"type": "doc",
+		"content": []any{
+			map[string]any{
+				"type": "paragraph",
+				"content": []any{
+					map[string]any{"type": "text", "text": "See "},
+					map[string]any{"type": "text", "text": "the docs", "marks": []any{link}},
+					map[string]any{"type": "text", "text": " or "},
+					map[string]any{"type": "text", "text": "this", "marks": []any{link, map[string]any{"type": "strong"}}},
+					map[string]any{"type": "text", "text": ": "},
+					map[string]any{"type": "inlineCard", "attrs": map[string]any{"url": "https://example.atlassian.net/browse/PROJ-1"}},
+				},
+			},
+		},
+	}
+
+	expected := "See [the docs](https://example.com/docs) or [**this**](https://example.com/docs): <https://example.atlassian.net/browse/PROJ-1>"
+	if result := ADFToText(adf); result != expected {
+		t.Errorf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestADFToText_StatusAndDate(t *testing.T) {
+	adf := map[string]any{
+		"type": "doc",
+		"content": []any{
+			map[string]any{
+				"type": "paragraph",
+				"content": []any{
+					map[string]any{"type": "status", "attrs": map[string]any{"text": "In progress", "color": "blue"}},
+					map[string]any{"type": "text", "text": " due "},
+					map[string]any{"type": "date", "attrs": map[string]any{"timestamp": "1705276800000"}},
+				},
+			},
+		},
+	}
+
+	expected := "[IN PROGRESS] due 2024-01-15"
+	if result := ADFToText(adf); result != expected {
+		t.Errorf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestADFToText_DateUnparseable(t *testing.T) {
+	adf := map[string]any{
+		"type": "doc",
+		"content": []any{
+			map[string]any{
+				"type": "paragraph",
+				"content": []any{
+					map[string]any{"type": "date", "attrs": map[string]any{"timestamp": "next tuesday"}},
+				},
+			},
+		},
+	}
+
+	if result := ADFToText(adf); result != "next tuesday" {
+		t.Errorf("Expected the raw timestamp, got %q", result)
+	}
+}
+
+// adfTable builds an ADF table from rows of cell texts, using cellType for
+// the cells of the first row and tableCell for the rest
+func adfTable(firstRowType string, rows ...[]string) map[string]any {
+	var rowNodes []any
+	for i, row := range rows {
+		cellType := "tableCell"
+		if i == 0 {
+			cellType = firstRowType
+		}
+		var cells []any
+		for _, text := range row {
+			cells = append(cells, map[string]any{
+				"type": cellType,
+				"content": []any{
+					map[string]any{
+						"type":    "paragraph",
+						"content": []any{map[string]any{"type": "text", "text": text}},
+					},
+				},
+			})
+		}
+		rowNodes = append(rowNodes, map[string]any{"type": "tableRow", "content": cells})
+	}
+	return map[string]any{
+		"type":    "doc",
+		"content": []any{map[string]any{"type": "table", "content": rowNodes}},
+	}
+}
+
+func TestADFToText_TableWithoutHeader(t *testing.T) {
+	result := ADFToText(adfTable("tableCell", []string{"a", "b"}, []string{"long cell", "x|y"}))
+
+	expected := "| a         | b    |\n| --------- | ---- |\n| long cell | x\\|y |"
+	if result != expected {
+		t.Errorf("Expected markdown table:\n%s\ngot:\n%s", expected, result)
+	}
+}
+
+func TestADFToText_TableVaryingColumns(t *testing.T) {
+	result := ADFToText(adfTable("tableHeader", []string{"Name", "Status"}, []string{"one"}, []string{"two", "Done", "extra"}))
+
+	expected := "| Name | Status |       |\n| ---- | ------ | ----- |\n| one  |        |       |\n| two  | Done   | extra |"
+	if result != expected {
+		t.Errorf("Expected markdown table:\n%s\ngot:\n%s", expected, result)
+	}
+}
+
+func TestADFToText_TableCellsOnOneLine(t *testing.T) {
+	adf := adfTable("tableHeader", []string{"Notes"}, []string{"first"})
+	rows := adf["content"].([]any)[0].(map[string]any)["content"].([]any)
+	cell := rows[1].(map[string]any)["content"].([]any)[0].(map[string]any)
+	cell["content"] = append(cell["content"].([]any), map[string]any{
+		"type":    "paragraph",
+		"content": []any{map[string]any{"type": "text", "text": "second"}},
+	})
+
+	result := ADFToText(adf)
+	if !strings.Contains(result, "| first<br>second |") {
+		t.Errorf("Expected multi-paragraph cell joined with <br>, got:\n%s", result)
+	}
+}
+
+func TestADFToText_TableMatchesMarkdown(t *testing.T) {
+	adf := adfTable("tableHeader", []string{"Name", "Notes"}, []string{"a|b", "first"})
+	rows := adf["content"].([]any)[0].(map[string]any)["content"].([]any)
+	cell := rows[1].(map[string]any)["content"].([]any)[1].(map[string]any)
+	cell["content"] = append(cell["content"].([]any), map[string]any{
+		"type":    "paragraph",
+		"content": []any{map[string]any{"type": "text", "text": "second"}},
+	})
+
+	text, markdown := ADFToText(adf), ADFToMarkdown(adf)
+	if text != markdown {
+		t.Errorf("Expected the same table from ADFToText and ADFToMarkdown, got:\n%s\nand:\n%s", text, markdown)
 	}
 }
 
@@ -576,6 +861,13 @@ func TestADFToText_MediaSingle_NoAltNoFilename(t *testing.T) {
 	}
 
 	result := ADFToText(adf)
+	if !strings.Contains(result, "[Attachment: abc-123]") {
+		t.Errorf("Expected '[Attachment: abc-123]', got %q", result)
+	}
+
+	// Without any identifying attributes only the placeholder is left
+	delete(adf["content"].([]any)[0].(map[string]any)["content"].([]any)[0].(map[string]any), "attrs")
+	result = ADFToText(adf)
 	if !strings.Contains(result, "[Attached image]") {
 		t.Errorf("Expected '[Attached image]', got %q", result)
 	}
@@ -714,3 +1006,122 @@ func TestADFToText_ComplexDocument(t *testing.T) {
 		t.Errorf("Expected list item in output, got %q", result)
 	}
 }
+
+func TestADFToTextWithOptions_MarkdownSafe(t *testing.T) {
+	paragraph := func(nodes ...any) map[string]any {
+		return map[string]any{"type": "paragraph", "content": nodes}
+	}
+	text := func(value string, marks ...string) map[string]any {
+		node := map[string]any{"type": "text", "text": value}
+		if len(marks) > 0 {
+			var markList []any
+			for _, m := range marks {
+				markList = append(markList, map[string]any{"type": m})
+			}
+			node["marks"] = markList
+		}
+		return node
+	}
+
+	tests := []struct {
+		name     string
+		content  []any
+		expected string
+	}{
+		{"Asterisks", []any{paragraph(text("2 * 3 * 4"))}, `2 \* 3 \* 4`},
+		{"Underscores", []any{paragraph(text("snake_case_name"))}, `snake\_case\_name`},
+		{"Backticks", []any{paragraph(text("use `go test`"))}, "use \\`go test\\`"},
+		{"Leading hash", []any{paragraph(text("#1 priority"))}, `\#1 priority`},
+		{"Hash mid-line", []any{paragraph(text("issue #1"))}, "issue #1"},
+		{"Marks still applied", []any{paragraph(text("a_b", "strong"))}, `**a\_b**`},
+		{"Inline code untouched", []any{paragraph(text("a_b*c", "code"))}, "`a_b*c`"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adf := map[string]any{"type": "doc", "content": tt.content}
+			result := ADFToTextWithOptions(adf, &ADFToTextOptions{MarkdownSafe: true})
+			if result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+
+	// Code blocks are shown verbatim
+	codeBlock := map[string]any{"type": "doc", "content": []any{
+		map[string]any{"type": "codeBlock", "content": []any{text("x = a*b_c")}},
+	}}
+	if result := ADFToTextWithOptions(codeBlock, &ADFToTextOptions{MarkdownSafe: true}); !strings.Contains(result, "x = a*b_c") {
+		t.Errorf("Expected code block to be unescaped, got %q", result)
+	}
+
+	// Without the option, text is left as-is
+	adf := map[string]any{"type": "doc", "content": []any{paragraph(text("snake_case * 2"))}}
+	if result := ADFToText(adf); result != "snake_case * 2" {
+		t.Errorf("Expected unescaped text, got %q", result)
+	}
+}
+
+func TestADFUnhandledNodeTypes(t *testing.T) {
+	adf := map[string]any{
+		"type": "doc",
+		"content": []any{
+			map[string]any{"type": "paragraph", "content": []any{
+				map[string]any{"type": "text", "text": "Hello"},
+				map[string]any{"type": "placeholder", "attrs": map[string]any{"text": "Type here"}},
+			}},
+			map[string]any{"type": "expand", "content": []any{
+				map[string]any{"type": "placeholder"},
+				map[string]any{"type": "decisionList"},
+			}},
+		},
+	}
+
+	result := ADFUnhandledNodeTypes(adf)
+	expected := []string{"decisionList", "expand", "placeholder"}
+	if strings.Join(result, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	known := map[string]any{"type": "doc", "content": []any{
+		map[string]any{"type": "paragraph", "content": []any{map[string]any{"type": "text", "text": "Hi"}}},
+	}}
+	if result := ADFUnhandledNodeTypes(known); len(result) != 0 {
+		t.Errorf("Expected no unhandled types, got %v", result)
+	}
+}
+
+func TestADFHasContent(t *testing.T) {
+	if ADFHasContent(nil) {
+		t.Error("Expected nil to have no content")
+	}
+	if ADFHasContent(map[string]any{"type": "doc", "content": []any{}}) {
+		t.Error("Expected empty doc to have no content")
+	}
+	if !ADFHasContent(map[string]any{"type": "doc", "content": []any{map[string]any{"type": "status"}}}) {
+		t.Error("Expected doc with a node to have content")
+	}
+}
+
+func TestParseADFDocument(t *testing.T) {
+	doc, err := ParseADFDocument(`{"type":"doc","version":1,"content":[{"type":"paragraph"}]}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if content, _ := doc["content"].([]any); len(content) != 1 {
+		t.Errorf("Expected 1 content node, got %v", doc["content"])
+	}
+
+	invalid := []string{
+		`not json`,
+		`{"type":"paragraph","content":[]}`,
+		`{"version":1,"content":[]}`,
+		`{"type":"doc","content":"text"}`,
+		`[]`,
+	}
+	for _, data := range invalid {
+		if _, err := ParseADFDocument(data); err == nil {
+			t.Errorf("Expected error for %s", data)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package atlassian
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ARI is a parsed Atlassian Resource Identifier such as
+// ari:cloud:jira:a436116f-02ce-4520-8fbb-7301462a1674:issue/10001
+type ARI struct {
+	Product      string `json:"product"`
+	CloudID      string `json:"cloudId"`
+	ResourceType string `json:"resourceType"`
+	ResourceID   string `json:"resourceId"`
+}
+
+var (
+	ariProductRegexp      = regexp.MustCompile(`^[a-z][a-z0-9.-]*$`)
+	ariCloudIDRegexp      = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	ariResourceTypeRegexp = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+)
+
+// ParseARI parses an ARI of the form
+// ari:cloud:<product>:<cloudId>:<resourceType>/<resourceId>. The cloud ID
+// may be empty for resources that aren't tied to a site (e.g. users). Errors
+// name the segment that is malformed.
+func ParseARI(s string) (*ARI, error) {
+	parts := strings.SplitN(strings.TrimSpace(s), ":", 5)
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("invalid ARI %q: expected ari:cloud:<product>:<cloudId>:<type>/<id>", s)
+	}
+
+	if parts[0] != "ari" {
+		return nil, fmt.Errorf("invalid ARI %q: must start with 'ari', got %q", s, parts[0])
+	}
+	if parts[1] != "cloud" {
+		return nil, fmt.Errorf("invalid ARI %q: partition must be 'cloud', got %q", s, parts[1])
+	}
+	if !ariProductRegexp.MatchString(parts[2]) {
+		return nil, fmt.Errorf("invalid ARI %q: malformed product %q", s, parts[2])
+	}
+	if parts[3] != "" && !ariCloudIDRegexp.MatchString(parts[3]) {
+		return nil, fmt.Errorf("invalid ARI %q: malformed cloud ID %q (expected a UUID)", s, parts[3])
+	}
+
+	resourceType, resourceID, ok := strings.Cut(parts[4], "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid ARI %q: resource %q must be <type>/<id>", s, parts[4])
+	}
+	if !ariResourceTypeRegexp.MatchString(resourceType) {
+		return nil, fmt.Errorf("invalid ARI %q: malformed resource type %q", s, resourceType)
+	}
+	if resourceID == "" {
+		return nil, fmt.Errorf("invalid ARI %q: resource ID is empty", s)
+	}
+
+	return &ARI{
+		Product:      parts[2],
+		CloudID:      parts[3],
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+	}, nil
+}
+
+// String formats the ARI back into its canonical form
+func (a *ARI) String() string {
+	return fmt.Sprintf("ari:cloud:%s:%s:%s/%s", a.Product, a.CloudID, a.ResourceType, a.ResourceID)
+}
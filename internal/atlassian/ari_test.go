@@ -0,0 +1,73 @@
+package atlassian
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseARI(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected ARI
+	}{
+		{
+			"ari:cloud:jira:a436116f-02ce-4520-8fbb-7301462a1674:issue/10001",
+			ARI{Product: "jira", CloudID: "a436116f-02ce-4520-8fbb-7301462a1674", ResourceType: "issue", ResourceID: "10001"},
+		},
+		{
+			"ari:cloud:confluence:a436116f-02ce-4520-8fbb-7301462a1674:page/123456",
+			ARI{Product: "confluence", CloudID: "a436116f-02ce-4520-8fbb-7301462a1674", ResourceType: "page", ResourceID: "123456"},
+		},
+		{
+			"ari:cloud:identity::user/557058:f1b2c3",
+			ARI{Product: "identity", ResourceType: "user", ResourceID: "557058:f1b2c3"},
+		},
+		{
+			"ari:cloud:jira:a436116f-02ce-4520-8fbb-7301462a1674:workspace/abc/def",
+			ARI{Product: "jira", CloudID: "a436116f-02ce-4520-8fbb-7301462a1674", ResourceType: "workspace", ResourceID: "abc/def"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			ari, err := ParseARI(tt.input)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if *ari != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, *ari)
+			}
+			if ari.String() != tt.input {
+				t.Errorf("Expected round trip to %q, got %q", tt.input, ari.String())
+			}
+		})
+	}
+}
+
+func TestParseARI_Invalid(t *testing.T) {
+	tests := []struct {
+		input   string
+		segment string
+	}{
+		{"ari:cloud:jira", "expected ari:cloud"},
+		{"urn:cloud:jira:a436116f-02ce-4520-8fbb-7301462a1674:issue/1", "must start with 'ari'"},
+		{"ari:onprem:jira:a436116f-02ce-4520-8fbb-7301462a1674:issue/1", "partition"},
+		{"ari:cloud:Jira!:a436116f-02ce-4520-8fbb-7301462a1674:issue/1", "product"},
+		{"ari:cloud:jira:not-a-uuid:issue/1", "cloud ID"},
+		{"ari:cloud:jira:a436116f-02ce-4520-8fbb-7301462a1674:issue", "<type>/<id>"},
+		{"ari:cloud:jira:a436116f-02ce-4520-8fbb-7301462a1674:Issue/1", "resource type"},
+		{"ari:cloud:jira:a436116f-02ce-4520-8fbb-7301462a1674:issue/", "resource ID"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := ParseARI(tt.input)
+			if err == nil {
+				t.Fatalf("Expected error for %q", tt.input)
+			}
+			if !strings.Contains(err.Error(), tt.segment) {
+				t.Errorf("Expected error to mention %q, got %v", tt.segment, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package atlassian
+
+import "strings"
+
+// BlockerNode is an issue in a tree of blocking relationships. BlockedBy
+// lists the issues blocking this one.
+type BlockerNode struct {
+	Key       string         `json:"key"`
+	Summary   string         `json:"summary,omitempty"`
+	Status    string         `json:"status,omitempty"`
+	BlockedBy []*BlockerNode `json:"blockedBy,omitempty"`
+
+	// Repeated marks an issue already shown elsewhere in the tree; its
+	// blockers aren't expanded again, which also stops link cycles
+	Repeated bool `json:"repeated,omitempty"`
+
+	// Error is set when the issue's own links couldn't be fetched
+	Error string `json:"error,omitempty"`
+}
+
+// IssueBlockers returns the issues linked to an issue (given its fields) as
+// blocking it, i.e. its "is blocked by" links of the standard Blocks type
+func IssueBlockers(fields map[string]any) []*BlockerNode {
+	issueLinks, _ := fields["issuelinks"].([]any)
+
+	var blockers []*BlockerNode
+	for _, l := range issueLinks {
+		link, _ := l.(map[string]any)
+		linkType, _ := link["type"].(map[string]any)
+		if name, _ := linkType["name"].(string); !strings.EqualFold(name, "Blocks") {
+			continue
+		}
+
+		// An inward issue on a Blocks link is the one doing the blocking
+		inward, ok := link["inwardIssue"].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		key, _ := inward["key"].(string)
+		inwardFields, _ := inward["fields"].(map[string]any)
+		summary, _ := inwardFields["summary"].(string)
+		status, _ := inwardFields["status"].(map[string]any)
+		statusName, _ := status["name"].(string)
+
+		blockers = append(blockers, &BlockerNode{Key: key, Summary: summary, Status: statusName})
+	}
+	return blockers
+}
+
+// BuildBlockerTree returns the blockers of the issue identified by key (whose
+// fields are given), following blocking links up to depth levels. fetch
+// returns an issue's fields, including issuelinks. The tree is built breadth
+// first and each issue is expanded at most once, where it's closest to the
+// root, so the result is bounded even when links form a cycle.
+func BuildBlockerTree(key string, fields map[string]any, depth int, fetch func(key string) (map[string]any, error)) []*BlockerNode {
+	if depth < 1 {
+		return nil
+	}
+
+	visited := map[string]bool{key: true}
+	roots := IssueBlockers(fields)
+	nodes := roots
+	for level := 1; len(nodes) > 0; level++ {
+		var next []*BlockerNode
+		for _, node := range nodes {
+			if visited[node.Key] {
+				node.Repeated = true
+				continue
+			}
+			visited[node.Key] = true
+
+			if level >= depth {
+				continue
+			}
+
+			nodeFields, err := fetch(node.Key)
+			if err != nil {
+				node.Error = err.Error()
+				continue
+			}
+			node.BlockedBy = IssueBlockers(nodeFields)
+			next = append(next, node.BlockedBy...)
+		}
+		nodes = next
+	}
+	return roots
+}
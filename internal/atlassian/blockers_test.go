@@ -0,0 +1,128 @@
+package atlassian
+
+import (
+	"fmt"
+	"testing"
+)
+
+// blockedBy builds issue fields with "is blocked by" links to the given keys
+func blockedBy(keys ...string) map[string]any {
+	var links []any
+	for _, key := range keys {
+		links = append(links, map[string]any{
+			"type": map[string]any{"name": "Blocks", "inward": "is blocked by", "outward": "blocks"},
+			"inwardIssue": map[string]any{
+				"key": key,
+				"fields": map[string]any{
+					"summary": "Summary of " + key,
+					"status":  map[string]any{"name": "To Do"},
+				},
+			},
+		})
+	}
+	return map[string]any{"issuelinks": links}
+}
+
+func TestIssueBlockers(t *testing.T) {
+	fields := blockedBy("ABC-2")
+	links := fields["issuelinks"].([]any)
+	links = append(links,
+		// This issue blocks ABC-3, so ABC-3 isn't a blocker
+		map[string]any{
+			"type":         map[string]any{"name": "Blocks"},
+			"outwardIssue": map[string]any{"key": "ABC-3"},
+		},
+		// Other link types are ignored
+		map[string]any{
+			"type":        map[string]any{"name": "Relates"},
+			"inwardIssue": map[string]any{"key": "ABC-4"},
+		},
+	)
+	fields["issuelinks"] = links
+
+	blockers := IssueBlockers(fields)
+	if len(blockers) != 1 {
+		t.Fatalf("Expected 1 blocker, got %d", len(blockers))
+	}
+	if blockers[0].Key != "ABC-2" || blockers[0].Status != "To Do" || blockers[0].Summary != "Summary of ABC-2" {
+		t.Errorf("Unexpected blocker: %+v", blockers[0])
+	}
+}
+
+func TestBuildBlockerTree(t *testing.T) {
+	issues := map[string]map[string]any{
+		"ABC-2": blockedBy("ABC-3", "ABC-4"),
+		"ABC-3": blockedBy("ABC-1"), // cycle back to the root
+		"ABC-4": blockedBy("ABC-5"),
+		"ABC-5": blockedBy("ABC-6"),
+	}
+	var fetched []string
+	fetch := func(key string) (map[string]any, error) {
+		fetched = append(fetched, key)
+		fields, ok := issues[key]
+		if !ok {
+			return nil, fmt.Errorf("issue %s not found", key)
+		}
+		return fields, nil
+	}
+
+	tree := BuildBlockerTree("ABC-1", blockedBy("ABC-2"), 3, fetch)
+
+	if len(tree) != 1 || tree[0].Key != "ABC-2" {
+		t.Fatalf("Expected ABC-2 at the root, got %+v", tree)
+	}
+	children := tree[0].BlockedBy
+	if len(children) != 2 || children[0].Key != "ABC-3" || children[1].Key != "ABC-4" {
+		t.Fatalf("Expected ABC-3 and ABC-4 under ABC-2, got %+v", children)
+	}
+	if cycle := children[0].BlockedBy; len(cycle) != 1 || cycle[0].Key != "ABC-1" || !cycle[0].Repeated {
+		t.Errorf("Expected ABC-1 marked repeated under ABC-3, got %+v", cycle)
+	}
+	leaf := children[1].BlockedBy
+	if len(leaf) != 1 || leaf[0].Key != "ABC-5" {
+		t.Fatalf("Expected ABC-5 under ABC-4, got %+v", leaf)
+	}
+	if leaf[0].BlockedBy != nil {
+		t.Errorf("Expected depth 3 to stop before ABC-5's blockers, got %+v", leaf[0].BlockedBy)
+	}
+
+	expectedFetches := []string{"ABC-2", "ABC-3", "ABC-4"}
+	if fmt.Sprint(fetched) != fmt.Sprint(expectedFetches) {
+		t.Errorf("Expected fetches %v, got %v", expectedFetches, fetched)
+	}
+}
+
+func TestBuildBlockerTree_ExpandsShallowestOccurrence(t *testing.T) {
+	// ABC-9 blocks the root directly and also blocks ABC-2
+	issues := map[string]map[string]any{
+		"ABC-2":  blockedBy("ABC-9"),
+		"ABC-9":  blockedBy("ABC-10"),
+		"ABC-10": blockedBy(),
+	}
+	fetch := func(key string) (map[string]any, error) {
+		return issues[key], nil
+	}
+
+	tree := BuildBlockerTree("ABC-1", blockedBy("ABC-2", "ABC-9"), 2, fetch)
+
+	if len(tree) != 2 || tree[1].Key != "ABC-9" {
+		t.Fatalf("Expected ABC-2 and ABC-9 at the root, got %+v", tree)
+	}
+	if direct := tree[1]; direct.Repeated || len(direct.BlockedBy) != 1 || direct.BlockedBy[0].Key != "ABC-10" {
+		t.Errorf("Expected the direct ABC-9 expanded with ABC-10, got %+v", direct)
+	}
+	if nested := tree[0].BlockedBy; len(nested) != 1 || !nested[0].Repeated {
+		t.Errorf("Expected ABC-9 under ABC-2 marked repeated, got %+v", nested)
+	}
+}
+
+func TestBuildBlockerTree_FetchError(t *testing.T) {
+	fetch := func(key string) (map[string]any, error) {
+		return nil, fmt.Errorf("forbidden")
+	}
+
+	tree := BuildBlockerTree("ABC-1", blockedBy("ABC-2"), 2, fetch)
+	if len(tree) != 1 || tree[0].Error != "forbidden" {
+		t.Errorf("Expected the fetch error on ABC-2, got %+v", tree)
+	}
+}
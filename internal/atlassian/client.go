@@ -2,8 +2,10 @@ package atlassian
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -12,8 +14,10 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
+	"unicode"
 )
 
 // Client represents an Atlassian API client
@@ -21,9 +25,15 @@ type Client struct {
 	Email   string
 	Token   string
 	BaseURL string
+	Retry   RetryPolicy
+	Headers http.Header // Extra headers sent with every request
 	client  *http.Client
+	ctx     context.Context
 }
 
+// DefaultTimeout is how long a single request may take before it's abandoned
+const DefaultTimeout = 30 * time.Second
+
 // NewClient creates a new Atlassian API client
 func NewClient(email, token, site string) *Client {
 	baseURL := site
@@ -35,34 +45,102 @@ func NewClient(email, token, site string) *Client {
 		Email:   email,
 		Token:   token,
 		BaseURL: baseURL,
+		Retry:   DefaultRetryPolicy(),
 		client: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout: DefaultTimeout,
 		},
 	}
 }
 
+// WithContext returns a copy of the client whose requests are bound to ctx,
+// so cancelling ctx aborts any request in flight and any pending retry
+func (c *Client) WithContext(ctx context.Context) *Client {
+	clone := *c
+	clone.ctx = ctx
+	return &clone
+}
+
+// SetTimeout sets how long a single request may take (0 means no limit)
+func (c *Client) SetTimeout(timeout time.Duration) {
+	c.client.Timeout = timeout
+}
+
+// context returns the context requests are bound to
+func (c *Client) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
 // basicAuth returns the Basic auth header value
 func (c *Client) basicAuth() string {
 	auth := c.Email + ":" + c.Token
 	return "Basic " + base64.StdEncoding.EncodeToString([]byte(auth))
 }
 
-// doRequest performs an HTTP request with authentication
+// doRequest performs an HTTP request with authentication, retrying responses
+// whose status is in the client's retry policy (see RetryPolicy.shouldRetry)
 func (c *Client) doRequest(method, url string, body io.Reader) (*http.Response, error) {
-	req, err := http.NewRequest(method, url, body)
+	// Buffer the body so it can be replayed on retries
+	var payload []byte
+	if body != nil {
+		var err error
+		payload, err = io.ReadAll(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		var reqBody io.Reader
+		if payload != nil {
+			reqBody = bytes.NewReader(payload)
+		}
+
+		resp, err := c.sendRequest(method, url, "application/json", reqBody)
+		if err != nil {
+			return nil, err
+		}
+
+		if !c.Retry.shouldRetry(method, resp, attempt) {
+			return resp, nil
+		}
+
+		delay := retryDelay(resp, attempt)
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-c.context().Done():
+			timer.Stop()
+			return nil, fmt.Errorf("failed to execute request: %w", c.context().Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// sendRequest makes a single authenticated attempt at a request
+func (c *Client) sendRequest(method, url, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(c.context(), method, url, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Authorization", c.basicAuth())
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
+	if strings.HasPrefix(contentType, "multipart/") {
+		// Atlassian's XSRF check rejects form posts without this
+		req.Header.Set("X-Atlassian-Token", "no-check")
+	}
+	c.applyHeaders(req)
 
 	resp, err := c.client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
-
 	return resp, nil
 }
 
@@ -132,10 +210,141 @@ func (c *Client) GetCurrentUser() (*UserInfo, error) {
 	return &user, nil
 }
 
-// TestAuthentication verifies that the credentials are valid by calling the Jira API
-func (c *Client) TestAuthentication() error {
-	_, err := c.GetCurrentUser()
-	return err
+// CheckAuthentication verifies that the credentials are valid by calling the
+// Jira API. It returns the HTTP status of the check so callers can tell bad
+// credentials (401) from missing permissions (403). The status is 0 when the
+// site couldn't be reached.
+func (c *Client) CheckAuthentication() (*UserInfo, int, error) {
+	apiURL := fmt.Sprintf("%s/rest/api/3/myself", c.BaseURL)
+
+	resp, err := c.doRequest("GET", apiURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, resp.StatusCode, fmt.Errorf("failed to get user info (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var user UserInfo
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, resp.StatusCode, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &user, resp.StatusCode, nil
+}
+
+// GetMyPermissions reports which of the given permission keys (e.g.
+// BROWSE_PROJECTS, ADMINISTER) the authenticated user holds, in the order
+// given. Project permissions count if the user has them in any project.
+func (c *Client) GetMyPermissions(keys []string) ([]string, error) {
+	params := url.Values{}
+	params.Set("permissions", strings.Join(keys, ","))
+	apiURL := fmt.Sprintf("%s/rest/api/3/mypermissions?%s", c.BaseURL, params.Encode())
+
+	resp, err := c.doRequest("GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get permissions (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		Permissions map[string]struct {
+			HavePermission bool `json:"havePermission"`
+		} `json:"permissions"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	held := []string{}
+	for _, key := range keys {
+		if result.Permissions[key].HavePermission {
+			held = append(held, key)
+		}
+	}
+	return held, nil
+}
+
+// Ping makes the cheapest authenticated request available (the current user's
+// account ID only) and reports whether it succeeded
+func (c *Client) Ping() error {
+	apiURL := fmt.Sprintf("%s/rest/api/3/myself?fields=accountId", c.BaseURL)
+
+	resp, err := c.doRequest("GET", apiURL, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return fmt.Errorf("authentication failed (status 401); check your email and API token")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("ping failed (status %d)", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// GetServerInfo fetches the Jira site's version, build, and deployment type
+func (c *Client) GetServerInfo() (map[string]any, error) {
+	apiURL := fmt.Sprintf("%s/rest/api/3/serverInfo", c.BaseURL)
+
+	resp, err := c.doRequest("GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get server info (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var info map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return info, nil
+}
+
+// GetCloudID fetches the site's cloud ID from the public tenant info endpoint
+func (c *Client) GetCloudID() (string, error) {
+	url := fmt.Sprintf("%s/_edge/tenant_info", c.BaseURL)
+
+	resp, err := c.doRequest("GET", url, nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("failed to get tenant info (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		CloudID string `json:"cloudId"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if result.CloudID == "" {
+		return "", fmt.Errorf("tenant info did not include a cloud ID")
+	}
+
+	return result.CloudID, nil
 }
 
 // GetIssueOptions contains optional parameters for getting an issue
@@ -193,41 +402,116 @@ func (c *Client) GetJiraIssue(issueKey string, opts *GetIssueOptions) (map[strin
 	return issue, nil
 }
 
+// DefaultSearchFields lists the fields SearchJiraIssuesJQL requests when no
+// field list is given
+var DefaultSearchFields = []string{"summary", "status", "issuetype", "assignee", "priority", "reporter", "created", "updated"}
+
 // SearchJQLOptions contains optional parameters for JQL search
 type SearchJQLOptions struct {
-	Fields      []string // List of fields to return
-	MaxResults  int      // Maximum number of results (default 50, max 100)
-	StartAt     int      // Starting index for pagination
+	Fields        []string // List of fields to return
+	MaxResults    int      // Maximum number of results per request (default 50, max 100)
+	StartAt       int      // Starting index for pagination
+	NextPageToken string   // Token from a previous response's nextPageToken
+
+	// FetchAll follows nextPageToken (or startAt for APIs that report a
+	// total) until the last page, merging every page's issues into the
+	// returned result
+	FetchAll bool
+
+	// OnPage, when set with FetchAll, receives each page's issues as it
+	// arrives instead of them being accumulated in the result, so large
+	// result sets needn't be held in memory
+	OnPage func(issues []any) error
 }
 
 // SearchJiraIssuesJQL searches for Jira issues using JQL (Jira Query Language)
 func (c *Client) SearchJiraIssuesJQL(jql string, opts *SearchJQLOptions) (map[string]any, error) {
-	baseURL := fmt.Sprintf("%s/rest/api/3/search/jql", c.BaseURL)
-
-	// Build query parameters using url.Values for proper encoding
-	params := url.Values{}
-	params.Add("jql", jql)
+	if opts == nil {
+		opts = &SearchJQLOptions{}
+	}
+	if !opts.FetchAll {
+		return c.searchJQLPage(jql, opts, opts.NextPageToken, opts.StartAt)
+	}
 
-	// Default fields to request if none specified
-	defaultFields := "summary,status,issuetype,assignee,priority,reporter,created,updated"
+	all := []any{}
+	var result map[string]any
+	token, startAt := opts.NextPageToken, opts.StartAt
+	seenTokens := map[string]bool{}
 
-	if opts != nil {
-		if len(opts.Fields) > 0 {
-			params.Add("fields", strings.Join(opts.Fields, ","))
-		} else {
-			params.Add("fields", defaultFields)
+	for {
+		page, err := c.searchJQLPage(jql, opts, token, startAt)
+		if err != nil {
+			return nil, err
 		}
-		if opts.MaxResults > 0 {
-			params.Add("maxResults", fmt.Sprintf("%d", opts.MaxResults))
+		result = page
+
+		issues, _ := page["issues"].([]any)
+		if opts.OnPage != nil {
+			if len(issues) > 0 {
+				if err := opts.OnPage(issues); err != nil {
+					return nil, err
+				}
+			}
 		} else {
-			params.Add("maxResults", "50") // Default
+			all = append(all, issues...)
 		}
-		if opts.StartAt > 0 {
-			params.Add("startAt", fmt.Sprintf("%d", opts.StartAt))
+
+		// An empty page ends the search even if the API claims there's more
+		isLast, _ := page["isLast"].(bool)
+		if len(issues) == 0 || isLast {
+			break
+		}
+
+		if next, _ := page["nextPageToken"].(string); next != "" {
+			if seenTokens[next] {
+				return nil, fmt.Errorf("search returned page token %q twice", next)
+			}
+			seenTokens[next] = true
+			token = next
+			continue
+		}
+
+		// The classic search API pages by startAt and reports a total
+		total, ok := page["total"].(float64)
+		startAt += len(issues)
+		if !ok || startAt >= int(total) {
+			break
 		}
+	}
+
+	// Present the merged pages as a single, final page
+	if opts.OnPage != nil {
+		delete(result, "issues")
+	} else {
+		result["issues"] = all
+	}
+	result["isLast"] = true
+	delete(result, "nextPageToken")
+	return result, nil
+}
+
+// searchJQLPage fetches a single page of JQL search results
+func (c *Client) searchJQLPage(jql string, opts *SearchJQLOptions, nextPageToken string, startAt int) (map[string]any, error) {
+	baseURL := fmt.Sprintf("%s/rest/api/3/search/jql", c.BaseURL)
+
+	// Build query parameters using url.Values for proper encoding
+	params := url.Values{}
+	params.Add("jql", jql)
+
+	if len(opts.Fields) > 0 {
+		params.Add("fields", strings.Join(opts.Fields, ","))
 	} else {
-		params.Add("fields", defaultFields)
-		params.Add("maxResults", "50")
+		params.Add("fields", strings.Join(DefaultSearchFields, ","))
+	}
+	if opts.MaxResults > 0 {
+		params.Add("maxResults", fmt.Sprintf("%d", opts.MaxResults))
+	} else {
+		params.Add("maxResults", "50") // Default
+	}
+	if nextPageToken != "" {
+		params.Add("nextPageToken", nextPageToken)
+	} else if startAt > 0 {
+		params.Add("startAt", fmt.Sprintf("%d", startAt))
 	}
 
 	fullURL := baseURL + "?" + params.Encode()
@@ -261,6 +545,10 @@ type CreateIssueOptions struct {
 	ParentKey   string
 	PriorityID  string
 	Fields      map[string]any // Additional custom fields
+
+	// DescriptionADF is the description as ADF; used instead of Description
+	// when set
+	DescriptionADF map[string]any
 }
 
 // CreateJiraIssue creates a new Jira issue
@@ -290,6 +578,9 @@ func (c *Client) CreateJiraIssue(opts *CreateIssueOptions) (map[string]any, erro
 		}
 		fields["description"] = adf
 	}
+	if opts.DescriptionADF != nil {
+		fields["description"] = opts.DescriptionADF
+	}
 
 	if opts.AssigneeID != "" {
 		fields["assignee"] = map[string]any{
@@ -347,6 +638,7 @@ func (c *Client) CreateJiraIssue(opts *CreateIssueOptions) (map[string]any, erro
 // AddCommentOptions contains parameters for adding a comment
 type AddCommentOptions struct {
 	Comment        string
+	Body           map[string]any // Comment body as ADF; used instead of Comment when set
 	VisibilityType string // "group" or "role"
 	VisibilityValue string // Group or role name
 }
@@ -373,6 +665,9 @@ func (c *Client) AddCommentToIssue(issueKey string, opts *AddCommentOptions) (ma
 			},
 		},
 	}
+	if opts.Body != nil {
+		body["body"] = opts.Body
+	}
 
 	// Add visibility if specified
 	if opts.VisibilityType != "" && opts.VisibilityValue != "" {
@@ -406,10 +701,149 @@ func (c *Client) AddCommentToIssue(issueKey string, opts *AddCommentOptions) (ma
 	return result, nil
 }
 
+// GetIssueCommentsOptions contains parameters for getting issue comments
+type GetIssueCommentsOptions struct {
+	StartAt    int
+	MaxResults int
+	OrderBy    string // "created" or "-created"
+}
+
+// GetIssueComments gets one page of comments on a Jira issue
+func (c *Client) GetIssueComments(issueKey string, opts *GetIssueCommentsOptions) (map[string]any, error) {
+	baseURL := fmt.Sprintf("%s/rest/api/3/issue/%s/comment", c.BaseURL, issueKey)
+
+	params := url.Values{}
+	if opts != nil {
+		if opts.StartAt > 0 {
+			params.Add("startAt", fmt.Sprintf("%d", opts.StartAt))
+		}
+		if opts.MaxResults > 0 {
+			params.Add("maxResults", fmt.Sprintf("%d", opts.MaxResults))
+		}
+		if opts.OrderBy != "" {
+			params.Add("orderBy", opts.OrderBy)
+		}
+	}
+
+	fullURL := baseURL
+	if len(params) > 0 {
+		fullURL += "?" + params.Encode()
+	}
+
+	resp, err := c.doRequest("GET", fullURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get comments (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var result map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetAllIssueComments gets every comment on a Jira issue, oldest first, by
+// following startAt pagination until the reported total is reached
+func (c *Client) GetAllIssueComments(issueKey string) ([]map[string]any, error) {
+	opts := &GetIssueCommentsOptions{MaxResults: 100, OrderBy: "created"}
+
+	var comments []map[string]any
+	for {
+		result, err := c.GetIssueComments(issueKey, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		page, _ := result["comments"].([]any)
+		for _, item := range page {
+			if comment, ok := item.(map[string]any); ok {
+				comments = append(comments, comment)
+			}
+		}
+
+		total, _ := result["total"].(float64)
+		opts.StartAt += len(page)
+		if len(page) == 0 || opts.StartAt >= int(total) {
+			break
+		}
+	}
+
+	return comments, nil
+}
+
+// EditComment replaces the body of a comment on a Jira issue with an ADF
+// document
+func (c *Client) EditComment(issueKey, commentID string, body map[string]any) (map[string]any, error) {
+	apiURL := fmt.Sprintf("%s/rest/api/3/issue/%s/comment/%s", c.BaseURL, url.PathEscape(issueKey), url.PathEscape(commentID))
+
+	jsonBody, err := json.Marshal(map[string]any{"body": body})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest("PUT", apiURL, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to edit comment (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+// DeleteComment deletes a comment from a Jira issue
+func (c *Client) DeleteComment(issueKey, commentID string) error {
+	apiURL := fmt.Sprintf("%s/rest/api/3/issue/%s/comment/%s", c.BaseURL, url.PathEscape(issueKey), url.PathEscape(commentID))
+
+	resp, err := c.doRequest("DELETE", apiURL, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to delete comment (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
 // EditJiraIssue updates fields on a Jira issue
 func (c *Client) EditJiraIssue(issueKey string, fields map[string]any) error {
+	return c.EditJiraIssueWithOptions(issueKey, fields, nil)
+}
+
+// EditIssueOptions contains optional parameters for editing an issue
+type EditIssueOptions struct {
+	SkipNotifications bool // Send notifyUsers=false (requires admin permission)
+}
+
+// EditJiraIssueWithOptions updates fields on a Jira issue
+func (c *Client) EditJiraIssueWithOptions(issueKey string, fields map[string]any, opts *EditIssueOptions) error {
 	url := fmt.Sprintf("%s/rest/api/3/issue/%s", c.BaseURL, issueKey)
 
+	skipNotifications := opts != nil && opts.SkipNotifications
+	if skipNotifications {
+		url += "?notifyUsers=false"
+	}
+
 	body := map[string]any{
 		"fields": fields,
 	}
@@ -427,12 +861,31 @@ func (c *Client) EditJiraIssue(issueKey string, fields map[string]any) error {
 
 	if resp.StatusCode != http.StatusNoContent {
 		respBody, _ := io.ReadAll(resp.Body)
+		if skipNotifications && isNotifyForbidden(resp.StatusCode, respBody) {
+			return errNotifyForbidden
+		}
 		return fmt.Errorf("failed to edit issue (status %d): %s", resp.StatusCode, string(respBody))
 	}
 
 	return nil
 }
 
+// errNotifyForbidden explains the 403 Jira returns when a non-admin asks to
+// suppress notifications
+var errNotifyForbidden = errors.New("disabling notifications requires Jira administrator or project administrator permission (status 403). Retry without --no-notify")
+
+// isNotifyForbidden reports whether a response is Jira refusing notifyUsers,
+// as opposed to a 403 for some other reason such as lacking Edit Issues
+// permission. Jira's message reads "To discard the user notification either
+// admin or project admin permissions are required."
+func isNotifyForbidden(status int, body []byte) bool {
+	if status != http.StatusForbidden {
+		return false
+	}
+	text := strings.ToLower(string(body))
+	return strings.Contains(text, "notifyusers") || strings.Contains(text, "notification")
+}
+
 // GetTransitionsOptions contains optional parameters for getting transitions
 type GetTransitionsOptions struct {
 	Expand                      string
@@ -565,6 +1018,290 @@ func (c *Client) LookupAccountID(searchString string) ([]map[string]any, error)
 	return users, nil
 }
 
+// accountIDRegexp matches Atlassian account IDs: 24 hex digits for older
+// accounts, or a numeric prefix and a UUID (e.g. 557058:f58131cb-...)
+var accountIDRegexp = regexp.MustCompile(`^(?:[0-9a-f]{24}|\d+:[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})$`)
+
+// LooksLikeAccountID reports whether s has the shape of an Atlassian account
+// ID rather than a name or email address
+func LooksLikeAccountID(s string) bool {
+	return accountIDRegexp.MatchString(s)
+}
+
+// AssignIssue sets an issue's assignee. An empty accountID unassigns it.
+func (c *Client) AssignIssue(issueKey, accountID string) error {
+	apiURL := fmt.Sprintf("%s/rest/api/3/issue/%s/assignee", c.BaseURL, url.PathEscape(issueKey))
+
+	body := map[string]any{"accountId": nil}
+	if accountID != "" {
+		body["accountId"] = accountID
+	}
+
+	bodyJSON, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest("PUT", apiURL, strings.NewReader(string(bodyJSON)))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to assign issue (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
+
+// GetWatchers gets the users watching a Jira issue
+func (c *Client) GetWatchers(issueKey string) (map[string]any, error) {
+	apiURL := fmt.Sprintf("%s/rest/api/3/issue/%s/watchers", c.BaseURL, url.PathEscape(issueKey))
+
+	resp, err := c.doRequest("GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get watchers (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+// AddWatcher adds a user to an issue's watchers
+func (c *Client) AddWatcher(issueKey, accountID string) error {
+	apiURL := fmt.Sprintf("%s/rest/api/3/issue/%s/watchers", c.BaseURL, url.PathEscape(issueKey))
+
+	// The body is the account ID as a bare JSON string
+	bodyJSON, err := json.Marshal(accountID)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest("POST", apiURL, strings.NewReader(string(bodyJSON)))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to add watcher (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
+
+// RemoveWatcher removes a user from an issue's watchers
+func (c *Client) RemoveWatcher(issueKey, accountID string) error {
+	apiURL := fmt.Sprintf("%s/rest/api/3/issue/%s/watchers?accountId=%s", c.BaseURL, url.PathEscape(issueKey), url.QueryEscape(accountID))
+
+	resp, err := c.doRequest("DELETE", apiURL, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to remove watcher (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
+
+// AddWorklogOptions contains parameters for logging time on an issue
+type AddWorklogOptions struct {
+	TimeSpent      string         // Time in Jira duration format, e.g. "2h 30m"
+	Comment        map[string]any // Worklog comment as ADF
+	Started        string         // Start time, e.g. "2024-01-15T09:00:00.000+0000" (defaults to now)
+	AdjustEstimate string         // "auto", "new", "leave", or "manual" (defaults to auto)
+	NewEstimate    string         // Remaining estimate to set; required with AdjustEstimate "new"
+	ReduceBy       string         // Amount to reduce the estimate by; required with AdjustEstimate "manual"
+}
+
+// AddWorklog logs time against a Jira issue and returns the created worklog
+func (c *Client) AddWorklog(issueKey string, opts *AddWorklogOptions) (map[string]any, error) {
+	if opts.TimeSpent == "" {
+		return nil, fmt.Errorf("time spent is required")
+	}
+
+	params := url.Values{}
+	switch opts.AdjustEstimate {
+	case "", "auto", "leave":
+	case "new":
+		if opts.NewEstimate == "" {
+			return nil, fmt.Errorf("a new estimate is required when adjusting the estimate with 'new'")
+		}
+		params.Add("newEstimate", opts.NewEstimate)
+	case "manual":
+		if opts.ReduceBy == "" {
+			return nil, fmt.Errorf("a reduce-by amount is required when adjusting the estimate with 'manual'")
+		}
+		params.Add("reduceBy", opts.ReduceBy)
+	default:
+		return nil, fmt.Errorf("invalid estimate adjustment %q (expected auto, new, leave, or manual)", opts.AdjustEstimate)
+	}
+	if opts.AdjustEstimate != "" {
+		params.Add("adjustEstimate", opts.AdjustEstimate)
+	}
+
+	apiURL := fmt.Sprintf("%s/rest/api/3/issue/%s/worklog", c.BaseURL, url.PathEscape(issueKey))
+	if len(params) > 0 {
+		apiURL += "?" + params.Encode()
+	}
+
+	body := map[string]any{
+		"timeSpent": opts.TimeSpent,
+	}
+	if opts.Comment != nil {
+		body["comment"] = opts.Comment
+	}
+	if opts.Started != "" {
+		body["started"] = opts.Started
+	}
+
+	bodyJSON, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest("POST", apiURL, strings.NewReader(string(bodyJSON)))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to add worklog (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetWorklogsOptions contains parameters for listing an issue's worklogs
+type GetWorklogsOptions struct {
+	StartAt       int
+	MaxResults    int
+	StartedAfter  time.Time // Only worklogs started at or after this time
+	StartedBefore time.Time // Only worklogs started before this time
+}
+
+// GetWorklogs gets one page of worklogs on a Jira issue
+func (c *Client) GetWorklogs(issueKey string, opts *GetWorklogsOptions) (map[string]any, error) {
+	apiURL := fmt.Sprintf("%s/rest/api/3/issue/%s/worklog", c.BaseURL, url.PathEscape(issueKey))
+
+	params := url.Values{}
+	if opts != nil {
+		if opts.StartAt > 0 {
+			params.Add("startAt", fmt.Sprintf("%d", opts.StartAt))
+		}
+		if opts.MaxResults > 0 {
+			params.Add("maxResults", fmt.Sprintf("%d", opts.MaxResults))
+		}
+		if !opts.StartedAfter.IsZero() {
+			params.Add("startedAfter", fmt.Sprintf("%d", opts.StartedAfter.UnixMilli()))
+		}
+		if !opts.StartedBefore.IsZero() {
+			params.Add("startedBefore", fmt.Sprintf("%d", opts.StartedBefore.UnixMilli()))
+		}
+	}
+	if len(params) > 0 {
+		apiURL += "?" + params.Encode()
+	}
+
+	resp, err := c.doRequest("GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get worklogs (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetAllWorklogs gets every worklog on a Jira issue matching opts's time
+// filters, following startAt pagination until the reported total is reached.
+// Each page is returned exactly as the API sent it.
+func (c *Client) GetAllWorklogs(issueKey string, opts *GetWorklogsOptions) ([]map[string]any, error) {
+	pageOpts := GetWorklogsOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+	pageOpts.StartAt = 0
+
+	var pages []map[string]any
+	for {
+		result, err := c.GetWorklogs(issueKey, &pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		pages = append(pages, result)
+
+		page, _ := result["worklogs"].([]any)
+		total, _ := result["total"].(float64)
+		pageOpts.StartAt += len(page)
+		if len(page) == 0 || pageOpts.StartAt >= int(total) {
+			break
+		}
+	}
+
+	return pages, nil
+}
+
+// FilterUsersOptions contains criteria for narrowing user search results
+type FilterUsersOptions struct {
+	ActiveOnly bool   // Drop deactivated users
+	Exact      string // Keep only users whose display name or email equals this (case-insensitive)
+}
+
+// FilterUsers narrows the results of LookupAccountID
+func FilterUsers(users []map[string]any, opts *FilterUsersOptions) []map[string]any {
+	filtered := make([]map[string]any, 0, len(users))
+	for _, user := range users {
+		if opts.ActiveOnly {
+			if active, _ := user["active"].(bool); !active {
+				continue
+			}
+		}
+		if opts.Exact != "" {
+			displayName, _ := user["displayName"].(string)
+			email, _ := user["emailAddress"].(string)
+			if !strings.EqualFold(displayName, opts.Exact) && !strings.EqualFold(email, opts.Exact) {
+				continue
+			}
+		}
+		filtered = append(filtered, user)
+	}
+	return filtered
+}
+
 // GetVisibleProjectsOptions contains parameters for getting visible projects
 type GetVisibleProjectsOptions struct {
 	Action         string // view, browse, edit, create
@@ -684,126 +1421,382 @@ func (c *Client) GetProjectIssueTypes(projectKey string, opts *GetProjectIssueTy
 	return issueTypes, nil
 }
 
-// GetRemoteLinksOptions contains parameters for getting remote issue links
-type GetRemoteLinksOptions struct {
-	GlobalID string
-}
-
-// GetIssueRemoteLinks gets remote links for a Jira issue
-func (c *Client) GetIssueRemoteLinks(issueKey string, opts *GetRemoteLinksOptions) ([]map[string]any, error) {
-	baseURL := fmt.Sprintf("%s/rest/api/3/issue/%s/remotelink", c.BaseURL, issueKey)
-
-	params := url.Values{}
-	if opts != nil && opts.GlobalID != "" {
-		params.Add("globalId", opts.GlobalID)
-	}
+// errProjectRolesForbidden explains the 403 Jira returns when a user without
+// project admin rights asks for role membership
+var errProjectRolesForbidden = errors.New("viewing project roles requires the Administer Projects permission (status 403)")
 
-	fullURL := baseURL
-	if len(params) > 0 {
-		fullURL += "?" + params.Encode()
-	}
+// GetProjectRoles lists a project's roles as a map of role name to role URL
+func (c *Client) GetProjectRoles(projectKey string) (map[string]string, error) {
+	apiURL := fmt.Sprintf("%s/rest/api/3/project/%s/role", c.BaseURL, url.PathEscape(projectKey))
 
-	resp, err := c.doRequest("GET", fullURL, nil)
+	resp, err := c.doRequest("GET", apiURL, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusForbidden {
+		return nil, errProjectRolesForbidden
+	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get remote links (status %d): %s", resp.StatusCode, string(body))
+		return nil, fmt.Errorf("failed to get project roles (status %d): %s", resp.StatusCode, string(body))
 	}
 
-	var links []map[string]any
-	if err := json.NewDecoder(resp.Body).Decode(&links); err != nil {
+	var roles map[string]string
+	if err := json.NewDecoder(resp.Body).Decode(&roles); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	return links, nil
-}
-
-// SearchCQLOptions contains optional parameters for CQL search
-type SearchCQLOptions struct {
-	Limit      int
-	Cursor     string
-	CqlContext string
-	Expand     string
-	Next       bool
-	Prev       bool
+	return roles, nil
 }
 
-// SearchConfluenceCQL searches Confluence content using CQL (Confluence Query Language)
-func (c *Client) SearchConfluenceCQL(cql string, opts *SearchCQLOptions) (map[string]any, error) {
-	baseURL := fmt.Sprintf("%s/wiki/rest/api/content/search", c.BaseURL)
-
-	// Build query parameters using url.Values for proper encoding
-	params := url.Values{}
-	params.Add("cql", cql)
-
-	if opts != nil {
-		if opts.Limit > 0 {
-			params.Add("limit", fmt.Sprintf("%d", opts.Limit))
-		} else {
-			params.Add("limit", "25")
-		}
-		if opts.Cursor != "" {
-			params.Add("cursor", opts.Cursor)
-		}
-		if opts.CqlContext != "" {
-			params.Add("cqlcontext", opts.CqlContext)
-		}
-		if opts.Expand != "" {
-			params.Add("expand", opts.Expand)
-		}
-		if opts.Next {
-			params.Add("next", "true")
-		}
-		if opts.Prev {
-			params.Add("prev", "true")
-		}
-	} else {
-		params.Add("limit", "25")
-	}
-
-	fullURL := baseURL + "?" + params.Encode()
+// GetProjectRole gets a project role, including its actors (users and groups)
+func (c *Client) GetProjectRole(projectKey, roleID string) (map[string]any, error) {
+	apiURL := fmt.Sprintf("%s/rest/api/3/project/%s/role/%s", c.BaseURL, url.PathEscape(projectKey), url.PathEscape(roleID))
 
-	resp, err := c.doRequest("GET", fullURL, nil)
+	resp, err := c.doRequest("GET", apiURL, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusForbidden {
+		return nil, errProjectRolesForbidden
+	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to search content (status %d): %s", resp.StatusCode, string(body))
+		return nil, fmt.Errorf("failed to get project role (status %d): %s", resp.StatusCode, string(body))
 	}
 
-	var result map[string]any
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	var role map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&role); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	return result, nil
+	return role, nil
 }
 
-// GetPageOptions contains parameters for getting a page
-type GetPageOptions struct {
-	Status string // Page status: current, draft, archived, trashed
+// CreateVersionOptions contains parameters for creating a project version
+type CreateVersionOptions struct {
+	ProjectKey  string
+	Name        string
+	Description string
+	StartDate   string // YYYY-MM-DD
+	ReleaseDate string // YYYY-MM-DD
 }
 
-// GetConfluencePage retrieves a Confluence page by ID
-func (c *Client) GetConfluencePage(pageID string, opts *GetPageOptions) (map[string]any, error) {
-	baseURL := fmt.Sprintf("%s/wiki/rest/api/content/%s", c.BaseURL, pageID)
-
-	// Request body content expanded
-	params := url.Values{}
-	params.Add("expand", "body.storage,version,space,history")
+// CreateProjectVersion creates a new version (release) in a Jira project
+func (c *Client) CreateProjectVersion(opts *CreateVersionOptions) (map[string]any, error) {
+	apiURL := fmt.Sprintf("%s/rest/api/3/version", c.BaseURL)
 
-	// Add status if specified (defaults to current if not specified)
-	if opts != nil && opts.Status != "" {
+	body := map[string]any{
+		"project": opts.ProjectKey,
+		"name":    opts.Name,
+	}
+	if opts.Description != "" {
+		body["description"] = opts.Description
+	}
+	if opts.StartDate != "" {
+		body["startDate"] = opts.StartDate
+	}
+	if opts.ReleaseDate != "" {
+		body["releaseDate"] = opts.ReleaseDate
+	}
+
+	bodyJSON, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest("POST", apiURL, bytes.NewReader(bodyJSON))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to create version (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+// ReleaseVersion marks a version as released on the given date (YYYY-MM-DD)
+func (c *Client) ReleaseVersion(versionID, releaseDate string) (map[string]any, error) {
+	apiURL := fmt.Sprintf("%s/rest/api/3/version/%s", c.BaseURL, url.PathEscape(versionID))
+
+	body := map[string]any{
+		"released":    true,
+		"releaseDate": releaseDate,
+	}
+
+	bodyJSON, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest("PUT", apiURL, bytes.NewReader(bodyJSON))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to release version (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetRemoteLinksOptions contains parameters for getting remote issue links
+type GetRemoteLinksOptions struct {
+	GlobalID string
+}
+
+// GetIssueRemoteLinks gets remote links for a Jira issue
+func (c *Client) GetIssueRemoteLinks(issueKey string, opts *GetRemoteLinksOptions) ([]map[string]any, error) {
+	baseURL := fmt.Sprintf("%s/rest/api/3/issue/%s/remotelink", c.BaseURL, issueKey)
+
+	params := url.Values{}
+	if opts != nil && opts.GlobalID != "" {
+		params.Add("globalId", opts.GlobalID)
+	}
+
+	fullURL := baseURL
+	if len(params) > 0 {
+		fullURL += "?" + params.Encode()
+	}
+
+	resp, err := c.doRequest("GET", fullURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get remote links (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var links []map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&links); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return links, nil
+}
+
+// SearchCQLOptions contains optional parameters for CQL search
+type SearchCQLOptions struct {
+	Limit      int
+	Cursor     string
+	CqlContext string
+	Expand     string
+	Next       bool
+	Prev       bool
+}
+
+// SearchConfluenceCQL searches Confluence content using CQL (Confluence Query Language)
+func (c *Client) SearchConfluenceCQL(cql string, opts *SearchCQLOptions) (map[string]any, error) {
+	baseURL := fmt.Sprintf("%s/wiki/rest/api/content/search", c.BaseURL)
+
+	// Build query parameters using url.Values for proper encoding
+	params := url.Values{}
+	params.Add("cql", cql)
+
+	if opts != nil {
+		if opts.Limit > 0 {
+			params.Add("limit", fmt.Sprintf("%d", opts.Limit))
+		} else {
+			params.Add("limit", "25")
+		}
+		if opts.Cursor != "" {
+			params.Add("cursor", opts.Cursor)
+		}
+		if opts.CqlContext != "" {
+			params.Add("cqlcontext", opts.CqlContext)
+		}
+		if opts.Expand != "" {
+			params.Add("expand", opts.Expand)
+		}
+		if opts.Next {
+			params.Add("next", "true")
+		}
+		if opts.Prev {
+			params.Add("prev", "true")
+		}
+	} else {
+		params.Add("limit", "25")
+	}
+
+	fullURL := baseURL + "?" + params.Encode()
+
+	resp, err := c.doRequest("GET", fullURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to search content (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var result map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+// SearchAllConfluenceCQL runs a CQL search and follows each response's next
+// link until there are no more results, returning every result in one
+// response whose size is the combined count. It fails if the API hands back
+// a cursor it already returned, rather than looping forever.
+func (c *Client) SearchAllConfluenceCQL(cql string, opts *SearchCQLOptions) (map[string]any, error) {
+	pageOpts := SearchCQLOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+
+	all := []any{}
+	seen := map[string]bool{}
+	var last map[string]any
+	for {
+		result, err := c.SearchConfluenceCQL(cql, &pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		last = result
+
+		results, _ := result["results"].([]any)
+		all = append(all, results...)
+
+		cursor := NextCursor(result)
+		if cursor == "" || len(results) == 0 {
+			break
+		}
+		if seen[cursor] {
+			return nil, fmt.Errorf("search returned cursor %q twice", cursor)
+		}
+		seen[cursor] = true
+		pageOpts.Cursor = cursor
+	}
+
+	merged := map[string]any{
+		"results": all,
+		"size":    len(all),
+	}
+	if totalSize, ok := last["totalSize"]; ok {
+		merged["totalSize"] = totalSize
+	}
+	return merged, nil
+}
+
+// NextCursor extracts the pagination cursor from a Confluence response's
+// _links.next URL. It returns an empty string when there are no more results.
+func NextCursor(result map[string]any) string {
+	links, _ := result["_links"].(map[string]any)
+	next, _ := links["next"].(string)
+	if next == "" {
+		return ""
+	}
+
+	parsed, err := url.Parse(next)
+	if err != nil {
+		return ""
+	}
+	return parsed.Query().Get("cursor")
+}
+
+// GetContentByLabelOptions contains parameters for finding labeled content
+type GetContentByLabelOptions struct {
+	Types  []string // Content types to include: page, blogpost, attachment
+	Limit  int
+	Cursor string
+}
+
+// GetContentByLabel finds all content across the instance tagged with a label
+func (c *Client) GetContentByLabel(label string, opts *GetContentByLabelOptions) (map[string]any, error) {
+	cql := fmt.Sprintf("label = %s", QuoteQueryValue(label))
+
+	searchOpts := &SearchCQLOptions{Expand: "space"}
+	if opts != nil {
+		if len(opts.Types) > 0 {
+			quoted := make([]string, len(opts.Types))
+			for i, t := range opts.Types {
+				quoted[i] = QuoteQueryValue(t)
+			}
+			cql += fmt.Sprintf(" AND type IN (%s)", strings.Join(quoted, ", "))
+		}
+		searchOpts.Limit = opts.Limit
+		searchOpts.Cursor = opts.Cursor
+	}
+
+	return c.SearchConfluenceCQL(cql, searchOpts)
+}
+
+// queryValueEscaper escapes backslashes and double quotes so neither can end the string early
+var queryValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// QuoteQueryValue wraps a value in double quotes for use in a JQL or CQL query
+func QuoteQueryValue(value string) string {
+	return `"` + queryValueEscaper.Replace(value) + `"`
+}
+
+// DefaultPageExpand lists the properties GetConfluencePage expands when no
+// expand list is given
+var DefaultPageExpand = []string{"body.storage", "version", "space", "history"}
+
+// GetPageOptions contains parameters for getting a page
+type GetPageOptions struct {
+	Status  string   // Page status: current, draft, archived, trashed
+	Version int      // Historical version number (0 for the current version)
+	Expand  []string // Properties to expand instead of DefaultPageExpand
+
+	// BodyFormat is the extra body representation to expand alongside
+	// storage: "view" for the rendered HTML, or "" for storage only
+	BodyFormat string
+}
+
+// GetConfluencePage retrieves a Confluence page by ID
+func (c *Client) GetConfluencePage(pageID string, opts *GetPageOptions) (map[string]any, error) {
+	baseURL := fmt.Sprintf("%s/wiki/rest/api/content/%s", c.BaseURL, pageID)
+
+	// Request body content expanded
+	params := url.Values{}
+	expand := DefaultPageExpand
+	if opts != nil && len(opts.Expand) > 0 {
+		expand = opts.Expand
+	}
+	if opts != nil && opts.BodyFormat != "" && opts.BodyFormat != "storage" {
+		expand = mergeLists(expand, []string{"body." + opts.BodyFormat})
+	}
+	params.Add("expand", strings.Join(expand, ","))
+
+	// Add status if specified (defaults to current if not specified)
+	if opts != nil && opts.Status != "" {
 		params.Add("status", opts.Status)
 	}
 
+	if opts != nil && opts.Version > 0 {
+		params.Add("version", fmt.Sprintf("%d", opts.Version))
+	}
+
 	fullURL := baseURL + "?" + params.Encode()
 
 	resp, err := c.doRequest("GET", fullURL, nil)
@@ -812,6 +1805,15 @@ func (c *Client) GetConfluencePage(pageID string, opts *GetPageOptions) (map[str
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound && opts != nil && opts.Version > 0 {
+		return nil, fmt.Errorf("version %d of page %s does not exist", opts.Version, pageID)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("%w (status %d): %s", ErrPageNotFound, resp.StatusCode, string(body))
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("failed to get page (status %d): %s", resp.StatusCode, string(body))
@@ -825,6 +1827,21 @@ func (c *Client) GetConfluencePage(pageID string, opts *GetPageOptions) (map[str
 	return result, nil
 }
 
+// ErrPageNotFound is returned when a Confluence page doesn't exist in the
+// requested status, or isn't visible to the user
+var ErrPageNotFound = errors.New("page not found")
+
+// mergeLists appends the items of extra that aren't already in base
+func mergeLists(base, extra []string) []string {
+	result := append([]string{}, base...)
+	for _, item := range extra {
+		if !slices.Contains(result, item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
 // GetSpacesOptions contains parameters for getting spaces
 type GetSpacesOptions struct {
 	Keys              []string
@@ -915,9 +1932,144 @@ func (c *Client) GetConfluenceSpaces(opts *GetSpacesOptions) (map[string]any, er
 	return result, nil
 }
 
+// errSpacePermissionsForbidden explains the 403 Confluence returns when a
+// non-admin asks for a space's permissions
+var errSpacePermissionsForbidden = errors.New("viewing space permissions requires space admin permission (status 403)")
+
+// GetSpacePermissionsOptions contains parameters for getting space permissions
+type GetSpacePermissionsOptions struct {
+	Limit  int
+	Cursor string
+}
+
+// GetSpacePermissions gets one page of permission assignments for a space.
+// Each result has a principal (user, group, or role ID) and an operation.
+func (c *Client) GetSpacePermissions(spaceID string, opts *GetSpacePermissionsOptions) (map[string]any, error) {
+	baseURL := fmt.Sprintf("%s/wiki/api/v2/spaces/%s/permissions", c.BaseURL, url.PathEscape(spaceID))
+
+	params := url.Values{}
+	if opts != nil {
+		if opts.Limit > 0 {
+			params.Add("limit", fmt.Sprintf("%d", opts.Limit))
+		}
+		if opts.Cursor != "" {
+			params.Add("cursor", opts.Cursor)
+		}
+	}
+
+	fullURL := baseURL
+	if len(params) > 0 {
+		fullURL += "?" + params.Encode()
+	}
+
+	resp, err := c.doRequest("GET", fullURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusForbidden {
+		return nil, errSpacePermissionsForbidden
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get space permissions (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var result map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetAllSpacePermissions follows the cursor through every page of a space's
+// permission assignments
+func (c *Client) GetAllSpacePermissions(spaceID string) ([]any, error) {
+	opts := &GetSpacePermissionsOptions{Limit: 250}
+	seen := map[string]bool{}
+
+	all := []any{}
+	for {
+		result, err := c.GetSpacePermissions(spaceID, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		results, _ := result["results"].([]any)
+		all = append(all, results...)
+
+		cursor := NextCursor(result)
+		if cursor == "" || len(results) == 0 {
+			break
+		}
+		if seen[cursor] {
+			return nil, fmt.Errorf("space permissions pagination returned cursor %q twice", cursor)
+		}
+		seen[cursor] = true
+		opts.Cursor = cursor
+	}
+
+	return all, nil
+}
+
+// GetConfluenceUser gets a Confluence user by account ID
+func (c *Client) GetConfluenceUser(accountID string) (map[string]any, error) {
+	apiURL := fmt.Sprintf("%s/wiki/rest/api/user?accountId=%s", c.BaseURL, url.QueryEscape(accountID))
+
+	resp, err := c.doRequest("GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get user (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var result map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetConfluenceGroup gets a Confluence group by group ID
+func (c *Client) GetConfluenceGroup(groupID string) (map[string]any, error) {
+	apiURL := fmt.Sprintf("%s/wiki/rest/api/group/by-id?id=%s", c.BaseURL, url.QueryEscape(groupID))
+
+	resp, err := c.doRequest("GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get group (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var result map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+// Confluence content types
+const (
+	ContentTypePage     = "page"
+	ContentTypeBlogPost = "blogpost"
+)
+
 // GetPagesInSpaceOptions contains parameters for getting pages in a space
 type GetPagesInSpaceOptions struct {
 	SpaceKey string
+	Type     string // Content type: page (default) or blogpost
 	Title    string
 	Status   string
 	Limit    int
@@ -931,8 +2083,13 @@ type GetPagesInSpaceOptions struct {
 func (c *Client) GetPagesInSpace(opts *GetPagesInSpaceOptions) (map[string]any, error) {
 	baseURL := fmt.Sprintf("%s/wiki/rest/api/content", c.BaseURL)
 
+	contentType := opts.Type
+	if contentType == "" {
+		contentType = ContentTypePage
+	}
+
 	params := url.Values{}
-	params.Add("type", "page")
+	params.Add("type", contentType)
 	params.Add("spaceKey", opts.SpaceKey)
 
 	if opts.Title != "" {
@@ -960,9 +2117,39 @@ func (c *Client) GetPagesInSpace(opts *GetPagesInSpaceOptions) (map[string]any,
 		params.Add("limit", "25")
 	}
 
-	fullURL := baseURL + "?" + params.Encode()
+	fullURL := baseURL + "?" + params.Encode()
+
+	resp, err := c.doRequest("GET", fullURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get pages (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var result map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
 
-	resp, err := c.doRequest("GET", fullURL, nil)
+// FindPageByTitle looks up the current page with an exact title in a space,
+// including its storage body, version, and ancestors. It returns nil if no
+// such page exists.
+func (c *Client) FindPageByTitle(spaceKey, title string) (map[string]any, error) {
+	params := url.Values{}
+	params.Add("type", "page")
+	params.Add("spaceKey", spaceKey)
+	params.Add("title", title)
+	params.Add("expand", "body.storage,version,ancestors")
+	apiURL := fmt.Sprintf("%s/wiki/rest/api/content?%s", c.BaseURL, params.Encode())
+
+	resp, err := c.doRequest("GET", apiURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -970,15 +2157,20 @@ func (c *Client) GetPagesInSpace(opts *GetPagesInSpaceOptions) (map[string]any,
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get pages (status %d): %s", resp.StatusCode, string(body))
+		return nil, fmt.Errorf("failed to find page (status %d): %s", resp.StatusCode, string(body))
 	}
 
-	var result map[string]any
+	var result struct {
+		Results []map[string]any `json:"results"`
+	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	return result, nil
+	if len(result.Results) == 0 {
+		return nil, nil
+	}
+	return result.Results[0], nil
 }
 
 // CreatePageOptions contains parameters for creating a page
@@ -988,14 +2180,24 @@ type CreatePageOptions struct {
 	Body      string
 	ParentID  string
 	IsPrivate bool
+	Type      string // Content type: page (default) or blogpost
 }
 
-// CreateConfluencePage creates a new Confluence page
+// CreateConfluencePage creates a new Confluence page or blog post. Blog
+// posts have no place in the page tree, so ParentID must be empty for them.
 func (c *Client) CreateConfluencePage(opts *CreatePageOptions) (map[string]any, error) {
 	apiURL := fmt.Sprintf("%s/wiki/rest/api/content", c.BaseURL)
 
+	contentType := opts.Type
+	if contentType == "" {
+		contentType = ContentTypePage
+	}
+	if contentType == ContentTypeBlogPost && opts.ParentID != "" {
+		return nil, fmt.Errorf("blog posts can't have a parent page")
+	}
+
 	body := map[string]any{
-		"type":  "page",
+		"type":  contentType,
 		"title": opts.Title,
 		"space": map[string]any{
 			"key": opts.SpaceKey,
@@ -1062,6 +2264,35 @@ type UpdatePageOptions struct {
 	SpaceKey       string
 	Status         string
 	VersionMessage string
+	MinorEdit      bool // Don't notify watchers about this version
+}
+
+// FillFromPage sets whichever of Title, Body, and Version are unset from the
+// page's current state, so an update only changes what was given
+func (opts *UpdatePageOptions) FillFromPage(page map[string]any) {
+	if opts.Title == "" {
+		opts.Title, _ = page["title"].(string)
+	}
+	if opts.Body == "" {
+		body, _ := page["body"].(map[string]any)
+		storage, _ := body["storage"].(map[string]any)
+		opts.Body, _ = storage["value"].(string)
+	}
+	if opts.Version == 0 {
+		opts.Version = NextPageVersion(page)
+	}
+}
+
+// NextPageVersion returns the version number an update to page must send:
+// the current version + 1 for published pages, or 1 for drafts, which don't
+// increment
+func NextPageVersion(page map[string]any) int {
+	if status, _ := page["status"].(string); status == "draft" {
+		return 1
+	}
+	version, _ := page["version"].(map[string]any)
+	number, _ := version["number"].(float64)
+	return int(number) + 1
 }
 
 // UpdateConfluencePage updates an existing Confluence page
@@ -1085,6 +2316,9 @@ func (c *Client) UpdateConfluencePage(opts *UpdatePageOptions) (map[string]any,
 	if opts.VersionMessage != "" {
 		body["version"].(map[string]any)["message"] = opts.VersionMessage
 	}
+	if opts.MinorEdit {
+		body["version"].(map[string]any)["minorEdit"] = true
+	}
 
 	if opts.ParentID != "" {
 		body["ancestors"] = []any{
@@ -1104,20 +2338,319 @@ func (c *Client) UpdateConfluencePage(opts *UpdatePageOptions) (map[string]any,
 		body["status"] = opts.Status
 	}
 
-	bodyJSON, err := json.Marshal(body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	bodyJSON, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest("PUT", apiURL, strings.NewReader(string(bodyJSON)))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to update page (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+// RestorePageOptions contains parameters for restoring a trashed page
+type RestorePageOptions struct {
+	PageID  string
+	Title   string
+	Version int  // The trashed page's current version number
+	ToRoot  bool // Restore to the top level of the space instead of the original parent
+}
+
+// RestoreTrashedPage moves a trashed page back to current status
+func (c *Client) RestoreTrashedPage(opts *RestorePageOptions) (map[string]any, error) {
+	apiURL := fmt.Sprintf("%s/wiki/rest/api/content/%s", c.BaseURL, url.PathEscape(opts.PageID))
+
+	body := map[string]any{
+		"type":   "page",
+		"title":  opts.Title,
+		"status": "current",
+		"version": map[string]any{
+			"number": opts.Version + 1,
+		},
+	}
+
+	if opts.ToRoot {
+		body["ancestors"] = []any{}
+	}
+
+	bodyJSON, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest("PUT", apiURL, strings.NewReader(string(bodyJSON)))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to restore page (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+// DeleteConfluencePage deletes a Confluence page. Deleting a current page
+// moves it to the trash; with purge set, an already-trashed page is removed
+// permanently.
+func (c *Client) DeleteConfluencePage(pageID string, purge bool) error {
+	apiURL := fmt.Sprintf("%s/wiki/rest/api/content/%s", c.BaseURL, url.PathEscape(pageID))
+	if purge {
+		apiURL += "?status=trashed"
+	}
+
+	resp, err := c.doRequest("DELETE", apiURL, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to delete page (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// GetPageWatchers gets everyone watching a Confluence page, following
+// pagination until all watchers have been fetched
+func (c *Client) GetPageWatchers(pageID string) ([]any, error) {
+	const limit = 100
+
+	all := []any{}
+	for start := 0; ; {
+		apiURL := fmt.Sprintf("%s/wiki/rest/api/content/%s/notification/created?start=%d&limit=%d", c.BaseURL, url.PathEscape(pageID), start, limit)
+
+		resp, err := c.doRequest("GET", apiURL, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			return nil, fmt.Errorf("failed to get page watchers (status %d): %s", resp.StatusCode, string(body))
+		}
+
+		var result map[string]any
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+
+		results, _ := result["results"].([]any)
+		all = append(all, results...)
+
+		if len(results) < limit {
+			return all, nil
+		}
+		start += len(results)
+	}
+}
+
+// WatchPage makes a user watch a Confluence page. An empty accountID means
+// the current user.
+func (c *Client) WatchPage(pageID, accountID string) error {
+	return c.setPageWatch("POST", pageID, accountID)
+}
+
+// UnwatchPage stops a user watching a Confluence page. An empty accountID
+// means the current user.
+func (c *Client) UnwatchPage(pageID, accountID string) error {
+	return c.setPageWatch("DELETE", pageID, accountID)
+}
+
+func (c *Client) setPageWatch(method, pageID, accountID string) error {
+	apiURL := fmt.Sprintf("%s/wiki/rest/api/user/watch/content/%s", c.BaseURL, url.PathEscape(pageID))
+	if accountID != "" {
+		apiURL += "?accountId=" + url.QueryEscape(accountID)
+	}
+
+	resp, err := c.doRequest(method, apiURL, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		action := "watch"
+		if method == "DELETE" {
+			action = "unwatch"
+		}
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to %s page (status %d): %s", action, resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// AddPageCommentOptions contains parameters for adding a comment to a page
+type AddPageCommentOptions struct {
+	PageID           string
+	Comment          string
+	ParentCommentID  string
+	AttachmentID     string
+	CustomContentID  string
+}
+
+// AddConfluencePageComment adds a comment to a Confluence page
+func (c *Client) AddConfluencePageComment(opts *AddPageCommentOptions) (map[string]any, error) {
+	apiURL := fmt.Sprintf("%s/wiki/rest/api/content", c.BaseURL)
+
+	body := map[string]any{
+		"type": "comment",
+		"container": map[string]any{
+			"id":   opts.PageID,
+			"type": "page",
+		},
+		"body": map[string]any{
+			"storage": map[string]any{
+				"value":          opts.Comment,
+				"representation": "storage",
+			},
+		},
+	}
+
+	// Add optional parameters
+	if opts.ParentCommentID != "" {
+		body["container"] = map[string]any{
+			"id":   opts.ParentCommentID,
+			"type": "comment",
+		}
+	}
+	// Note: attachmentId and customContentId may require different API structure
+
+	bodyJSON, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest("POST", apiURL, strings.NewReader(string(bodyJSON)))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to add comment (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetContentTemplate gets a Confluence page template, including its body in
+// storage format
+func (c *Client) GetContentTemplate(templateID string) (map[string]any, error) {
+	apiURL := fmt.Sprintf("%s/wiki/rest/api/template/%s", c.BaseURL, url.PathEscape(templateID))
+
+	resp, err := c.doRequest("GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("template %s not found", templateID)
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get template (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var result map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetPageAncestors gets the parent pages of a Confluence page
+func (c *Client) GetPageAncestors(pageID string) ([]map[string]any, error) {
+	baseURL := fmt.Sprintf("%s/wiki/rest/api/content/%s/ancestor", c.BaseURL, pageID)
+
+	resp, err := c.doRequest("GET", baseURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get ancestors (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var ancestors []map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&ancestors); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return ancestors, nil
+}
+
+// GetPageDescendantsOptions contains parameters for getting page descendants
+type GetPageDescendantsOptions struct {
+	Depth int
+	Limit int
+}
+
+// GetPageDescendants gets child pages of a Confluence page
+func (c *Client) GetPageDescendants(pageID string, opts *GetPageDescendantsOptions) (map[string]any, error) {
+	baseURL := fmt.Sprintf("%s/wiki/rest/api/content/%s/descendant/page", c.BaseURL, pageID)
+
+	params := url.Values{}
+	if opts != nil {
+		if opts.Depth > 0 {
+			params.Add("depth", fmt.Sprintf("%d", opts.Depth))
+		}
+		if opts.Limit > 0 {
+			params.Add("limit", fmt.Sprintf("%d", opts.Limit))
+		}
+	}
+
+	fullURL := baseURL
+	if len(params) > 0 {
+		fullURL += "?" + params.Encode()
 	}
 
-	resp, err := c.doRequest("PUT", apiURL, strings.NewReader(string(bodyJSON)))
+	resp, err := c.doRequest("GET", fullURL, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		respBody, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to update page (status %d): %s", resp.StatusCode, string(respBody))
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get descendants (status %d): %s", resp.StatusCode, string(body))
 	}
 
 	var result map[string]any
@@ -1128,56 +2661,105 @@ func (c *Client) UpdateConfluencePage(opts *UpdatePageOptions) (map[string]any,
 	return result, nil
 }
 
-// AddPageCommentOptions contains parameters for adding a comment to a page
-type AddPageCommentOptions struct {
-	PageID           string
-	Comment          string
-	ParentCommentID  string
-	AttachmentID     string
-	CustomContentID  string
+// PageLabel is a label on a Confluence page. Prefix is global for ordinary
+// labels, or my/team for personal and team labels.
+type PageLabel struct {
+	Prefix string `json:"prefix"`
+	Name   string `json:"name"`
 }
 
-// AddConfluencePageComment adds a comment to a Confluence page
-func (c *Client) AddConfluencePageComment(opts *AddPageCommentOptions) (map[string]any, error) {
-	apiURL := fmt.Sprintf("%s/wiki/rest/api/content", c.BaseURL)
+// ParsePageLabel parses a label given as name or prefix:name, defaulting the
+// prefix to global. Label names can't contain whitespace.
+func ParsePageLabel(s string) (PageLabel, error) {
+	label := PageLabel{Prefix: "global", Name: strings.TrimSpace(s)}
+	if prefix, name, ok := strings.Cut(label.Name, ":"); ok {
+		switch prefix {
+		case "global", "my", "team":
+			label.Prefix, label.Name = prefix, name
+		}
+	}
 
-	body := map[string]any{
-		"type": "comment",
-		"container": map[string]any{
-			"id":   opts.PageID,
-			"type": "page",
-		},
-		"body": map[string]any{
-			"storage": map[string]any{
-				"value":          opts.Comment,
-				"representation": "storage",
-			},
-		},
+	if label.Name == "" {
+		return PageLabel{}, fmt.Errorf("invalid label %q: name is empty", s)
+	}
+	if strings.ContainsFunc(label.Name, unicode.IsSpace) {
+		return PageLabel{}, fmt.Errorf("invalid label %q: labels can't contain spaces", s)
 	}
+	return label, nil
+}
 
-	// Add optional parameters
-	if opts.ParentCommentID != "" {
-		body["container"] = map[string]any{
-			"id":   opts.ParentCommentID,
-			"type": "comment",
+// GetPageLabels gets every label on a Confluence page, following the
+// _links.next URL until all labels have been fetched
+func (c *Client) GetPageLabels(pageID string) (map[string]any, error) {
+	apiURL := fmt.Sprintf("%s/wiki/rest/api/content/%s/label?limit=200", c.BaseURL, url.PathEscape(pageID))
+
+	all := []any{}
+	seen := map[string]bool{}
+	for {
+		resp, err := c.doRequest("GET", apiURL, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			return nil, fmt.Errorf("failed to get labels (status %d): %s", resp.StatusCode, string(body))
+		}
+
+		var result map[string]any
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+
+		results, _ := result["results"].([]any)
+		all = append(all, results...)
+
+		links, _ := result["_links"].(map[string]any)
+		next, _ := links["next"].(string)
+		if next == "" || len(results) == 0 {
+			break
+		}
+		if seen[next] {
+			return nil, fmt.Errorf("labels returned next link %q twice", next)
+		}
+		seen[next] = true
+
+		// The v1 API's next links are relative to the /wiki context path
+		if strings.HasPrefix(next, "/wiki/") {
+			apiURL = c.BaseURL + next
+		} else {
+			apiURL = c.BaseURL + "/wiki" + next
 		}
 	}
-	// Note: attachmentId and customContentId may require different API structure
 
-	bodyJSON, err := json.Marshal(body)
+	return map[string]any{
+		"results": all,
+		"size":    len(all),
+	}, nil
+}
+
+// AddPageLabels adds labels to a Confluence page and returns the page's
+// labels afterwards. Labels the page already has are left as they are.
+func (c *Client) AddPageLabels(pageID string, labels []PageLabel) (map[string]any, error) {
+	apiURL := fmt.Sprintf("%s/wiki/rest/api/content/%s/label", c.BaseURL, url.PathEscape(pageID))
+
+	bodyJSON, err := json.Marshal(labels)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	resp, err := c.doRequest("POST", apiURL, strings.NewReader(string(bodyJSON)))
+	resp, err := c.doRequest("POST", apiURL, bytes.NewReader(bodyJSON))
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		respBody, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to add comment (status %d): %s", resp.StatusCode, string(respBody))
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to add labels (status %d): %s", resp.StatusCode, string(body))
 	}
 
 	var result map[string]any
@@ -1188,55 +2770,99 @@ func (c *Client) AddConfluencePageComment(opts *AddPageCommentOptions) (map[stri
 	return result, nil
 }
 
-// GetPageAncestors gets the parent pages of a Confluence page
-func (c *Client) GetPageAncestors(pageID string) ([]map[string]any, error) {
-	baseURL := fmt.Sprintf("%s/wiki/rest/api/content/%s/ancestor", c.BaseURL, pageID)
+// RemovePageLabel removes a label from a Confluence page
+func (c *Client) RemovePageLabel(pageID, name string) error {
+	apiURL := fmt.Sprintf("%s/wiki/rest/api/content/%s/label/%s", c.BaseURL, url.PathEscape(pageID), url.PathEscape(name))
 
-	resp, err := c.doRequest("GET", baseURL, nil)
+	resp, err := c.doRequest("DELETE", apiURL, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to remove label %s (status %d): %s", name, resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// AddPageAttachmentOptions contains parameters for uploading a file to a
+// Confluence page
+type AddPageAttachmentOptions struct {
+	Comment   string // Version comment shown in the attachment history
+	MinorEdit bool   // Don't notify page watchers
+}
+
+// AddPageAttachment uploads a file to a Confluence page. It uses the create
+// or update endpoint, so uploading a filename the page already has adds a
+// new version of that attachment rather than failing.
+func (c *Client) AddPageAttachment(pageID, filePath string, opts *AddPageAttachmentOptions) (map[string]any, error) {
+	apiURL := fmt.Sprintf("%s/wiki/rest/api/content/%s/child/attachment", c.BaseURL, url.PathEscape(pageID))
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file %s: %w", filePath, err)
+	}
+	defer f.Close()
+
+	fileName := filepath.Base(filePath)
+
+	fields := map[string]string{}
+	if opts != nil {
+		if opts.Comment != "" {
+			fields["comment"] = opts.Comment
+		}
+		if opts.MinorEdit {
+			fields["minorEdit"] = "true"
+		}
+	}
+
+	resp, err := c.doMultipartForm("PUT", apiURL, "file", fileName, f, fields)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusRequestEntityTooLarge {
+		return nil, fmt.Errorf("%s is larger than the site's attachment size limit", fileName)
+	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get ancestors (status %d): %s", resp.StatusCode, string(body))
+		return nil, fmt.Errorf("failed to add attachment (status %d): %s", resp.StatusCode, string(body))
 	}
 
-	var ancestors []map[string]any
-	if err := json.NewDecoder(resp.Body).Decode(&ancestors); err != nil {
+	var result map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	return ancestors, nil
+	return result, nil
 }
 
-// GetPageDescendantsOptions contains parameters for getting page descendants
-type GetPageDescendantsOptions struct {
-	Depth int
+// GetPageAttachmentsOptions contains parameters for listing page attachments
+type GetPageAttachmentsOptions struct {
 	Limit int
+	Start int
 }
 
-// GetPageDescendants gets child pages of a Confluence page
-func (c *Client) GetPageDescendants(pageID string, opts *GetPageDescendantsOptions) (map[string]any, error) {
-	baseURL := fmt.Sprintf("%s/wiki/rest/api/content/%s/descendant/page", c.BaseURL, pageID)
+// GetPageAttachments lists the attachments on a Confluence page
+func (c *Client) GetPageAttachments(pageID string, opts *GetPageAttachmentsOptions) (map[string]any, error) {
+	baseURL := fmt.Sprintf("%s/wiki/rest/api/content/%s/child/attachment", c.BaseURL, url.PathEscape(pageID))
 
 	params := url.Values{}
+	params.Add("expand", "version")
 	if opts != nil {
-		if opts.Depth > 0 {
-			params.Add("depth", fmt.Sprintf("%d", opts.Depth))
-		}
 		if opts.Limit > 0 {
 			params.Add("limit", fmt.Sprintf("%d", opts.Limit))
 		}
+		if opts.Start > 0 {
+			params.Add("start", fmt.Sprintf("%d", opts.Start))
+		}
 	}
 
-	fullURL := baseURL
-	if len(params) > 0 {
-		fullURL += "?" + params.Encode()
-	}
-
-	resp, err := c.doRequest("GET", fullURL, nil)
+	resp, err := c.doRequest("GET", baseURL+"?"+params.Encode(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1244,7 +2870,7 @@ func (c *Client) GetPageDescendants(pageID string, opts *GetPageDescendantsOptio
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get descendants (status %d): %s", resp.StatusCode, string(body))
+		return nil, fmt.Errorf("failed to get attachments (status %d): %s", resp.StatusCode, string(body))
 	}
 
 	var result map[string]any
@@ -1257,9 +2883,11 @@ func (c *Client) GetPageDescendants(pageID string, opts *GetPageDescendantsOptio
 
 // GetPageCommentsOptions contains parameters for getting page comments
 type GetPageCommentsOptions struct {
-	Limit  int
-	Start  int
-	Status string
+	Limit    int
+	Start    int
+	Status   string
+	Location string // "inline", "footer", or "resolved"; empty for all
+	Expand   string
 }
 
 // GetPageComments gets comments for a Confluence page
@@ -1277,6 +2905,12 @@ func (c *Client) GetPageComments(pageID string, opts *GetPageCommentsOptions) (m
 		if opts.Status != "" {
 			params.Add("status", opts.Status)
 		}
+		if opts.Location != "" {
+			params.Add("location", opts.Location)
+		}
+		if opts.Expand != "" {
+			params.Add("expand", opts.Expand)
+		}
 	}
 
 	fullURL := baseURL
@@ -1303,6 +2937,49 @@ func (c *Client) GetPageComments(pageID string, opts *GetPageCommentsOptions) (m
 	return result, nil
 }
 
+// GetAllPageComments gets every comment on a Confluence page by following
+// pagination until a short page or no next link is returned. The combined
+// comments are returned under "results" with "size" set to the true total.
+func (c *Client) GetAllPageComments(pageID string, opts *GetPageCommentsOptions) (map[string]any, error) {
+	pageOpts := GetPageCommentsOptions{Limit: 25}
+	if opts != nil {
+		pageOpts = *opts
+		if pageOpts.Limit <= 0 {
+			pageOpts.Limit = 25
+		}
+	}
+
+	var all []any
+	for {
+		result, err := c.GetPageComments(pageID, &pageOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		results, _ := result["results"].([]any)
+		all = append(all, results...)
+
+		links, _ := result["_links"].(map[string]any)
+		next, _ := links["next"].(string)
+		if len(results) < pageOpts.Limit && next == "" {
+			break
+		}
+		if len(results) == 0 {
+			break
+		}
+		pageOpts.Start += len(results)
+	}
+
+	if all == nil {
+		all = []any{}
+	}
+
+	return map[string]any{
+		"results": all,
+		"size":    len(all),
+	}, nil
+}
+
 // CreateInlineCommentOptions contains parameters for creating an inline comment
 type CreateInlineCommentOptions struct {
 	PageID                   string
@@ -1367,9 +3044,40 @@ func (c *Client) CreateInlineComment(opts *CreateInlineCommentOptions) (map[stri
 	return result, nil
 }
 
-// GetCreateMeta gets field metadata for creating issues of a specific type
+// GetCreateMeta gets field metadata for creating issues of a specific type.
+// The endpoint pages its fields, so every page is fetched and the fields are
+// combined under "fields"; the other keys come from the first page.
 func (c *Client) GetCreateMeta(projectKey string, issueTypeID string) (map[string]any, error) {
-	apiURL := fmt.Sprintf("%s/rest/api/3/issue/createmeta/%s/issuetypes/%s", c.BaseURL, projectKey, issueTypeID)
+	var result map[string]any
+	var fields []any
+	for {
+		page, err := c.getCreateMetaPage(projectKey, issueTypeID, len(fields))
+		if err != nil {
+			return nil, err
+		}
+		if result == nil {
+			result = page
+		}
+
+		pageFields, _ := page["fields"].([]any)
+		fields = append(fields, pageFields...)
+
+		total, hasTotal := page["total"].(float64)
+		if len(pageFields) == 0 || !hasTotal || len(fields) >= int(total) {
+			break
+		}
+	}
+
+	result["fields"] = fields
+	return result, nil
+}
+
+// getCreateMetaPage gets one page of create metadata fields starting at startAt
+func (c *Client) getCreateMetaPage(projectKey string, issueTypeID string, startAt int) (map[string]any, error) {
+	apiURL := fmt.Sprintf("%s/rest/api/3/issue/createmeta/%s/issuetypes/%s", c.BaseURL, url.PathEscape(projectKey), url.PathEscape(issueTypeID))
+	if startAt > 0 {
+		apiURL += fmt.Sprintf("?startAt=%d", startAt)
+	}
 
 	resp, err := c.doRequest("GET", apiURL, nil)
 	if err != nil {
@@ -1401,24 +3109,10 @@ func (c *Client) GetFieldOptions(fieldKey string, projectKey string, issueTypeID
 		return nil, fmt.Errorf("issue type ID is required to get field options")
 	}
 
-	// Get createmeta for this issue type
-	createMetaURL := fmt.Sprintf("%s/rest/api/3/issue/createmeta/%s/issuetypes/%s", c.BaseURL, projectKey, issueTypeID)
-
-	resp2, err := c.doRequest("GET", createMetaURL, nil)
+	createMetaResult, err := c.GetCreateMeta(projectKey, issueTypeID)
 	if err != nil {
 		return nil, err
 	}
-	defer resp2.Body.Close()
-
-	if resp2.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp2.Body)
-		return nil, fmt.Errorf("failed to get create metadata (status %d): %s", resp2.StatusCode, string(body))
-	}
-
-	var createMetaResult map[string]any
-	if err := json.NewDecoder(resp2.Body).Decode(&createMetaResult); err != nil {
-		return nil, fmt.Errorf("failed to decode create metadata response: %w", err)
-	}
 
 	// Search for the field in the fields array
 	fieldsArray, _ := createMetaResult["fields"].([]any)
@@ -1467,6 +3161,68 @@ func (c *Client) GetIssueLinkTypes() ([]IssueLinkType, error) {
 	return result.IssueLinkTypes, nil
 }
 
+// JiraField describes a system or custom Jira field
+type JiraField struct {
+	ID     string `json:"id"`
+	Key    string `json:"key"`
+	Name   string `json:"name"`
+	Custom bool   `json:"custom"`
+}
+
+// GetFields lists every system and custom field on the site
+func (c *Client) GetFields() ([]JiraField, error) {
+	apiURL := fmt.Sprintf("%s/rest/api/3/field", c.BaseURL)
+
+	resp, err := c.doRequest("GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get fields (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var fields []JiraField
+	if err := json.NewDecoder(resp.Body).Decode(&fields); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return fields, nil
+}
+
+// FindField looks up a field by ID (customfield_10016) or by display name
+// ("Story Points", case-insensitive). Names shared by several fields are
+// rejected so the caller can ask for the ID instead.
+func FindField(fields []JiraField, nameOrID string) (*JiraField, error) {
+	for i := range fields {
+		if fields[i].ID == nameOrID {
+			return &fields[i], nil
+		}
+	}
+
+	var matches []*JiraField
+	for i := range fields {
+		if strings.EqualFold(fields[i].Name, nameOrID) {
+			matches = append(matches, &fields[i])
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no field named '%s'", nameOrID)
+	case 1:
+		return matches[0], nil
+	}
+
+	ids := make([]string, len(matches))
+	for i, field := range matches {
+		ids[i] = field.ID
+	}
+	return nil, fmt.Errorf("several fields are named '%s' (%s); use the field ID instead", nameOrID, strings.Join(ids, ", "))
+}
+
 // LinkIssueOptions contains options for linking issues
 type LinkIssueOptions struct {
 	TypeName      string
@@ -1589,48 +3345,97 @@ func (c *Client) DeleteIssueLink(linkID string) error {
 
 // doMultipartUpload performs a multipart form file upload with authentication
 func (c *Client) doMultipartUpload(url string, fieldName string, fileName string, fileReader io.Reader) (*http.Response, error) {
-	var buf bytes.Buffer
-	writer := multipart.NewWriter(&buf)
+	return c.doMultipartForm("POST", url, fieldName, fileName, fileReader, nil)
+}
 
+// doMultipartForm sends a file as a multipart form along with extra text
+// form fields. The form is streamed rather than buffered, so uploads are
+// never retried: replaying one after a gateway error could attach the file
+// twice.
+func (c *Client) doMultipartForm(method, url, fieldName, fileName string, fileReader io.Reader, fields map[string]string) (*http.Response, error) {
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+
+	go func() {
+		pw.CloseWithError(writeMultipartForm(writer, fieldName, fileName, fileReader, fields))
+	}()
+
+	resp, err := c.sendRequest(method, url, writer.FormDataContentType(), pr)
+	// Unblock the writer if the request ended before reading the whole form
+	pr.Close()
+	return resp, err
+}
+
+// writeMultipartForm writes the file part and text fields, then closes the
+// form
+func writeMultipartForm(writer *multipart.Writer, fieldName, fileName string, fileReader io.Reader, fields map[string]string) error {
 	part, err := writer.CreateFormFile(fieldName, fileName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create form file: %w", err)
+		return fmt.Errorf("failed to create form file: %w", err)
 	}
 
 	if _, err := io.Copy(part, fileReader); err != nil {
-		return nil, fmt.Errorf("failed to copy file data: %w", err)
+		return fmt.Errorf("failed to copy file data: %w", err)
 	}
 
-	if err := writer.Close(); err != nil {
-		return nil, fmt.Errorf("failed to close multipart writer: %w", err)
+	for name, value := range fields {
+		if err := writer.WriteField(name, value); err != nil {
+			return fmt.Errorf("failed to write form field %s: %w", name, err)
+		}
 	}
 
-	req, err := http.NewRequest("POST", url, &buf)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to close multipart writer: %w", err)
 	}
+	return nil
+}
 
-	req.Header.Set("Authorization", c.basicAuth())
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", writer.FormDataContentType())
-	req.Header.Set("X-Atlassian-Token", "no-check")
+// Attachment represents a Jira attachment
+type Attachment struct {
+	ID        string            `json:"id"`
+	Filename  string            `json:"filename"`
+	MimeType  string            `json:"mimeType"`
+	Size      int64             `json:"size"`
+	Content   string            `json:"content"`   // download URL
+	Thumbnail string            `json:"thumbnail"` // thumbnail URL
+	Created   string            `json:"created,omitempty"`
+	Author    *AttachmentAuthor `json:"author,omitempty"`
+}
+
+// AttachmentAuthor is the user who uploaded an attachment
+type AttachmentAuthor struct {
+	AccountID   string `json:"accountId"`
+	DisplayName string `json:"displayName"`
+}
 
-	resp, err := c.client.Do(req)
+// GetAttachment retrieves an attachment's metadata by ID
+func (c *Client) GetAttachment(attachmentID string) (*Attachment, error) {
+	apiURL := fmt.Sprintf("%s/rest/api/3/attachment/%s", c.BaseURL, url.PathEscape(attachmentID))
+
+	resp, err := c.doRequest("GET", apiURL, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get attachment (status %d): %s", resp.StatusCode, string(body))
 	}
 
-	return resp, nil
-}
+	// This endpoint returns the ID as a number, unlike the issue's attachment
+	// field which returns a string
+	var metadata struct {
+		Attachment
+		ID json.Number `json:"id"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&metadata); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
 
-// Attachment represents a Jira attachment
-type Attachment struct {
-	ID        string `json:"id"`
-	Filename  string `json:"filename"`
-	MimeType  string `json:"mimeType"`
-	Size      int64  `json:"size"`
-	Content   string `json:"content"`   // download URL
-	Thumbnail string `json:"thumbnail"` // thumbnail URL
+	attachment := metadata.Attachment
+	attachment.ID = metadata.ID.String()
+	return &attachment, nil
 }
 
 // AddAttachment uploads a file attachment to a Jira issue
@@ -1651,6 +3456,9 @@ func (c *Client) AddAttachment(issueKey string, filePath string) ([]Attachment,
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusRequestEntityTooLarge {
+		return nil, fmt.Errorf("%s is larger than the site's attachment size limit", fileName)
+	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("failed to add attachment (status %d): %s", resp.StatusCode, string(body))
@@ -1664,6 +3472,44 @@ func (c *Client) AddAttachment(issueKey string, filePath string) ([]Attachment,
 	return attachments, nil
 }
 
+// DownloadAttachment streams an attachment's content to w and returns the
+// number of bytes written. Unlike other requests there is no overall timeout,
+// so large files aren't cut off mid-transfer.
+func (c *Client) DownloadAttachment(attachment *Attachment, w io.Writer) (int64, error) {
+	contentURL := attachment.Content
+	if contentURL == "" {
+		contentURL = fmt.Sprintf("%s/rest/api/3/attachment/content/%s", c.BaseURL, url.PathEscape(attachment.ID))
+	}
+
+	req, err := http.NewRequestWithContext(c.context(), "GET", contentURL, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", c.basicAuth())
+	req.Header.Set("Accept", "*/*")
+	c.applyHeaders(req)
+
+	downloadClient := &http.Client{Transport: c.client.Transport}
+	resp, err := downloadClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return 0, fmt.Errorf("failed to download attachment (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	n, err := io.Copy(w, resp.Body)
+	if err != nil {
+		return n, fmt.Errorf("failed to download attachment: %w", err)
+	}
+
+	return n, nil
+}
+
 // mediaIDRegexp extracts UUID from Atlassian media URLs
 var mediaIDRegexp = regexp.MustCompile(`/file/([0-9a-f-]{36})/`)
 
@@ -1672,19 +3518,20 @@ var mediaIDRegexp = regexp.MustCompile(`/file/([0-9a-f-]{36})/`)
 func (c *Client) GetAttachmentMediaID(attachment *Attachment) (string, error) {
 	// Create a client that doesn't follow redirects
 	noRedirectClient := &http.Client{
-		Timeout: 30 * time.Second,
+		Timeout: c.client.Timeout,
 		CheckRedirect: func(req *http.Request, via []*http.Request) error {
 			return http.ErrUseLastResponse
 		},
 	}
 
-	req, err := http.NewRequest("GET", attachment.Content, nil)
+	req, err := http.NewRequestWithContext(c.context(), "GET", attachment.Content, nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Authorization", c.basicAuth())
 	req.Header.Set("Accept", "application/json")
+	c.applyHeaders(req)
 
 	resp, err := noRedirectClient.Do(req)
 	if err != nil {
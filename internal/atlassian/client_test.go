@@ -1,13 +1,16 @@
 package atlassian
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestNewClient(t *testing.T) {
@@ -275,6 +278,88 @@ func TestCreateJiraIssue_Success(t *testing.T) {
 	}
 }
 
+func TestCreateJiraIssue_MarkdownDescription(t *testing.T) {
+	var fields map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var requestBody map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+		fields, _ = requestBody["fields"].(map[string]any)
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]any{"key": "ABC-125"})
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	opts := &CreateIssueOptions{
+		ProjectKey:  "ABC",
+		IssueType:   "Task",
+		Summary:     "New Task",
+		Description: "# Heading\n\nSome **bold** text",
+	}
+	if _, err := client.CreateJiraIssue(opts); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	description, _ := fields["description"].(map[string]any)
+	content, _ := description["content"].([]any)
+	if len(content) == 0 {
+		t.Fatalf("Expected ADF description content, got %v", fields["description"])
+	}
+	first, _ := content[0].(map[string]any)
+	if first["type"] != "heading" {
+		t.Errorf("Expected first node to be a heading, got %v", first["type"])
+	}
+
+	// No description means no description field at all
+	opts.Description = ""
+	if _, err := client.CreateJiraIssue(opts); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, ok := fields["description"]; ok {
+		t.Errorf("Expected no description field, got %v", fields["description"])
+	}
+}
+
+func TestCreateJiraIssue_DescriptionADF(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var requestBody map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+		fields, _ := requestBody["fields"].(map[string]any)
+		description, _ := fields["description"].(map[string]any)
+		content, _ := description["content"].([]any)
+		if description["type"] != "doc" || len(content) != 1 {
+			t.Errorf("Expected raw ADF description, got %v", fields["description"])
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]any{"key": "ABC-125"})
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	opts := &CreateIssueOptions{
+		ProjectKey: "ABC",
+		IssueType:  "Task",
+		Summary:    "New Task",
+		DescriptionADF: map[string]any{
+			"type":    "doc",
+			"version": 1,
+			"content": []any{map[string]any{"type": "rule"}},
+		},
+	}
+
+	if _, err := client.CreateJiraIssue(opts); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
 func TestSearchJiraIssuesJQL_Success(t *testing.T) {
 	// Create mock server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -375,6 +460,341 @@ func TestGetConfluencePage_Success(t *testing.T) {
 	}
 }
 
+func TestGetConfluencePage_ReplacesExpand(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		expected := "body.storage,version,metadata.labels"
+		if expand := r.URL.Query().Get("expand"); expand != expected {
+			t.Errorf("Expected expand %q, got %q", expected, expand)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"123"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	_, err := client.GetConfluencePage("123", &GetPageOptions{Expand: []string{"body.storage", "version", "metadata.labels"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestGetConfluencePage_Status(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   string
+		expected string
+	}{
+		{"Draft", "draft", "draft"},
+		{"Any", "any", "any"},
+		{"Default", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if status := r.URL.Query().Get("status"); status != tt.expected {
+					t.Errorf("Expected status %q, got %q", tt.expected, status)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"id":"123"}`))
+			}))
+			defer server.Close()
+
+			client := NewClient("user@example.com", "token", server.URL)
+
+			if _, err := client.GetConfluencePage("123", &GetPageOptions{Status: tt.status}); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGetConfluencePage_BodyFormat(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		expected := "body.storage,version,space,history,body.view"
+		if expand := r.URL.Query().Get("expand"); expand != expected {
+			t.Errorf("Expected expand %q, got %q", expected, expand)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"123","body":{"view":{"value":"<p>Rendered</p>"}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	page, err := client.GetConfluencePage("123", &GetPageOptions{BodyFormat: "view"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	body, _ := page["body"].(map[string]any)
+	if _, ok := body["view"]; !ok {
+		t.Errorf("Expected a view body, got %v", page["body"])
+	}
+}
+
+func TestGetConfluencePage_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	if _, err := client.GetConfluencePage("123", nil); !errors.Is(err, ErrPageNotFound) {
+		t.Errorf("Expected ErrPageNotFound, got %v", err)
+	}
+}
+
+func TestRestoreTrashedPage(t *testing.T) {
+	tests := []struct {
+		name          string
+		toRoot        bool
+		wantAncestors bool
+	}{
+		{"original parent", false, false},
+		{"space root", true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "PUT" || r.URL.Path != "/wiki/rest/api/content/123" {
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				var body map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Fatalf("Failed to decode request: %v", err)
+				}
+				if body["status"] != "current" || body["title"] != "Old page" {
+					t.Errorf("Expected status current and the page title, got %v", body)
+				}
+				if version, _ := body["version"].(map[string]any); version["number"] != float64(4) {
+					t.Errorf("Expected version 4, got %v", body["version"])
+				}
+				ancestors, ok := body["ancestors"].([]any)
+				if ok != tt.wantAncestors || len(ancestors) != 0 {
+					t.Errorf("Expected empty ancestors %v, got %v", tt.wantAncestors, body["ancestors"])
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"id":"123","status":"current"}`))
+			}))
+			defer server.Close()
+
+			client := NewClient("user@example.com", "token", server.URL)
+
+			result, err := client.RestoreTrashedPage(&RestorePageOptions{PageID: "123", Title: "Old page", Version: 3, ToRoot: tt.toRoot})
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if result["status"] != "current" {
+				t.Errorf("Expected status current, got %v", result["status"])
+			}
+		})
+	}
+}
+
+func TestDeleteConfluencePage(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		requests = append(requests, r.URL.RequestURI())
+		if r.URL.Path == "/wiki/rest/api/content/404" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"No content found with id 404"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	if err := client.DeleteConfluencePage("123", false); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := client.DeleteConfluencePage("123", true); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []string{"/wiki/rest/api/content/123", "/wiki/rest/api/content/123?status=trashed"}
+	if strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected requests %v, got %v", expected, requests)
+	}
+
+	err := client.DeleteConfluencePage("404", false)
+	if err == nil || !strings.Contains(err.Error(), "No content found") {
+		t.Errorf("Expected error with the API message, got %v", err)
+	}
+}
+
+func TestNextPageVersion(t *testing.T) {
+	published := map[string]any{"status": "current", "version": map[string]any{"number": float64(7)}}
+	if got := NextPageVersion(published); got != 8 {
+		t.Errorf("Expected 8 for a published page, got %d", got)
+	}
+
+	draft := map[string]any{"status": "draft", "version": map[string]any{"number": float64(3)}}
+	if got := NextPageVersion(draft); got != 1 {
+		t.Errorf("Expected 1 for a draft, got %d", got)
+	}
+}
+
+func TestUpdatePageOptions_FillFromPage(t *testing.T) {
+	page := map[string]any{
+		"title":   "Current title",
+		"status":  "current",
+		"version": map[string]any{"number": float64(4)},
+		"body":    map[string]any{"storage": map[string]any{"value": "<p>Stored body</p>"}},
+	}
+
+	// Updating only the title keeps the stored body
+	opts := &UpdatePageOptions{Title: "New title"}
+	opts.FillFromPage(page)
+	if opts.Title != "New title" || opts.Body != "<p>Stored body</p>" || opts.Version != 5 {
+		t.Errorf("Expected new title with stored body at version 5, got %+v", opts)
+	}
+
+	// Updating only the body keeps the current title, and an explicit version
+	// is left alone
+	opts = &UpdatePageOptions{Body: "<p>New body</p>", Version: 9}
+	opts.FillFromPage(page)
+	if opts.Title != "Current title" || opts.Body != "<p>New body</p>" || opts.Version != 9 {
+		t.Errorf("Expected current title with new body at version 9, got %+v", opts)
+	}
+}
+
+func TestUpdateConfluencePage_MinorEdit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		version, _ := body["version"].(map[string]any)
+		if version["number"] != float64(8) || version["minorEdit"] != true {
+			t.Errorf("Expected version 8 with minorEdit, got %v", version)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"123","version":{"number":8}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	_, err := client.UpdateConfluencePage(&UpdatePageOptions{PageID: "123", Title: "T", Body: "<p>x</p>", Version: 8, MinorEdit: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestParsePageLabel(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected PageLabel
+	}{
+		{"runbook", PageLabel{Prefix: "global", Name: "runbook"}},
+		{"my:review", PageLabel{Prefix: "my", Name: "review"}},
+		{"team:infra", PageLabel{Prefix: "team", Name: "infra"}},
+		{"v2:beta", PageLabel{Prefix: "global", Name: "v2:beta"}},
+	}
+	for _, tt := range tests {
+		label, err := ParsePageLabel(tt.input)
+		if err != nil {
+			t.Errorf("%q: expected no error, got %v", tt.input, err)
+		} else if label != tt.expected {
+			t.Errorf("%q: expected %+v, got %+v", tt.input, tt.expected, label)
+		}
+	}
+
+	for _, input := range []string{"", "my:", "two words"} {
+		if _, err := ParsePageLabel(input); err == nil {
+			t.Errorf("%q: expected error", input)
+		}
+	}
+}
+
+func TestPageLabels(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch r.Method {
+		case "POST":
+			var labels []PageLabel
+			if err := json.NewDecoder(r.Body).Decode(&labels); err != nil {
+				t.Fatalf("Failed to decode request: %v", err)
+			}
+			if len(labels) != 2 || labels[0].Prefix != "global" || labels[1].Name != "oncall" {
+				t.Errorf("Unexpected labels %+v", labels)
+			}
+			w.Write([]byte(`{"results":[{"prefix":"global","name":"runbook"},{"prefix":"global","name":"oncall"}]}`))
+		case "GET":
+			w.Write([]byte(`{"results":[{"prefix":"global","name":"runbook"}]}`))
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	labels := []PageLabel{{Prefix: "global", Name: "runbook"}, {Prefix: "global", Name: "oncall"}}
+	if _, err := client.AddPageLabels("123", labels); err != nil {
+		t.Fatalf("AddPageLabels: expected no error, got %v", err)
+	}
+	result, err := client.GetPageLabels("123")
+	if err != nil {
+		t.Fatalf("GetPageLabels: expected no error, got %v", err)
+	}
+	if results, _ := result["results"].([]any); len(results) != 1 {
+		t.Errorf("Expected 1 label, got %v", result["results"])
+	}
+	if err := client.RemovePageLabel("123", "runbook"); err != nil {
+		t.Fatalf("RemovePageLabel: expected no error, got %v", err)
+	}
+
+	expected := []string{
+		"POST /wiki/rest/api/content/123/label",
+		"GET /wiki/rest/api/content/123/label",
+		"DELETE /wiki/rest/api/content/123/label/runbook",
+	}
+	if strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestGetPageLabels_FollowsNextLink(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RequestURI())
+		if r.URL.Query().Get("start") == "" {
+			w.Write([]byte(`{"results":[{"name":"a"},{"name":"b"}],"size":2,"_links":{"next":"/rest/api/content/123/label?limit=200&start=2"}}`))
+			return
+		}
+		w.Write([]byte(`{"results":[{"name":"c"}],"size":1,"_links":{}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+	result, err := client.GetPageLabels("123")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	results, _ := result["results"].([]any)
+	if len(results) != 3 || result["size"] != 3 {
+		t.Errorf("Expected 3 labels, got %v", result)
+	}
+	expected := []string{
+		"/wiki/rest/api/content/123/label?limit=200",
+		"/wiki/rest/api/content/123/label?limit=200&start=2",
+	}
+	if strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected requests %v, got %v", expected, requests)
+	}
+}
+
 func TestGetConfluenceSpaces_Success(t *testing.T) {
 	// Create mock server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -520,6 +940,37 @@ func TestGetCreateMeta_Success(t *testing.T) {
 	}
 }
 
+func TestGetCreateMeta_FollowsPagination(t *testing.T) {
+	var starts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		starts = append(starts, r.URL.Query().Get("startAt"))
+		if r.URL.Query().Get("startAt") == "" {
+			w.Write([]byte(`{"startAt":0,"maxResults":2,"total":3,"fields":[{"key":"summary"},{"key":"description"}]}`))
+			return
+		}
+		w.Write([]byte(`{"startAt":2,"maxResults":2,"total":3,"fields":[{"key":"customfield_10001","required":true}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	metadata, err := client.GetCreateMeta("ABC", "10002")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if fields, _ := metadata["fields"].([]any); len(fields) != 3 {
+		t.Errorf("Expected fields from both pages, got %v", metadata["fields"])
+	}
+	if strings.Join(starts, ",") != ",2" {
+		t.Errorf("Expected requests at startAt none then 2, got %v", starts)
+	}
+
+	problems := ValidateCreateFields(metadata, map[string]any{"summary": "x"})
+	if len(problems) != 1 || problems[0].Field != "customfield_10001" {
+		t.Errorf("Expected the required field from page 2 to be reported, got %v", problems)
+	}
+}
+
 func TestGetFieldOptions_Success(t *testing.T) {
 	// Create mock server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -646,44 +1097,128 @@ func TestDoMultipartUpload_Success(t *testing.T) {
 	}
 }
 
-func TestAddAttachment_Success(t *testing.T) {
+func TestDoMultipartUpload_NotRetried(t *testing.T) {
+	attempts := 0
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != "POST" {
-			t.Errorf("Expected POST request, got %s", r.Method)
+		attempts++
+		io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	resp, err := client.doMultipartUpload(server.URL+"/upload", "file", "test.png", strings.NewReader("fake image data"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resp.Body.Close()
+
+	if attempts != 1 {
+		t.Errorf("Expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestAddPageAttachment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("Expected PUT request, got %s", r.Method)
 		}
-		if !strings.Contains(r.URL.Path, "/rest/api/3/issue/ABC-123/attachments") {
-			t.Errorf("Expected attachment path, got %s", r.URL.Path)
+		if r.URL.Path != "/wiki/rest/api/content/123/child/attachment" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		if r.Header.Get("X-Atlassian-Token") != "no-check" {
+			t.Errorf("Expected X-Atlassian-Token 'no-check', got %q", r.Header.Get("X-Atlassian-Token"))
 		}
 
-		response := []map[string]any{
-			{
-				"id":        "10001",
-				"filename":  "client_test.go",
-				"mimeType":  "application/octet-stream",
-				"size":      1234,
-				"content":   "https://example.com/download/10001",
-				"thumbnail": "https://example.com/thumbnail/10001",
-			},
+		if err := r.ParseMultipartForm(10 << 20); err != nil {
+			t.Fatalf("Failed to parse multipart form: %v", err)
+		}
+		if _, header, err := r.FormFile("file"); err != nil || header.Filename != "client_test.go" {
+			t.Errorf("Expected file client_test.go, got %v (%v)", header, err)
+		}
+		if r.FormValue("comment") != "First draft" || r.FormValue("minorEdit") != "true" {
+			t.Errorf("Unexpected form fields %v", r.MultipartForm.Value)
 		}
 
 		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(response)
+		w.Write([]byte(`{"results":[{"id":"att1","title":"client_test.go","version":{"number":2}}],"size":1}`))
 	}))
 	defer server.Close()
 
 	client := NewClient("user@example.com", "token", server.URL)
 
-	// Use this test file itself as the attachment (we know it exists)
-	attachments, err := client.AddAttachment("ABC-123", "client_test.go")
+	result, err := client.AddPageAttachment("123", "client_test.go", &AddPageAttachmentOptions{Comment: "First draft", MinorEdit: true})
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
 	}
-
-	if len(attachments) != 1 {
-		t.Fatalf("Expected 1 attachment, got %d", len(attachments))
+	if results, _ := result["results"].([]any); len(results) != 1 {
+		t.Errorf("Expected 1 result, got %v", result["results"])
 	}
+}
 
-	if attachments[0].ID != "10001" {
+func TestGetPageAttachments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wiki/rest/api/content/123/child/attachment" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("limit") != "10" || r.URL.Query().Get("start") != "20" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results":[{"id":"att1"}],"size":1}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	result, err := client.GetPageAttachments("123", &GetPageAttachmentsOptions{Limit: 10, Start: 20})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if results, _ := result["results"].([]any); len(results) != 1 {
+		t.Errorf("Expected 1 result, got %v", result["results"])
+	}
+}
+
+func TestAddAttachment_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if !strings.Contains(r.URL.Path, "/rest/api/3/issue/ABC-123/attachments") {
+			t.Errorf("Expected attachment path, got %s", r.URL.Path)
+		}
+
+		response := []map[string]any{
+			{
+				"id":        "10001",
+				"filename":  "client_test.go",
+				"mimeType":  "application/octet-stream",
+				"size":      1234,
+				"content":   "https://example.com/download/10001",
+				"thumbnail": "https://example.com/thumbnail/10001",
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	// Use this test file itself as the attachment (we know it exists)
+	attachments, err := client.AddAttachment("ABC-123", "client_test.go")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(attachments) != 1 {
+		t.Fatalf("Expected 1 attachment, got %d", len(attachments))
+	}
+
+	if attachments[0].ID != "10001" {
 		t.Errorf("Expected attachment ID '10001', got %q", attachments[0].ID)
 	}
 	if attachments[0].Filename != "client_test.go" {
@@ -703,6 +1238,23 @@ func TestAddAttachment_FileNotFound(t *testing.T) {
 	}
 }
 
+func TestAddAttachment_TooLarge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	_, err := client.AddAttachment("ABC-123", "client_test.go")
+	if err == nil {
+		t.Fatal("Expected error for oversized attachment, got nil")
+	}
+	if !strings.Contains(err.Error(), "attachment size limit") {
+		t.Errorf("Expected size limit error, got %v", err)
+	}
+}
+
 func TestGetAttachmentMediaID_Success(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Return a redirect with a Location header containing media UUID
@@ -772,3 +1324,1105 @@ func TestGetAttachmentMediaID_NoMediaIDInURL(t *testing.T) {
 		t.Errorf("Expected 'could not extract media ID' error, got %v", err)
 	}
 }
+
+func TestQuoteQueryValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{"Plain", "deploy runbook", `"deploy runbook"`},
+		{"Embedded quote", `say "hi"`, `"say \"hi\""`},
+		{"Trailing backslash", `C:\`, `"C:\\"`},
+		{"Backslash before quote", `a\"b`, `"a\\\"b"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := QuoteQueryValue(tt.value); got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetContentByLabel_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.Path, "/wiki/rest/api/content/search") {
+			t.Errorf("Expected content search path, got %s", r.URL.Path)
+		}
+
+		expectedCQL := `label = "deprecated" AND type IN ("page", "blogpost")`
+		if cql := r.URL.Query().Get("cql"); cql != expectedCQL {
+			t.Errorf("Expected CQL %q, got %q", expectedCQL, cql)
+		}
+
+		response := map[string]any{
+			"results": []any{
+				map[string]any{"id": "123", "type": "page", "title": "Old Runbook"},
+			},
+			"_links": map[string]any{
+				"next": "/rest/api/content/search?cql=label&cursor=abc123&limit=25",
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	result, err := client.GetContentByLabel("deprecated", &GetContentByLabelOptions{Types: []string{"page", "blogpost"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if cursor := NextCursor(result); cursor != "abc123" {
+		t.Errorf("Expected next cursor abc123, got %q", cursor)
+	}
+}
+
+func TestGetAllPageComments_FollowsPagination(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		start := r.URL.Query().Get("start")
+		var response map[string]any
+		switch start {
+		case "":
+			response = map[string]any{
+				"results": []any{map[string]any{"id": "1"}, map[string]any{"id": "2"}},
+				"_links":  map[string]any{"next": "/rest/api/content/123/child/comment?start=2&limit=2"},
+			}
+		case "2":
+			response = map[string]any{
+				"results": []any{map[string]any{"id": "3"}},
+				"_links":  map[string]any{},
+			}
+		default:
+			t.Errorf("Unexpected start %q", start)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	result, err := client.GetAllPageComments("123", &GetPageCommentsOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+
+	results, _ := result["results"].([]any)
+	if len(results) != 3 {
+		t.Errorf("Expected 3 comments, got %d", len(results))
+	}
+	if result["size"] != 3 {
+		t.Errorf("Expected size 3, got %v", result["size"])
+	}
+}
+
+func TestGetCloudID_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/_edge/tenant_info" {
+			t.Errorf("Expected tenant info path, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"cloudId":"abc-123"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	cloudID, err := client.GetCloudID()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if cloudID != "abc-123" {
+		t.Errorf("Expected cloud ID abc-123, got %s", cloudID)
+	}
+}
+
+func TestGetProjectRoles_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/project/ABC/role" {
+			t.Errorf("Expected project role path, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"Developers":"https://example.atlassian.net/rest/api/3/project/ABC/role/10001"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	roles, err := client.GetProjectRoles("ABC")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if roles["Developers"] != "https://example.atlassian.net/rest/api/3/project/ABC/role/10001" {
+		t.Errorf("Unexpected roles: %v", roles)
+	}
+}
+
+func TestGetProjectRole_Forbidden(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	_, err := client.GetProjectRole("ABC", "10001")
+	if err != errProjectRolesForbidden {
+		t.Errorf("Expected errProjectRolesForbidden, got %v", err)
+	}
+}
+
+func TestReleaseVersion_SendsReleasedFlag(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/rest/api/3/version/10100" {
+			t.Errorf("Expected PUT to version path, got %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+		if body["released"] != true || body["releaseDate"] != "2026-03-01" {
+			t.Errorf("Unexpected request body: %v", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"10100","name":"1.2.0","released":true,"releaseDate":"2026-03-01"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	version, err := client.ReleaseVersion("10100", "2026-03-01")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if version["name"] != "1.2.0" {
+		t.Errorf("Unexpected version: %v", version)
+	}
+}
+
+func TestGetAllSpacePermissions_FollowsCursor(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/wiki/api/v2/spaces/123/permissions" {
+			t.Errorf("Expected space permissions path, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("cursor") == "" {
+			w.Write([]byte(`{"results":[{"id":"1"}],"_links":{"next":"/wiki/api/v2/spaces/123/permissions?cursor=abc"}}`))
+			return
+		}
+		w.Write([]byte(`{"results":[{"id":"2"}],"_links":{}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	permissions, err := client.GetAllSpacePermissions("123")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(permissions) != 2 {
+		t.Errorf("Expected 2 permissions, got %d", len(permissions))
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+}
+
+func TestGetAllSpacePermissions_RepeatedCursor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results":[{"id":"1"}],"_links":{"next":"/wiki/api/v2/spaces/123/permissions?cursor=abc"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	if _, err := client.GetAllSpacePermissions("123"); err == nil {
+		t.Error("Expected error for repeated cursor")
+	}
+}
+
+func TestGetSpacePermissions_Forbidden(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	_, err := client.GetSpacePermissions("123", nil)
+	if err != errSpacePermissionsForbidden {
+		t.Errorf("Expected errSpacePermissionsForbidden, got %v", err)
+	}
+}
+
+func TestGetPageWatchers_FollowsPagination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wiki/rest/api/content/123/notification/created" {
+			t.Errorf("Expected page watchers path, got %s", r.URL.Path)
+		}
+
+		// A full first page of 100 watchers, then a partial second page
+		count := 100
+		if r.URL.Query().Get("start") != "0" {
+			count = 2
+		}
+		results := make([]map[string]any, count)
+		for i := range results {
+			results[i] = map[string]any{"watcher": map[string]any{"displayName": "User"}}
+		}
+		json.NewEncoder(w).Encode(map[string]any{"results": results})
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	watchers, err := client.GetPageWatchers("123")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(watchers) != 102 {
+		t.Errorf("Expected 102 watchers, got %d", len(watchers))
+	}
+}
+
+func TestWatchPage_ForAccount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/wiki/rest/api/user/watch/content/123" {
+			t.Errorf("Expected watch path, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("accountId") != "abc" {
+			t.Errorf("Expected accountId abc, got %q", r.URL.Query().Get("accountId"))
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	if err := client.WatchPage("123", "abc"); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestGetAttachment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/attachment/10001" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"id":10001,"filename":"report.pdf","size":2048,"mimeType":"application/pdf","content":"https://example.com/content/10001","author":{"displayName":"Doug Hughes"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	attachment, err := client.GetAttachment("10001")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if attachment.ID != "10001" {
+		t.Errorf("Expected ID '10001', got %q", attachment.ID)
+	}
+	if attachment.Filename != "report.pdf" || attachment.Size != 2048 {
+		t.Errorf("Unexpected attachment %+v", attachment)
+	}
+	if attachment.Author == nil || attachment.Author.DisplayName != "Doug Hughes" {
+		t.Errorf("Expected author Doug Hughes, got %+v", attachment.Author)
+	}
+}
+
+func TestDownloadAttachment_FallsBackToContentEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/attachment/content/10001" {
+			t.Errorf("Expected attachment content path, got %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") == "" {
+			t.Error("Expected Authorization header")
+		}
+		w.Write([]byte("log contents"))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	var buf bytes.Buffer
+	n, err := client.DownloadAttachment(&Attachment{ID: "10001", Filename: "app.log"}, &buf)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if n != int64(len("log contents")) || buf.String() != "log contents" {
+		t.Errorf("Unexpected download: %d bytes, %q", n, buf.String())
+	}
+}
+
+func TestFindField(t *testing.T) {
+	fields := []JiraField{
+		{ID: "summary", Name: "Summary"},
+		{ID: "customfield_10016", Name: "Story Points", Custom: true},
+		{ID: "customfield_10020", Name: "Team", Custom: true},
+		{ID: "customfield_10021", Name: "Team", Custom: true},
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		expected string
+		wantErr  bool
+	}{
+		{"By ID", "customfield_10016", "customfield_10016", false},
+		{"By name", "Story Points", "customfield_10016", false},
+		{"Case-insensitive name", "story points", "customfield_10016", false},
+		{"Ambiguous name", "Team", "", true},
+		{"Unknown", "Sprint", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field, err := FindField(fields, tt.query)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error, got field %s", field.ID)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if field.ID != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, field.ID)
+			}
+		})
+	}
+}
+
+func TestPing_Unauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/myself" {
+			t.Errorf("Expected myself path, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	err := client.Ping()
+	if err == nil || !strings.Contains(err.Error(), "authentication failed") {
+		t.Errorf("Expected authentication error, got %v", err)
+	}
+}
+
+func TestCheckAuthentication_Status(t *testing.T) {
+	for _, status := range []int{http.StatusUnauthorized, http.StatusForbidden} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		}))
+
+		client := NewClient("user@example.com", "token", server.URL)
+
+		user, code, err := client.CheckAuthentication()
+		if err == nil || user != nil {
+			t.Errorf("Expected error for status %d, got user %v", status, user)
+		}
+		if code != status {
+			t.Errorf("Expected status %d, got %d", status, code)
+		}
+		server.Close()
+	}
+}
+
+func TestGetMyPermissions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/mypermissions" {
+			t.Errorf("Expected mypermissions path, got %s", r.URL.Path)
+		}
+		if permissions := r.URL.Query().Get("permissions"); permissions != "BROWSE_PROJECTS,CREATE_ISSUES,ADMINISTER" {
+			t.Errorf("Unexpected permissions parameter %q", permissions)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"permissions":{
+			"BROWSE_PROJECTS":{"key":"BROWSE_PROJECTS","havePermission":true},
+			"CREATE_ISSUES":{"key":"CREATE_ISSUES","havePermission":true},
+			"ADMINISTER":{"key":"ADMINISTER","havePermission":false}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	held, err := client.GetMyPermissions([]string{"BROWSE_PROJECTS", "CREATE_ISSUES", "ADMINISTER"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if strings.Join(held, ",") != "BROWSE_PROJECTS,CREATE_ISSUES" {
+		t.Errorf("Expected the held permissions, got %v", held)
+	}
+}
+
+func TestFilterUsers(t *testing.T) {
+	users := []map[string]any{
+		{"accountId": "1", "displayName": "Jane Doe", "emailAddress": "jane@example.com", "active": true},
+		{"accountId": "2", "displayName": "Jane Doe", "emailAddress": "jane.old@example.com", "active": false},
+		{"accountId": "3", "displayName": "Janet Doe", "active": true},
+	}
+
+	tests := []struct {
+		name     string
+		opts     FilterUsersOptions
+		expected []string
+	}{
+		{"No filters", FilterUsersOptions{}, []string{"1", "2", "3"}},
+		{"Active only", FilterUsersOptions{ActiveOnly: true}, []string{"1", "3"}},
+		{"Exact name", FilterUsersOptions{Exact: "jane doe"}, []string{"1", "2"}},
+		{"Exact email", FilterUsersOptions{Exact: "JANE@example.com"}, []string{"1"}},
+		{"Exact and active", FilterUsersOptions{Exact: "Jane Doe", ActiveOnly: true}, []string{"1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ids []string
+			for _, user := range FilterUsers(users, &tt.opts) {
+				ids = append(ids, user["accountId"].(string))
+			}
+			if strings.Join(ids, ",") != strings.Join(tt.expected, ",") {
+				t.Errorf("Expected %v, got %v", tt.expected, ids)
+			}
+		})
+	}
+}
+
+func TestFindPageByTitle_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("spaceKey") != "DOCS" || query.Get("title") != "Getting Started" {
+			t.Errorf("Unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results":[],"size":0}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	page, err := client.FindPageByTitle("DOCS", "Getting Started")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if page != nil {
+		t.Errorf("Expected no page, got %v", page)
+	}
+}
+
+func TestCreateConfluencePage_BlogPost(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		if body["type"] != "blogpost" {
+			t.Errorf("Expected type blogpost, got %v", body["type"])
+		}
+		if _, ok := body["ancestors"]; ok {
+			t.Error("Expected no ancestors for a blog post")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"42","type":"blogpost","title":"Release notes"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	result, err := client.CreateConfluencePage(&CreatePageOptions{
+		SpaceKey: "DOCS",
+		Title:    "Release notes",
+		Body:     "<p>New things</p>",
+		Type:     ContentTypeBlogPost,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result["id"] != "42" {
+		t.Errorf("Expected id 42, got %v", result["id"])
+	}
+
+	if _, err := client.CreateConfluencePage(&CreatePageOptions{
+		SpaceKey: "DOCS",
+		Title:    "Release notes",
+		ParentID: "123",
+		Type:     ContentTypeBlogPost,
+	}); err == nil {
+		t.Error("Expected error for a blog post with a parent")
+	}
+}
+
+func TestGetPagesInSpace_BlogPosts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("type"); got != "blogpost" {
+			t.Errorf("Expected type=blogpost, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results":[{"id":"42","title":"Release notes"}],"size":1}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	result, err := client.GetPagesInSpace(&GetPagesInSpaceOptions{SpaceKey: "DOCS", Type: ContentTypeBlogPost})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if size, _ := result["size"].(float64); size != 1 {
+		t.Errorf("Expected 1 result, got %v", result["size"])
+	}
+}
+
+func TestAddCommentToIssue_ADFBody(t *testing.T) {
+	adf := map[string]any{
+		"type":    "doc",
+		"version": 1,
+		"content": []any{
+			map[string]any{"type": "heading", "attrs": map[string]any{"level": 2}},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		content, _ := body["body"].(map[string]any)["content"].([]any)
+		if len(content) != 1 || content[0].(map[string]any)["type"] != "heading" {
+			t.Errorf("Expected the ADF body to be sent as-is, got %v", body["body"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"10001"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	result, err := client.AddCommentToIssue("PROJ-1", &AddCommentOptions{Body: adf})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result["id"] != "10001" {
+		t.Errorf("Expected id 10001, got %v", result["id"])
+	}
+}
+
+func TestGetIssueComments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/PROJ-1/comment" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("startAt") != "10" || query.Get("maxResults") != "5" || query.Get("orderBy") != "-created" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"startAt":10,"total":11,"comments":[{"id":"10001"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	result, err := client.GetIssueComments("PROJ-1", &GetIssueCommentsOptions{StartAt: 10, MaxResults: 5, OrderBy: "-created"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if comments, _ := result["comments"].([]any); len(comments) != 1 {
+		t.Errorf("Expected 1 comment, got %v", result["comments"])
+	}
+}
+
+func TestEditComment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/rest/api/3/issue/PROJ-1/comment/10001" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		if doc, _ := body["body"].(map[string]any); doc["type"] != "doc" {
+			t.Errorf("Expected ADF body, got %v", body["body"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"10001"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	result, err := client.EditComment("PROJ-1", "10001", map[string]any{"type": "doc", "version": 1, "content": []any{}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result["id"] != "10001" {
+		t.Errorf("Expected id 10001, got %v", result["id"])
+	}
+}
+
+func TestDeleteComment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("Expected DELETE request, got %s", r.Method)
+		}
+		if r.URL.Path == "/rest/api/3/issue/PROJ-1/comment/99" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errorMessages":["Can not find a comment for the id: 99."]}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	if err := client.DeleteComment("PROJ-1", "10001"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	err := client.DeleteComment("PROJ-1", "99")
+	if err == nil || !strings.Contains(err.Error(), "Can not find a comment") {
+		t.Errorf("Expected error with the API message, got %v", err)
+	}
+}
+
+func TestSearchJiraIssuesJQL_FetchAll(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("nextPageToken")
+		requests = append(requests, token)
+		if got := r.URL.Query().Get("maxResults"); got != "2" {
+			t.Errorf("Expected maxResults=2, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch token {
+		case "":
+			w.Write([]byte(`{"issues":[{"key":"A-1"},{"key":"A-2"}],"nextPageToken":"p2","isLast":false}`))
+		case "p2":
+			w.Write([]byte(`{"issues":[{"key":"A-3"}],"isLast":true}`))
+		default:
+			t.Errorf("Unexpected token %q", token)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	result, err := client.SearchJiraIssuesJQL("project = A", &SearchJQLOptions{MaxResults: 2, FetchAll: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	issues, _ := result["issues"].([]any)
+	if len(issues) != 3 {
+		t.Fatalf("Expected 3 issues, got %d", len(issues))
+	}
+	if len(requests) != 2 {
+		t.Errorf("Expected 2 requests, got %d", len(requests))
+	}
+	if _, ok := result["nextPageToken"]; ok {
+		t.Error("Expected nextPageToken to be removed from the merged result")
+	}
+}
+
+func TestSearchJiraIssuesJQL_FetchAllStartAt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			w.Write([]byte(`{"issues":[{"key":"A-1"},{"key":"A-2"}],"startAt":0,"total":4}`))
+		case "2":
+			w.Write([]byte(`{"issues":[{"key":"A-3"}],"startAt":2,"total":4}`))
+		case "3":
+			// An empty page ends the loop even though total says there's more
+			w.Write([]byte(`{"issues":[],"startAt":3,"total":4}`))
+		default:
+			t.Errorf("Unexpected startAt %q", r.URL.Query().Get("startAt"))
+			w.Write([]byte(`{"issues":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	var streamed []any
+	result, err := client.SearchJiraIssuesJQL("project = A", &SearchJQLOptions{
+		FetchAll: true,
+		OnPage: func(issues []any) error {
+			streamed = append(streamed, issues...)
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(streamed) != 3 {
+		t.Errorf("Expected 3 streamed issues, got %d", len(streamed))
+	}
+	if _, ok := result["issues"]; ok {
+		t.Error("Expected streamed issues not to be accumulated")
+	}
+	if total, _ := result["total"].(float64); total != 4 {
+		t.Errorf("Expected total 4 to be preserved, got %v", result["total"])
+	}
+}
+
+func TestSearchJiraIssuesJQL_RepeatedToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"issues":[{"key":"A-1"}],"nextPageToken":"same","isLast":false}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	if _, err := client.SearchJiraIssuesJQL("project = A", &SearchJQLOptions{FetchAll: true}); err == nil {
+		t.Error("Expected error for a repeated page token")
+	}
+}
+
+func TestSearchAllConfluenceCQL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Write([]byte(`{"results":[{"id":"1"},{"id":"2"}],"size":2,"_links":{"next":"/rest/api/content/search?cql=x&cursor=c2"}}`))
+		case "c2":
+			w.Write([]byte(`{"results":[{"id":"3"}],"size":1,"_links":{}}`))
+		default:
+			t.Errorf("Unexpected cursor %q", r.URL.Query().Get("cursor"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	result, err := client.SearchAllConfluenceCQL("space = TEAM", nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	results, _ := result["results"].([]any)
+	if len(results) != 3 || result["size"] != 3 {
+		t.Errorf("Expected 3 results, got %d (size %v)", len(results), result["size"])
+	}
+}
+
+func TestSearchAllConfluenceCQL_RepeatedCursor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results":[{"id":"1"}],"size":1,"_links":{"next":"/rest/api/content/search?cursor=same"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	if _, err := client.SearchAllConfluenceCQL("space = TEAM", nil); err == nil {
+		t.Error("Expected error for a repeated cursor")
+	}
+}
+
+func TestLooksLikeAccountID(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"5b10ac8d82e05b22cc7d4ef5", true},
+		{"557058:f58131cb-b67d-43c7-b30d-6b58d40bd077", true},
+		{"doug@example.com", false},
+		{"Doug Hughes", false},
+		{"5b10ac8d", false},
+	}
+
+	for _, tt := range tests {
+		if result := LooksLikeAccountID(tt.input); result != tt.expected {
+			t.Errorf("LooksLikeAccountID(%q) = %v, expected %v", tt.input, result, tt.expected)
+		}
+	}
+}
+
+func TestAssignIssue(t *testing.T) {
+	var bodies []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/rest/api/3/issue/PROJ-1/assignee" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		bodies = append(bodies, body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	if err := client.AssignIssue("PROJ-1", "abc123"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := client.AssignIssue("PROJ-1", ""); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if bodies[0]["accountId"] != "abc123" {
+		t.Errorf("Expected accountId abc123, got %v", bodies[0]["accountId"])
+	}
+	if value, ok := bodies[1]["accountId"]; !ok || value != nil {
+		t.Errorf("Expected accountId null to unassign, got %v", bodies[1])
+	}
+}
+
+func TestEditJiraIssueWithOptions_SkipNotifications(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/rest/api/3/issue/PROJ-1" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("notifyUsers") != "false" {
+			t.Errorf("Expected notifyUsers=false, got %s", r.URL.RawQuery)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	err := client.EditJiraIssueWithOptions("PROJ-1", map[string]any{"summary": "Quiet"}, &EditIssueOptions{SkipNotifications: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestEditJiraIssueWithOptions_Forbidden(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantNotify bool
+	}{
+		{"notification refused", `{"errorMessages":["To discard the user notification either admin or project admin permissions are required."]}`, true},
+		{"other 403", `{"errorMessages":["You do not have permission to edit issues in this project."]}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := NewClient("user@example.com", "token", server.URL)
+
+			err := client.EditJiraIssueWithOptions("PROJ-1", map[string]any{"summary": "Quiet"}, &EditIssueOptions{SkipNotifications: true})
+			if errors.Is(err, errNotifyForbidden) != tt.wantNotify {
+				t.Errorf("Expected errNotifyForbidden %v, got %v", tt.wantNotify, err)
+			}
+			if !tt.wantNotify && (err == nil || !strings.Contains(err.Error(), "edit issues")) {
+				t.Errorf("Expected Jira's message in the error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTransitionIssue(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/api/3/issue/PROJ-1/transitions" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.RawQuery != "" {
+			t.Errorf("Expected no query parameters, got %s", r.URL.RawQuery)
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		if transition, _ := body["transition"].(map[string]any); transition["id"] != "31" {
+			t.Errorf("Expected transition id 31, got %v", body["transition"])
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	if err := client.TransitionIssue("PROJ-1", &TransitionIssueOptions{TransitionID: "31"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestAddWorklog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/api/3/issue/PROJ-1/worklog" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("adjustEstimate") != "new" || query.Get("newEstimate") != "1d" {
+			t.Errorf("Expected adjustEstimate=new&newEstimate=1d, got %s", r.URL.RawQuery)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		if body["timeSpent"] != "2h 30m" {
+			t.Errorf("Expected timeSpent '2h 30m', got %v", body["timeSpent"])
+		}
+		if body["started"] != "2024-01-15T09:00:00.000+0000" {
+			t.Errorf("Expected started to be passed through, got %v", body["started"])
+		}
+		if _, ok := body["comment"].(map[string]any); !ok {
+			t.Errorf("Expected ADF comment, got %v", body["comment"])
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"10001","timeSpent":"2h 30m"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	result, err := client.AddWorklog("PROJ-1", &AddWorklogOptions{
+		TimeSpent:      "2h 30m",
+		Comment:        map[string]any{"type": "doc", "version": 1, "content": []any{}},
+		Started:        "2024-01-15T09:00:00.000+0000",
+		AdjustEstimate: "new",
+		NewEstimate:    "1d",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result["id"] != "10001" {
+		t.Errorf("Expected worklog ID 10001, got %v", result["id"])
+	}
+}
+
+func TestAddWorklog_InvalidEstimateAdjustment(t *testing.T) {
+	client := NewClient("user@example.com", "token", "http://127.0.0.1:0")
+
+	tests := []*AddWorklogOptions{
+		{TimeSpent: "1h", AdjustEstimate: "sometimes"},
+		{TimeSpent: "1h", AdjustEstimate: "new"},
+		{TimeSpent: "1h", AdjustEstimate: "manual"},
+		{AdjustEstimate: "auto"},
+	}
+
+	for _, opts := range tests {
+		if _, err := client.AddWorklog("PROJ-1", opts); err == nil {
+			t.Errorf("Expected error for %+v", opts)
+		}
+	}
+}
+
+func TestGetWorklogs(t *testing.T) {
+	after := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/PROJ-1/worklog" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("startedAfter") != "1705276800000" {
+			t.Errorf("Expected startedAfter in epoch milliseconds, got %q", query.Get("startedAfter"))
+		}
+		if query.Has("startedBefore") {
+			t.Errorf("Expected no startedBefore, got %q", query.Get("startedBefore"))
+		}
+		if query.Get("startAt") != "10" || query.Get("maxResults") != "5" {
+			t.Errorf("Expected startAt=10&maxResults=5, got %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"startAt":10,"maxResults":5,"total":11,"worklogs":[{"id":"1","timeSpentSeconds":3600}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	result, err := client.GetWorklogs("PROJ-1", &GetWorklogsOptions{StartAt: 10, MaxResults: 5, StartedAfter: after})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if worklogs, _ := result["worklogs"].([]any); len(worklogs) != 1 {
+		t.Errorf("Expected 1 worklog, got %v", result["worklogs"])
+	}
+}
+
+func TestGetAllWorklogs_FollowsPagination(t *testing.T) {
+	var starts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		starts = append(starts, query.Get("startAt"))
+		if query.Get("startedAfter") != "1705276800000" {
+			t.Errorf("Expected startedAfter on every page, got %s", r.URL.RawQuery)
+		}
+		if query.Get("startAt") == "" {
+			w.Write([]byte(`{"startAt":0,"maxResults":2,"total":3,"worklogs":[{"id":"1"},{"id":"2"}]}`))
+			return
+		}
+		w.Write([]byte(`{"startAt":2,"maxResults":2,"total":3,"worklogs":[{"id":"3"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	after := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	pages, err := client.GetAllWorklogs("PROJ-1", &GetWorklogsOptions{StartedAfter: after})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(pages) != 2 {
+		t.Fatalf("Expected 2 pages, got %v", pages)
+	}
+	if pages[1]["startAt"] != float64(2) || pages[1]["total"] != float64(3) {
+		t.Errorf("Expected the second page unchanged, got %v", pages[1])
+	}
+	if strings.Join(starts, ",") != ",2" {
+		t.Errorf("Expected requests at startAt none then 2, got %v", starts)
+	}
+}
+
+func TestAddAndRemoveWatcher(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/PROJ-1/watchers" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.RawQuery+" "+string(body))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	if err := client.AddWatcher("PROJ-1", "abc123"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := client.RemoveWatcher("PROJ-1", "abc123"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []string{`POST  "abc123"`, "DELETE accountId=abc123 "}
+	for i, want := range expected {
+		if requests[i] != want {
+			t.Errorf("Request %d: expected %q, got %q", i, want, requests[i])
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package atlassian
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected string
+	}{
+		{
+			name:     "Identical",
+			a:        "one\ntwo",
+			b:        "one\ntwo",
+			expected: "",
+		},
+		{
+			name: "Changed line",
+			a:    "one\ntwo\nthree",
+			b:    "one\n2\nthree",
+			expected: `--- v1
++++ v2
+@@ -1,3 +1,3 @@
+ one
+-two
++2
+ three
+`,
+		},
+		{
+			name: "Added lines at end",
+			a:    "one",
+			b:    "one\ntwo\nthree",
+			expected: `--- v1
++++ v2
+@@ -1 +1,3 @@
+ one
++two
++three
+`,
+		},
+		{
+			name: "Separate hunks",
+			a:    "a\nb\nc\nd\ne\nf\ng\nh\ni\nj",
+			b:    "A\nb\nc\nd\ne\nf\ng\nh\ni\nJ",
+			expected: `--- v1
++++ v2
+@@ -1,2 +1,2 @@
+-a
++A
+ b
+@@ -9,2 +9,2 @@
+ i
+-j
++J
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := UnifiedDiff(strings.Split(tt.a, "\n"), strings.Split(tt.b, "\n"), "v1", "v2", 1)
+			if result != tt.expected {
+				t.Errorf("Expected:\n%s\nGot:\n%s", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package atlassian
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// headerNameRegexp matches valid HTTP header field names (RFC 7230 tokens)
+var headerNameRegexp = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+
+// ParseHeader parses a "Key: Value" header. The Authorization header is
+// rejected because the client always sets it from the account credentials.
+func ParseHeader(s string) (string, string, error) {
+	name, value, ok := strings.Cut(s, ":")
+	if !ok {
+		return "", "", fmt.Errorf("invalid header %q: expected \"Key: Value\"", s)
+	}
+
+	name = strings.TrimSpace(name)
+	if !headerNameRegexp.MatchString(name) {
+		return "", "", fmt.Errorf("invalid header %q: malformed header name %q", s, name)
+	}
+	if strings.EqualFold(name, "Authorization") {
+		return "", "", fmt.Errorf("the Authorization header can't be overridden")
+	}
+
+	value = strings.TrimSpace(value)
+	if strings.ContainsAny(value, "\r\n") {
+		return "", "", fmt.Errorf("invalid header %q: value contains a line break", s)
+	}
+
+	return http.CanonicalHeaderKey(name), value, nil
+}
+
+// ParseHeaders parses a list of "Key: Value" headers. Repeated keys add
+// multiple values.
+func ParseHeaders(headers []string) (http.Header, error) {
+	result := http.Header{}
+	for _, h := range headers {
+		name, value, err := ParseHeader(h)
+		if err != nil {
+			return nil, err
+		}
+		result.Add(name, value)
+	}
+	return result, nil
+}
+
+// applyHeaders adds the client's extra headers to a request, replacing any
+// defaults with the same name
+func (c *Client) applyHeaders(req *http.Request) {
+	for name, values := range c.Headers {
+		if strings.EqualFold(name, "Authorization") {
+			continue
+		}
+		req.Header.Del(name)
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package atlassian
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseHeader(t *testing.T) {
+	name, value, err := ParseHeader("atl-request-id:  abc-123 ")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if name != "Atl-Request-Id" {
+		t.Errorf("Expected canonical name Atl-Request-Id, got %q", name)
+	}
+	if value != "abc-123" {
+		t.Errorf("Expected value abc-123, got %q", value)
+	}
+}
+
+func TestParseHeader_Invalid(t *testing.T) {
+	tests := []string{
+		"NoColon",
+		": value",
+		"Bad Name: value",
+		"Authorization: Bearer x",
+		"authorization: Basic x",
+		"X-Test: a\r\nX-Other: b",
+	}
+
+	for _, header := range tests {
+		t.Run(header, func(t *testing.T) {
+			if _, _, err := ParseHeader(header); err == nil {
+				t.Errorf("Expected error for %q", header)
+			}
+		})
+	}
+}
+
+func TestDoRequest_SendsExtraHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Experimental"); got != "on" {
+			t.Errorf("Expected X-Experimental header 'on', got %q", got)
+		}
+		if got := r.Header.Get("Accept"); got != "application/xml" {
+			t.Errorf("Expected overridden Accept header, got %q", got)
+		}
+		if got := r.Header.Get("Authorization"); got == "" || got == "Bearer x" {
+			t.Errorf("Expected account Authorization header, got %q", got)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+	client.Headers = http.Header{
+		"X-Experimental": {"on"},
+		"Accept":         {"application/xml"},
+		"Authorization":  {"Bearer x"},
+	}
+
+	resp, err := client.doRequest("GET", server.URL, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resp.Body.Close()
+}
@@ -0,0 +1,88 @@
+package atlassian
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// orderByClauseRegexp detects an existing ORDER BY clause in a query
+var orderByClauseRegexp = regexp.MustCompile(`(?i)\border\s+by\b`)
+
+// quotedStringRegexp matches single- or double-quoted string literals so they
+// can be ignored when looking for an existing ORDER BY clause
+var quotedStringRegexp = regexp.MustCompile(`"(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*'`)
+
+// jqlFieldRegexp matches plausible JQL sort fields: system fields (updated,
+// issuetype), custom field IDs (customfield_10010, cf[10010]) and quoted
+// custom field names ("Story Points")
+var jqlFieldRegexp = regexp.MustCompile(`^(?:[A-Za-z][A-Za-z0-9_.]*|cf\[\d+\]|"[^"]+")$`)
+
+// cqlFieldRegexp matches plausible CQL sort fields such as created,
+// lastmodified, title, or space.key
+var cqlFieldRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_.]*$`)
+
+// HasOrderBy reports whether a JQL or CQL query already contains an ORDER BY
+// clause, ignoring any text inside quoted string literals
+func HasOrderBy(query string) bool {
+	stripped := quotedStringRegexp.ReplaceAllString(query, `""`)
+	return orderByClauseRegexp.MatchString(stripped)
+}
+
+// ApplyJQLOrderBy appends an ORDER BY clause built from orderBy to the JQL
+// query. orderBy is a comma-separated list of "field [ASC|DESC]" terms; desc
+// sets the direction for terms that don't specify one. Queries that already
+// contain an ORDER BY clause are returned unchanged.
+func ApplyJQLOrderBy(jql, orderBy string, desc bool) (string, error) {
+	return applyOrderBy(jql, orderBy, desc, jqlFieldRegexp)
+}
+
+// ApplyCQLOrderBy appends an ORDER BY clause to a CQL query the same way
+// ApplyJQLOrderBy does for JQL
+func ApplyCQLOrderBy(cql, orderBy string, desc bool) (string, error) {
+	return applyOrderBy(cql, orderBy, desc, cqlFieldRegexp)
+}
+
+func applyOrderBy(query, orderBy string, desc bool, fieldRe *regexp.Regexp) (string, error) {
+	orderBy = strings.TrimSpace(orderBy)
+	if orderBy == "" || HasOrderBy(query) {
+		return query, nil
+	}
+
+	var terms []string
+	for _, raw := range strings.Split(orderBy, ",") {
+		term := strings.TrimSpace(raw)
+		if term == "" {
+			return "", fmt.Errorf("invalid order-by %q: empty sort term", orderBy)
+		}
+
+		field := term
+		direction := ""
+		if idx := strings.LastIndex(term, " "); idx > 0 {
+			suffix := strings.ToUpper(strings.TrimSpace(term[idx+1:]))
+			if suffix == "ASC" || suffix == "DESC" {
+				field = strings.TrimSpace(term[:idx])
+				direction = suffix
+			}
+		}
+
+		if !fieldRe.MatchString(field) {
+			return "", fmt.Errorf("invalid order-by field %q", field)
+		}
+
+		if direction == "" && desc {
+			direction = "DESC"
+		}
+		if direction != "" {
+			field += " " + direction
+		}
+		terms = append(terms, field)
+	}
+
+	query = strings.TrimSpace(query)
+	clause := "ORDER BY " + strings.Join(terms, ", ")
+	if query == "" {
+		return clause, nil
+	}
+	return query + " " + clause, nil
+}
@@ -0,0 +1,88 @@
+package atlassian
+
+import (
+	"testing"
+)
+
+func TestApplyJQLOrderBy(t *testing.T) {
+	tests := []struct {
+		name     string
+		jql      string
+		orderBy  string
+		desc     bool
+		expected string
+	}{
+		{"Field with direction", "project = ABC", "updated DESC", false, "project = ABC ORDER BY updated DESC"},
+		{"Field with desc flag", "project = ABC", "updated", true, "project = ABC ORDER BY updated DESC"},
+		{"Field without direction", "project = ABC", "created", false, "project = ABC ORDER BY created"},
+		{"Lowercase direction", "project = ABC", "updated desc", false, "project = ABC ORDER BY updated DESC"},
+		{"Multiple fields", "project = ABC", "priority DESC, updated", true, "project = ABC ORDER BY priority DESC, updated DESC"},
+		{"Custom field ID", "project = ABC", "cf[10010] ASC", false, "project = ABC ORDER BY cf[10010] ASC"},
+		{"Quoted field name", "project = ABC", `"Story Points" DESC`, false, `project = ABC ORDER BY "Story Points" DESC`},
+		{"Existing ORDER BY untouched", "project = ABC order by created", "updated DESC", false, "project = ABC order by created"},
+		{"ORDER BY inside string literal", `summary ~ "order by"`, "updated", false, `summary ~ "order by" ORDER BY updated`},
+		{"Empty order-by", "project = ABC", "", true, "project = ABC"},
+		{"Empty query", "", "updated DESC", false, "ORDER BY updated DESC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ApplyJQLOrderBy(tt.jql, tt.orderBy, tt.desc)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestApplyJQLOrderBy_InvalidField(t *testing.T) {
+	tests := []string{
+		"updated; DROP",
+		"1updated",
+		"updated,",
+		"updated DESC DESC",
+	}
+
+	for _, orderBy := range tests {
+		t.Run(orderBy, func(t *testing.T) {
+			if _, err := ApplyJQLOrderBy("project = ABC", orderBy, false); err == nil {
+				t.Errorf("Expected error for order-by %q", orderBy)
+			}
+		})
+	}
+}
+
+func TestApplyCQLOrderBy(t *testing.T) {
+	tests := []struct {
+		name     string
+		cql      string
+		orderBy  string
+		expected string
+	}{
+		{"Field with direction", "space = ENG", "lastmodified desc", "space = ENG ORDER BY lastmodified DESC"},
+		{"Multiple fields", "type = page", "title, created DESC", "type = page ORDER BY title, created DESC"},
+		{"Existing ORDER BY untouched", "space = ENG order by title", "created", "space = ENG order by title"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ApplyCQLOrderBy(tt.cql, tt.orderBy, false)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+
+	// JQL-only field forms aren't valid CQL sort fields
+	for _, orderBy := range []string{"cf[10010]", `"Story Points"`} {
+		if _, err := ApplyCQLOrderBy("space = ENG", orderBy, false); err == nil {
+			t.Errorf("Expected error for order-by %q", orderBy)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package atlassian
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultMaxRetries is how many times a request is retried when no other
+// limit has been configured
+const DefaultMaxRetries = 3
+
+// DefaultRetryStatuses are the status codes retried by default: rate limiting
+// and gateway errors. A gateway error can arrive after the upstream already
+// acted on the request, so only idempotent methods retry on all of them (see
+// shouldRetry).
+var DefaultRetryStatuses = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// retryBaseDelay is the wait before the first retry; it doubles each attempt
+var retryBaseDelay = 500 * time.Millisecond
+
+// maxRetryDelay caps the wait between attempts, including Retry-After values
+const maxRetryDelay = 30 * time.Second
+
+// RetryPolicy controls which responses doRequest retries and how often
+type RetryPolicy struct {
+	MaxRetries int   // Number of retries after the first attempt (0 disables retries)
+	RetryOn    []int // Status codes that trigger a retry
+}
+
+// DefaultRetryPolicy returns the policy used when nothing is configured
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: DefaultMaxRetries,
+		RetryOn:    append([]int(nil), DefaultRetryStatuses...),
+	}
+}
+
+// shouldRetry reports whether a response to a request with the given method
+// should be retried on the given attempt (0 for the first retry). Idempotent
+// methods retry on any status in the policy. Other methods, such as POST,
+// only retry when the server says it rejected the request without acting on
+// it: a 429, or a 503 carrying Retry-After. Replaying a POST after a 502 or
+// 504 could create a second issue, comment, or attachment.
+func (p RetryPolicy) shouldRetry(method string, resp *http.Response, attempt int) bool {
+	if attempt >= p.MaxRetries || !p.retriesStatus(resp.StatusCode) {
+		return false
+	}
+	if isIdempotent(method) {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusServiceUnavailable:
+		return resp.Header.Get("Retry-After") != ""
+	}
+	return false
+}
+
+// retriesStatus reports whether status is in the policy's retry list
+func (p RetryPolicy) retriesStatus(status int) bool {
+	for _, code := range p.RetryOn {
+		if code == status {
+			return true
+		}
+	}
+	return false
+}
+
+// isIdempotent reports whether repeating a request with this method has the
+// same effect as sending it once
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// ParseRetryStatuses parses a comma-separated list of HTTP status codes such
+// as "429,502,503,504". Only 4xx and 5xx codes are accepted.
+func ParseRetryStatuses(s string) ([]int, error) {
+	var statuses []int
+	for _, raw := range strings.Split(s, ",") {
+		field := strings.TrimSpace(raw)
+		if field == "" {
+			continue
+		}
+		code, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid status code %q in retry list", field)
+		}
+		if code < 400 || code > 599 {
+			return nil, fmt.Errorf("status code %d can't be retried (must be 4xx or 5xx)", code)
+		}
+		statuses = append(statuses, code)
+	}
+	if len(statuses) == 0 {
+		return nil, fmt.Errorf("retry list is empty")
+	}
+	return statuses, nil
+}
+
+// FormatRetryStatuses renders status codes as a comma-separated list
+func FormatRetryStatuses(statuses []int) string {
+	parts := make([]string, len(statuses))
+	for i, code := range statuses {
+		parts[i] = strconv.Itoa(code)
+	}
+	return strings.Join(parts, ",")
+}
+
+// retryDelay returns how long to wait before retrying. A Retry-After header
+// (in seconds) wins; otherwise the delay backs off exponentially. Doubling
+// stops at maxRetryDelay so a large attempt count can't overflow into a
+// negative wait.
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	delay := retryBaseDelay
+	for i := 0; i < attempt && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+		delay = maxRetryDelay
+		if seconds < int(maxRetryDelay/time.Second) {
+			delay = time.Duration(seconds) * time.Second
+		}
+	}
+	return min(delay, maxRetryDelay)
+}
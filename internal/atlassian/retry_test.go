@@ -0,0 +1,229 @@
+package atlassian
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRetryStatuses(t *testing.T) {
+	statuses, err := ParseRetryStatuses(" 429, 500,503 ")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if FormatRetryStatuses(statuses) != "429,500,503" {
+		t.Errorf("Expected 429,500,503, got %v", statuses)
+	}
+}
+
+func TestParseRetryStatuses_Invalid(t *testing.T) {
+	tests := []string{"", "abc", "429,x", "200", "302", "600"}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			if _, err := ParseRetryStatuses(input); err == nil {
+				t.Errorf("Expected error for %q", input)
+			}
+		})
+	}
+}
+
+func TestRetryDelay_CapsLargeAttempts(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+	for _, attempt := range []int{0, 5, 6, 34, 40, 100} {
+		delay := retryDelay(resp, attempt)
+		if delay <= 0 || delay > maxRetryDelay {
+			t.Errorf("Attempt %d: expected a delay in (0, %v], got %v", attempt, maxRetryDelay, delay)
+		}
+	}
+	if delay := retryDelay(resp, 100); delay != maxRetryDelay {
+		t.Errorf("Expected attempt 100 to wait %v, got %v", maxRetryDelay, delay)
+	}
+
+	resp.Header.Set("Retry-After", "99999999999")
+	if delay := retryDelay(resp, 0); delay != maxRetryDelay {
+		t.Errorf("Expected a huge Retry-After to wait %v, got %v", maxRetryDelay, delay)
+	}
+}
+
+func TestDoRequest_RetriesConfiguredStatuses(t *testing.T) {
+	retryBaseDelay = time.Millisecond
+	defer func() { retryBaseDelay = 500 * time.Millisecond }()
+
+	attempts := 0
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if attempts < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+	client.Retry = RetryPolicy{MaxRetries: 3, RetryOn: []int{500}}
+
+	resp, err := client.doRequest("PUT", server.URL, strings.NewReader(`{"a":1}`))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	}
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts)
+	}
+	for i, body := range bodies {
+		if body != `{"a":1}` {
+			t.Errorf("Attempt %d sent body %q", i+1, body)
+		}
+	}
+}
+
+func TestDoRequest_PostRetriesOnlyWhenRejected(t *testing.T) {
+	retryBaseDelay = time.Millisecond
+	defer func() { retryBaseDelay = 500 * time.Millisecond }()
+
+	tests := []struct {
+		name       string
+		status     int
+		retryAfter string
+		wantTries  int
+	}{
+		{"bad gateway", http.StatusBadGateway, "", 1},
+		{"gateway timeout", http.StatusGatewayTimeout, "", 1},
+		{"unavailable without Retry-After", http.StatusServiceUnavailable, "", 1},
+		{"unavailable with Retry-After", http.StatusServiceUnavailable, "0", 2},
+		{"rate limited", http.StatusTooManyRequests, "", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				if attempts > 1 {
+					w.WriteHeader(http.StatusCreated)
+					return
+				}
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			client := NewClient("user@example.com", "token", server.URL)
+
+			resp, err := client.doRequest("POST", server.URL, strings.NewReader(`{"a":1}`))
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			resp.Body.Close()
+
+			if attempts != tt.wantTries {
+				t.Errorf("Expected %d attempts, got %d", tt.wantTries, attempts)
+			}
+		})
+	}
+}
+
+func TestDoRequest_StopsAtMaxRetries(t *testing.T) {
+	retryBaseDelay = time.Millisecond
+	defer func() { retryBaseDelay = 500 * time.Millisecond }()
+
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+	client.Retry.MaxRetries = 2
+
+	resp, err := client.doRequest("GET", server.URL, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected status 503, got %d", resp.StatusCode)
+	}
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestDoRequest_DoesNotRetryUnlistedStatus(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL)
+
+	resp, err := client.doRequest("GET", server.URL, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resp.Body.Close()
+
+	if attempts != 1 {
+		t.Errorf("Expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestDoRequest_CancelledDuringRetryWait(t *testing.T) {
+	retryBaseDelay = time.Hour
+	defer func() { retryBaseDelay = 500 * time.Millisecond }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		cancel()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewClient("user@example.com", "token", server.URL).WithContext(ctx)
+
+	_, err := client.doRequest("GET", server.URL, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if attempts != 1 {
+		t.Errorf("Expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestDoRequest_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := NewClient("user@example.com", "token", server.URL)
+	client.SetTimeout(20 * time.Millisecond)
+
+	if _, err := client.doRequest("GET", server.URL, nil); err == nil {
+		t.Fatal("Expected timeout error")
+	}
+}
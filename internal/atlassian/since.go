@@ -0,0 +1,58 @@
+package atlassian
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// relativeTimeRegexp matches JQL-style relative times such as -1d, -12h or -2w
+var relativeTimeRegexp = regexp.MustCompile(`^-(\d+)([mhdw])$`)
+
+// JiraTimeLayout is the timestamp format Jira uses for created/updated values
+const JiraTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// ParseSince converts a --since value into an absolute time. It accepts
+// JQL-style relative offsets (-30m, -12h, -1d, -2w) relative to now, as well
+// as dates ("2006-01-02") and date-times ("2006-01-02 15:04") in now's
+// location.
+func ParseSince(value string, now time.Time) (time.Time, error) {
+	if m := relativeTimeRegexp.FindStringSubmatch(value); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid time %q: %w", value, err)
+		}
+
+		var unit time.Duration
+		switch m[2] {
+		case "m":
+			unit = time.Minute
+		case "h":
+			unit = time.Hour
+		case "d":
+			unit = 24 * time.Hour
+		case "w":
+			unit = 7 * 24 * time.Hour
+		}
+		return now.Add(-time.Duration(n) * unit), nil
+	}
+
+	for _, layout := range []string{"2006-01-02", "2006-01-02 15:04"} {
+		if t, err := time.ParseInLocation(layout, value, now.Location()); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q. Use a relative offset like -1d or -12h, or a date like 2024-01-15", value)
+}
+
+// JQLRelativeTime returns t as a JQL relative offset from now in whole
+// minutes (e.g. "-90m"), rounded so the offset never falls after t. Unlike
+// an absolute date, which JQL reads in the user's profile timezone, an
+// offset means the same instant to Jira as it does locally.
+func JQLRelativeTime(t, now time.Time) string {
+	minutes := int(math.Ceil(now.Sub(t).Minutes()))
+	return fmt.Sprintf("-%dm", max(minutes, 0))
+}
@@ -0,0 +1,70 @@
+package atlassian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Time
+	}{
+		{"Minutes", "-30m", now.Add(-30 * time.Minute)},
+		{"Hours", "-12h", now.Add(-12 * time.Hour)},
+		{"Days", "-1d", now.Add(-24 * time.Hour)},
+		{"Weeks", "-2w", now.Add(-14 * 24 * time.Hour)},
+		{"Date", "2024-01-10", time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)},
+		{"Date and time", "2024-01-10 09:30", time.Date(2024, 1, 10, 9, 30, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseSince(tt.value, now)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !result.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestParseSince_Invalid(t *testing.T) {
+	now := time.Now()
+
+	for _, value := range []string{"", "1d", "-1y", "yesterday", "2024-13-01"} {
+		t.Run(value, func(t *testing.T) {
+			if _, err := ParseSince(value, now); err == nil {
+				t.Errorf("Expected error for %q", value)
+			}
+		})
+	}
+}
+
+func TestJQLRelativeTime(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		since    time.Time
+		expected string
+	}{
+		{"Whole minutes", now.Add(-90 * time.Minute), "-90m"},
+		{"Rounds to the earlier minute", now.Add(-90*time.Minute - 10*time.Second), "-91m"},
+		{"Other timezone", time.Date(2024, 1, 15, 6, 0, 0, 0, time.FixedZone("EST", -5*3600)), "-60m"},
+		{"Future", now.Add(time.Hour), "-0m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := JQLRelativeTime(tt.since, now); result != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
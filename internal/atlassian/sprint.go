@@ -0,0 +1,129 @@
+package atlassian
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SprintFieldType is the schema custom type of the Jira Software sprint field
+const SprintFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:gh-sprint"
+
+// Sprint is the readable part of a sprint field value
+type Sprint struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
+// ParseSprints extracts sprints from a sprint field value. Current Jira Cloud
+// returns a list of sprint objects; older instances return the legacy
+// greenhopper toString format, e.g.
+// "com.atlassian.greenhopper.service.sprint.Sprint@1a2b[id=42,rapidViewId=7,state=ACTIVE,name=Sprint 42,...]".
+// Both forms (and a single value of either) are accepted.
+func ParseSprints(value any) []Sprint {
+	var items []any
+	switch v := value.(type) {
+	case []any:
+		items = v
+	case nil:
+		return nil
+	default:
+		items = []any{v}
+	}
+
+	var sprints []Sprint
+	for _, item := range items {
+		switch v := item.(type) {
+		case map[string]any:
+			name, _ := v["name"].(string)
+			if name == "" {
+				continue
+			}
+			state, _ := v["state"].(string)
+			id, _ := v["id"].(float64)
+			sprints = append(sprints, Sprint{ID: int(id), Name: name, State: strings.ToLower(state)})
+		case string:
+			if sprint, ok := parseLegacySprint(v); ok {
+				sprints = append(sprints, sprint)
+			}
+		}
+	}
+
+	return sprints
+}
+
+// IsSprintValue reports whether a field value looks like sprint data, for
+// responses where the field schema isn't available
+func IsSprintValue(value any) bool {
+	items, ok := value.([]any)
+	if !ok || len(items) == 0 {
+		return false
+	}
+	switch v := items[0].(type) {
+	case map[string]any:
+		_, hasBoard := v["boardId"]
+		_, hasState := v["state"]
+		_, hasName := v["name"]
+		return hasBoard && hasState && hasName
+	case string:
+		return strings.Contains(v, "greenhopper.service.sprint.Sprint")
+	}
+	return false
+}
+
+// parseLegacySprint parses the legacy greenhopper sprint string format
+func parseLegacySprint(s string) (Sprint, bool) {
+	start := strings.Index(s, "[")
+	end := strings.LastIndex(s, "]")
+	if start < 0 || end <= start {
+		return Sprint{}, false
+	}
+
+	attrs := map[string]string{}
+	body := s[start+1 : end]
+
+	// Values may contain commas (sprint names often do), so split on the
+	// ",key=" boundaries instead of on every comma
+	for len(body) > 0 {
+		eq := strings.Index(body, "=")
+		if eq < 0 {
+			break
+		}
+		key := body[:eq]
+		rest := body[eq+1:]
+
+		next := nextLegacyAttr(rest)
+		if next < 0 {
+			attrs[key] = rest
+			break
+		}
+		attrs[key] = rest[:next]
+		body = rest[next+1:]
+	}
+
+	name := attrs["name"]
+	if name == "" || name == "<null>" {
+		return Sprint{}, false
+	}
+
+	id, _ := strconv.Atoi(attrs["id"])
+	return Sprint{ID: id, Name: name, State: strings.ToLower(attrs["state"])}, true
+}
+
+// nextLegacyAttr returns the index of the comma that starts the next
+// "key=" attribute in s, or -1 if there is none
+func nextLegacyAttr(s string) int {
+	for i := 0; i < len(s); i++ {
+		if s[i] != ',' {
+			continue
+		}
+		j := i + 1
+		for j < len(s) && (s[j] >= 'a' && s[j] <= 'z' || s[j] >= 'A' && s[j] <= 'Z') {
+			j++
+		}
+		if j > i+1 && j < len(s) && s[j] == '=' {
+			return i
+		}
+	}
+	return -1
+}
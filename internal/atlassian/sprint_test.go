@@ -0,0 +1,49 @@
+package atlassian
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSprints(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected []Sprint
+	}{
+		{
+			name: "Sprint objects",
+			value: []any{
+				map[string]any{"id": float64(41), "name": "Sprint 41", "state": "closed", "boardId": float64(7)},
+				map[string]any{"id": float64(42), "name": "Sprint 42", "state": "active", "boardId": float64(7)},
+			},
+			expected: []Sprint{{ID: 41, Name: "Sprint 41", State: "closed"}, {ID: 42, Name: "Sprint 42", State: "active"}},
+		},
+		{
+			name: "Legacy greenhopper string",
+			value: []any{
+				"com.atlassian.greenhopper.service.sprint.Sprint@1a2b3c[id=42,rapidViewId=7,state=ACTIVE,name=Sprint 42,startDate=2024-01-01T00:00:00.000Z,endDate=<null>,sequence=42]",
+			},
+			expected: []Sprint{{ID: 42, Name: "Sprint 42", State: "active"}},
+		},
+		{
+			name:     "Legacy name containing commas",
+			value:    "com.atlassian.greenhopper.service.sprint.Sprint@9[id=7,state=FUTURE,name=Q1, week 2, platform,goal=]",
+			expected: []Sprint{{ID: 7, Name: "Q1, week 2, platform", State: "future"}},
+		},
+		{
+			name:     "Nil",
+			value:    nil,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ParseSprints(tt.value)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, result)
+			}
+		})
+	}
+}
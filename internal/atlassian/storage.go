@@ -0,0 +1,179 @@
+package atlassian
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/util"
+)
+
+// MarkdownToStorage converts markdown (GitHub-flavored) to Confluence storage
+// format. Storage format is XHTML, so the output uses self-closing void
+// elements. Raw HTML in the markdown is dropped. Blockquotes starting with
+// an admonition marker such as [!NOTE] become Confluence panel macros.
+func MarkdownToStorage(markdown string) (string, error) {
+	gm := goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithRendererOptions(
+			html.WithXHTML(),
+			renderer.WithNodeRenderers(util.Prioritized(&storagePanelRenderer{}, 100)),
+		),
+	)
+
+	var buf bytes.Buffer
+	if err := gm.Convert([]byte(markdown), &buf); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// storageMacroNames maps admonition types to Confluence panel macros.
+// Confluence only has four, so success uses tip and error uses warning.
+var storageMacroNames = map[string]string{
+	"info":    "info",
+	"note":    "note",
+	"warning": "warning",
+	"success": "tip",
+	"error":   "warning",
+}
+
+// storagePanelRenderer renders admonition blockquotes as panel macros and
+// falls back to a plain <blockquote> for everything else
+type storagePanelRenderer struct{}
+
+func (r *storagePanelRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindBlockquote, r.renderBlockquote)
+}
+
+func (r *storagePanelRenderer) renderBlockquote(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	n := node.(*ast.Blockquote)
+	if entering {
+		macro := storageMacroNames[admonitionPanelType(n, source)]
+		if macro == "" {
+			_, _ = w.WriteString("<blockquote>\n")
+			return ast.WalkContinue, nil
+		}
+		// Remember the macro for the closing tag, since the marker is gone
+		// from the children by then
+		n.SetAttributeString("macro", macro)
+		stripAdmonitionMarker(n)
+		_, _ = w.WriteString(`<ac:structured-macro ac:name="` + macro + `"><ac:rich-text-body>` + "\n")
+		return ast.WalkContinue, nil
+	}
+
+	if _, ok := n.AttributeString("macro"); ok {
+		_, _ = w.WriteString("</ac:rich-text-body></ac:structured-macro>\n")
+	} else {
+		_, _ = w.WriteString("</blockquote>\n")
+	}
+	return ast.WalkContinue, nil
+}
+
+// ParseFrontMatter splits a leading front matter block (between "---" lines)
+// from a markdown document. Only simple "key: value" lines are read; quotes
+// around values are removed. Documents without front matter return a nil map
+// and the content unchanged.
+func ParseFrontMatter(content string) (map[string]string, string) {
+	content = strings.TrimPrefix(content, "\ufeff")
+	normalized := strings.ReplaceAll(content, "\r\n", "\n")
+	if !strings.HasPrefix(normalized, "---\n") {
+		return nil, content
+	}
+
+	rest := normalized[len("---\n"):]
+	end := strings.Index(rest, "\n---")
+	if end < 0 {
+		return nil, content
+	}
+	block := rest[:end]
+	body := rest[end+len("\n---"):]
+	if newline := strings.IndexByte(body, '\n'); newline >= 0 {
+		if strings.TrimSpace(body[:newline]) != "" {
+			// "---" followed by more text isn't a closing delimiter
+			return nil, content
+		}
+		body = body[newline+1:]
+	} else if strings.TrimSpace(body) != "" {
+		return nil, content
+	} else {
+		body = ""
+	}
+
+	values := map[string]string{}
+	for _, line := range strings.Split(block, "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok || strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		values[strings.TrimSpace(key)] = value
+	}
+	return values, body
+}
+
+// whitespaceRegexp matches runs of whitespace
+var whitespaceRegexp = regexp.MustCompile(`\s+`)
+
+// storageBlockTagRegexp matches a block-level storage format tag, along with
+// any whitespace around it. Whitespace next to these tags doesn't render;
+// whitespace next to inline tags such as strong does.
+var storageBlockTagRegexp = regexp.MustCompile(`\s*(</?(?:p|h[1-6]|ul|ol|li|table|colgroup|col|thead|tbody|tfoot|tr|th|td|blockquote|div|hr|br|pre|ac:structured-macro|ac:parameter|ac:rich-text-body|ac:plain-text-body|ac:layout|ac:layout-section|ac:layout-cell|ac:task-list|ac:task|ac:task-id|ac:task-status|ac:task-body)(?:\s[^>]*)?/?>)\s*`)
+
+// storagePreservedRegexps match storage content whose whitespace is
+// significant: CDATA sections, and the contents of pre, code, and
+// ac:plain-text-body elements (group 2). Later patterns run on the output of
+// earlier ones, so CDATA goes first.
+var storagePreservedRegexps = []*regexp.Regexp{
+	regexp.MustCompile(`(?s)()(<!\[CDATA\[.*?\]\]>)()`),
+	regexp.MustCompile(`(?s)(<pre(?:\s[^>]*[^/>])?>)(.*?)(</pre>)`),
+	regexp.MustCompile(`(?s)(<code(?:\s[^>]*[^/>])?>)(.*?)(</code>)`),
+	regexp.MustCompile(`(?s)(<ac:plain-text-body(?:\s[^>]*[^/>])?>)(.*?)(</ac:plain-text-body>)`),
+}
+
+// NormalizeStorage collapses insignificant whitespace in a storage format
+// body: runs of whitespace become one space, and whitespace next to
+// block-level tags and at either end is removed. Whitespace in CDATA and in
+// pre, code, and ac:plain-text-body elements is left alone.
+func NormalizeStorage(body string) string {
+	// Swap preserved content for placeholders so the whitespace rules skip it
+	var preserved []string
+	for _, re := range storagePreservedRegexps {
+		body = re.ReplaceAllStringFunc(body, func(match string) string {
+			groups := re.FindStringSubmatch(match)
+			preserved = append(preserved, groups[2])
+			return groups[1] + storagePlaceholder(len(preserved)-1) + groups[3]
+		})
+	}
+
+	body = whitespaceRegexp.ReplaceAllString(body, " ")
+	body = storageBlockTagRegexp.ReplaceAllString(body, "$1")
+	body = strings.TrimSpace(body)
+
+	// Restore in reverse, since later placeholders can contain earlier ones
+	for i := len(preserved) - 1; i >= 0; i-- {
+		body = strings.Replace(body, storagePlaceholder(i), preserved[i], 1)
+	}
+	return body
+}
+
+// storagePlaceholder stands in for the i'th preserved section. NUL can't
+// appear in XHTML, so it never collides with real content.
+func storagePlaceholder(i int) string {
+	return "\x00" + strconv.Itoa(i) + "\x00"
+}
+
+// StorageBodiesEqual reports whether two storage format bodies differ only
+// in whitespace, so updating one to the other would be a no-op
+func StorageBodiesEqual(a, b string) bool {
+	return NormalizeStorage(a) == NormalizeStorage(b)
+}
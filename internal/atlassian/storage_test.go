@@ -0,0 +1,136 @@
+package atlassian
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMarkdownToStorage(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		contains []string
+	}{
+		{"Heading and paragraph", "# Title\n\nSome *text*.", []string{"<h1>Title</h1>", "<p>Some <em>text</em>.</p>"}},
+		{"Self-closing break", "line one  \nline two", []string{"<br />"}},
+		{"Rule", "a\n\n---\n\nb", []string{"<hr />"}},
+		{"Table", "| A | B |\n|---|---|\n| 1 | 2 |", []string{"<table>", "<th>A</th>", "<td>2</td>"}},
+		{"Code block", "```go\nfmt.Println(1 < 2)\n```", []string{"<pre><code", "1 &lt; 2"}},
+		{"Emphasis", "**bold** and _italic_", []string{"<strong>bold</strong>", "<em>italic</em>"}},
+		{"Lists", "- one\n- two\n\n1. first", []string{"<ul>", "<li>one</li>", "<ol>", "<li>first</li>"}},
+		{"Link", "[docs](https://example.com/a?b=1&c=2)", []string{`<a href="https://example.com/a?b=1&amp;c=2">docs</a>`}},
+		{"Blockquote", "> quoted", []string{"<blockquote>", "<p>quoted</p>", "</blockquote>"}},
+		{"Info panel", "> [!INFO]\n> Heads **up**", []string{`<ac:structured-macro ac:name="info"><ac:rich-text-body>`, "<p>Heads <strong>up</strong></p>", "</ac:rich-text-body></ac:structured-macro>"}},
+		{"Success panel", "> [!success]\n> Done", []string{`ac:name="tip"`, "<p>Done</p>"}},
+		{"Error panel", "> [!ERROR]\n> Broken", []string{`ac:name="warning"`, "<p>Broken</p>"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := MarkdownToStorage(tt.markdown)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(result, want) {
+					t.Errorf("Expected output to contain %q, got %q", want, result)
+				}
+			}
+		})
+	}
+}
+
+func TestMarkdownToStorage_PanelMarkerOnly(t *testing.T) {
+	result, err := MarkdownToStorage("> [!NOTE]\n\n> plain")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if strings.Contains(result, "[!NOTE]") {
+		t.Errorf("Expected the marker to be removed, got %q", result)
+	}
+	if !strings.Contains(result, `ac:name="note"`) || !strings.Contains(result, "<blockquote>") {
+		t.Errorf("Expected a note panel followed by a blockquote, got %q", result)
+	}
+}
+
+func TestMarkdownToStorage_DropsRawHTML(t *testing.T) {
+	result, err := MarkdownToStorage("<script>alert(1)</script>\n\ntext")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if strings.Contains(result, "<script>") {
+		t.Errorf("Expected raw HTML to be dropped, got %q", result)
+	}
+}
+
+func TestParseFrontMatter(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		title    string
+		body     string
+		hasFront bool
+	}{
+		{"With title", "---\ntitle: Getting Started\n---\n# Hello\n", "Getting Started", "# Hello\n", true},
+		{"Quoted title", "---\ntitle: \"Setup: Part 1\"\nauthor: me\n---\nbody", "Setup: Part 1", "body", true},
+		{"CRLF line endings", "---\r\ntitle: Windows\r\n---\r\nbody", "Windows", "body", true},
+		{"No front matter", "# Just markdown\n", "", "# Just markdown\n", false},
+		{"Unclosed block", "---\ntitle: Oops\n# body", "", "---\ntitle: Oops\n# body", false},
+		{"Front matter only", "---\ntitle: Empty\n---", "Empty", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, body := ParseFrontMatter(tt.content)
+			if (values != nil) != tt.hasFront {
+				t.Fatalf("Expected front matter %v, got %v", tt.hasFront, values)
+			}
+			if values["title"] != tt.title {
+				t.Errorf("Expected title %q, got %q", tt.title, values["title"])
+			}
+			if body != tt.body {
+				t.Errorf("Expected body %q, got %q", tt.body, body)
+			}
+		})
+	}
+}
+
+func TestNormalizeStorage(t *testing.T) {
+	input := "<h1> Title </h1>\n<p>Some   <strong>bold</strong>\ntext</p>\n<pre>line 1\n    line 2</pre>\n<ac:structured-macro ac:name=\"code\">\n  <ac:plain-text-body><![CDATA[a\n\tb]]></ac:plain-text-body>\n</ac:structured-macro>\n"
+	expected := "<h1>Title</h1><p>Some <strong>bold</strong> text</p><pre>line 1\n    line 2</pre><ac:structured-macro ac:name=\"code\"><ac:plain-text-body><![CDATA[a\n\tb]]></ac:plain-text-body></ac:structured-macro>"
+
+	if result := NormalizeStorage(input); result != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, result)
+	}
+}
+
+func TestStorageBodiesEqual(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{"Identical", "<p>Hello</p>", "<p>Hello</p>", true},
+		{"Whitespace between tags", "<h1>Title</h1>\n<p>Hello</p>\n", "<h1>Title</h1><p>Hello</p>", true},
+		{"Collapsed spaces", "<p>Hello   world</p>", "<p>Hello\n world</p>", true},
+		{"Surrounding whitespace", "  <p>Hello</p>  ", "<p>Hello</p>", true},
+		{"Different text", "<p>Hello</p>", "<p>Goodbye</p>", false},
+		{"Word boundary kept", "<p>Hello world</p>", "<p>Helloworld</p>", false},
+		{"Space between inline tags kept", "<p><strong>a</strong> <em>b</em></p>", "<p><strong>a</strong><em>b</em></p>", false},
+		{"Space inside paragraph edges", "<p>\n  Hello\n</p>", "<p>Hello</p>", true},
+		{"Indentation in pre kept", "<pre>if x:\n    y</pre>", "<pre>if x:\n y</pre>", false},
+		{"Whitespace around pre", "<p>a</p>\n<pre>x  y</pre>\n", "<p>a</p><pre>x  y</pre>", true},
+		{"Spaces in code kept", "<p><code>a  b</code></p>", "<p><code>a b</code></p>", false},
+		{"CDATA kept", "<ac:plain-text-body><![CDATA[a\n  b]]></ac:plain-text-body>", "<ac:plain-text-body><![CDATA[a\n b]]></ac:plain-text-body>", false},
+		{"Macro layout", "<ac:structured-macro ac:name=\"code\">\n  <ac:parameter ac:name=\"language\">go</ac:parameter>\n  <ac:plain-text-body><![CDATA[x  := 1]]></ac:plain-text-body>\n</ac:structured-macro>", "<ac:structured-macro ac:name=\"code\"><ac:parameter ac:name=\"language\">go</ac:parameter><ac:plain-text-body><![CDATA[x  := 1]]></ac:plain-text-body></ac:structured-macro>", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := StorageBodiesEqual(tt.a, tt.b); result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
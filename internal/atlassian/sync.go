@@ -0,0 +1,143 @@
+package atlassian
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SyncDocument is one page to publish from a directory of markdown files
+type SyncDocument struct {
+	Path     string // Path relative to the synced directory (a file, or a directory)
+	Title    string // Page title from front matter, or the file or directory name
+	Markdown string // Page body, without front matter
+	Parent   string // Path of the parent document, or "" for top-level pages
+}
+
+// syncIndexFiles name the file that holds a directory's own page content
+var syncIndexFiles = []string{"index.md", "README.md"}
+
+// CollectSyncDocuments walks dir and returns a document for every markdown
+// file and subdirectory, parents before children. A subdirectory becomes a
+// page whose children are the files inside it; its content and title come
+// from an index.md or README.md in it when present. Hidden files and
+// directories are skipped. Titles must be unique, since Confluence requires
+// unique titles within a space.
+func CollectSyncDocuments(dir string) ([]SyncDocument, error) {
+	var docs []SyncDocument
+	titles := map[string]string{}
+
+	add := func(doc SyncDocument) error {
+		if other, ok := titles[doc.Title]; ok {
+			return fmt.Errorf("%s and %s both have the title '%s'", other, doc.Path, doc.Title)
+		}
+		titles[doc.Title] = doc.Path
+		docs = append(docs, doc)
+		return nil
+	}
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		if strings.HasPrefix(entry.Name(), ".") {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		rel = filepath.ToSlash(rel)
+		parent := syncParent(rel)
+
+		if entry.IsDir() {
+			doc := SyncDocument{Path: rel, Title: entry.Name(), Parent: parent}
+			if indexPath := findIndexFile(path); indexPath != "" {
+				front, body, err := readMarkdownFile(indexPath)
+				if err != nil {
+					return err
+				}
+				if front["title"] != "" {
+					doc.Title = front["title"]
+				}
+				doc.Markdown = body
+			}
+			return add(doc)
+		}
+
+		if !isMarkdownFile(entry.Name()) || (parent != "" && isIndexFile(entry.Name())) {
+			return nil
+		}
+
+		front, body, err := readMarkdownFile(path)
+		if err != nil {
+			return err
+		}
+		title := front["title"]
+		if title == "" {
+			title = strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+		}
+		return add(SyncDocument{Path: rel, Title: title, Markdown: body, Parent: parent})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return docs, nil
+}
+
+// syncParent returns the parent document path for a relative path
+func syncParent(rel string) string {
+	if idx := strings.LastIndex(rel, "/"); idx >= 0 {
+		return rel[:idx]
+	}
+	return ""
+}
+
+func isMarkdownFile(name string) bool {
+	ext := strings.ToLower(filepath.Ext(name))
+	return ext == ".md" || ext == ".markdown"
+}
+
+func isIndexFile(name string) bool {
+	for _, index := range syncIndexFiles {
+		if strings.EqualFold(name, index) {
+			return true
+		}
+	}
+	return false
+}
+
+// findIndexFile returns the path of the index file in dir, or "" if none
+func findIndexFile(dir string) string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return ""
+	}
+	for _, index := range syncIndexFiles {
+		for _, entry := range entries {
+			if !entry.IsDir() && strings.EqualFold(entry.Name(), index) {
+				return filepath.Join(dir, entry.Name())
+			}
+		}
+	}
+	return ""
+}
+
+func readMarkdownFile(path string) (map[string]string, string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	front, body := ParseFrontMatter(string(data))
+	return front, body, nil
+}
@@ -0,0 +1,68 @@
+package atlassian
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeSyncFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+	return dir
+}
+
+func TestCollectSyncDocuments(t *testing.T) {
+	dir := writeSyncFiles(t, map[string]string{
+		"README.md":             "# Home",
+		"install.md":            "---\ntitle: Installing\n---\nSteps",
+		"guide/index.md":        "---\ntitle: User Guide\n---\nOverview",
+		"guide/basics.md":       "Basics",
+		"guide/advanced/api.md": "API",
+		"notes.txt":             "not markdown",
+		".github/template.md":   "hidden",
+	})
+
+	docs, err := CollectSyncDocuments(dir)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var got []string
+	for _, doc := range docs {
+		got = append(got, doc.Path+"|"+doc.Title+"|"+doc.Parent+"|"+doc.Markdown)
+	}
+	expected := []string{
+		"README.md|README||# Home",
+		"guide|User Guide||Overview",
+		"guide/advanced|advanced|guide|",
+		"guide/advanced/api.md|api|guide/advanced|API",
+		"guide/basics.md|basics|guide|Basics",
+		"install.md|Installing||Steps",
+	}
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Unexpected documents:\n%s\nexpected:\n%s", strings.Join(got, "\n"), strings.Join(expected, "\n"))
+	}
+}
+
+func TestCollectSyncDocuments_DuplicateTitles(t *testing.T) {
+	dir := writeSyncFiles(t, map[string]string{
+		"a/setup.md": "one",
+		"b/setup.md": "two",
+	})
+
+	_, err := CollectSyncDocuments(dir)
+	if err == nil || !strings.Contains(err.Error(), "'setup'") {
+		t.Errorf("Expected duplicate title error, got %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+package atlassian
+
+import (
+	"html"
+	"regexp"
+	"sort"
+)
+
+// templateVarRegexp matches template placeholders: ${name} as well as the
+// <at:var at:name="name"/> elements Confluence uses in storage format
+var templateVarRegexp = regexp.MustCompile(`\$\{([A-Za-z0-9_.-]+)\}|<at:var\s+at:name="([^"]+)"\s*/>`)
+
+// TemplateVariables returns the names of the placeholders in a template body,
+// sorted and without duplicates
+func TemplateVariables(body string) []string {
+	seen := map[string]bool{}
+	var names []string
+	for _, match := range templateVarRegexp.FindAllStringSubmatch(body, -1) {
+		name := match[1]
+		if name == "" {
+			name = match[2]
+		}
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SubstituteTemplateVariables replaces each placeholder in a template body
+// with its HTML-escaped value. It returns the names of any placeholders that
+// had no value; those are left in place.
+func SubstituteTemplateVariables(body string, vars map[string]string) (string, []string) {
+	missingSet := map[string]bool{}
+	result := templateVarRegexp.ReplaceAllStringFunc(body, func(placeholder string) string {
+		match := templateVarRegexp.FindStringSubmatch(placeholder)
+		name := match[1]
+		if name == "" {
+			name = match[2]
+		}
+		value, ok := vars[name]
+		if !ok {
+			missingSet[name] = true
+			return placeholder
+		}
+		return html.EscapeString(value)
+	})
+
+	missing := make([]string, 0, len(missingSet))
+	for name := range missingSet {
+		missing = append(missing, name)
+	}
+	sort.Strings(missing)
+	return result, missing
+}
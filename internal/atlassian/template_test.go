@@ -0,0 +1,43 @@
+package atlassian
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTemplateVariables(t *testing.T) {
+	body := `<p>${owner} owns ${service}</p><p><at:var at:name="date" /></p><p>${owner}</p>`
+
+	expected := []string{"date", "owner", "service"}
+	if result := TemplateVariables(body); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestSubstituteTemplateVariables(t *testing.T) {
+	body := `<p>${owner} owns ${service}</p><p><at:var at:name="date"/></p>`
+
+	result, missing := SubstituteTemplateVariables(body, map[string]string{
+		"owner":   "Ops & SRE",
+		"service": "billing",
+	})
+
+	expected := `<p>Ops &amp; SRE owns billing</p><p><at:var at:name="date"/></p>`
+	if result != expected {
+		t.Errorf("Expected %q, got %q", expected, result)
+	}
+	if !reflect.DeepEqual(missing, []string{"date"}) {
+		t.Errorf("Expected missing [date], got %v", missing)
+	}
+}
+
+func TestSubstituteTemplateVariables_AllSupplied(t *testing.T) {
+	result, missing := SubstituteTemplateVariables(`<at:var at:name="date"/>`, map[string]string{"date": "2024-01-15"})
+
+	if result != "2024-01-15" {
+		t.Errorf("Expected 2024-01-15, got %q", result)
+	}
+	if len(missing) != 0 {
+		t.Errorf("Expected no missing variables, got %v", missing)
+	}
+}
@@ -0,0 +1,59 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FindTransitionToStatus picks the transition, from a get-transitions
+// response's "transitions" list, whose destination status matches status
+// (case-insensitive). When several lead there, the one whose own name also
+// matches is preferred; otherwise the caller must choose by ID.
+func FindTransitionToStatus(transitions []any, status string) (string, error) {
+	var matches []map[string]any
+	var destinations []string
+	seen := map[string]bool{}
+	for _, t := range transitions {
+		transition, _ := t.(map[string]any)
+		destination, _ := transition["to"].(map[string]any)
+		to, _ := destination["name"].(string)
+		if strings.EqualFold(to, status) {
+			matches = append(matches, transition)
+		}
+		if to != "" && !seen[to] {
+			seen[to] = true
+			destinations = append(destinations, to)
+		}
+	}
+
+	if len(matches) > 1 {
+		var named []map[string]any
+		for _, transition := range matches {
+			if name, _ := transition["name"].(string); strings.EqualFold(name, status) {
+				named = append(named, transition)
+			}
+		}
+		if len(named) == 1 {
+			matches = named
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		if len(destinations) == 0 {
+			return "", fmt.Errorf("no transition leads to status '%s'; no transitions are available", status)
+		}
+		return "", fmt.Errorf("no transition leads to status '%s'; available statuses: %s", status, strings.Join(destinations, ", "))
+	case 1:
+		id, _ := matches[0]["id"].(string)
+		return id, nil
+	}
+
+	var options []string
+	for _, transition := range matches {
+		id, _ := transition["id"].(string)
+		name, _ := transition["name"].(string)
+		options = append(options, fmt.Sprintf("%s (%s)", id, name))
+	}
+	return "", fmt.Errorf("several transitions lead to status '%s': %s; give the transition ID instead", status, strings.Join(options, ", "))
+}
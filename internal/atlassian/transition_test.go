@@ -0,0 +1,63 @@
+package atlassian
+
+import (
+	"strings"
+	"testing"
+)
+
+func testTransition(id, name, to string) map[string]any {
+	return map[string]any{"id": id, "name": name, "to": map[string]any{"name": to}}
+}
+
+func TestFindTransitionToStatus(t *testing.T) {
+	transitions := []any{
+		testTransition("11", "Start work", "In Progress"),
+		testTransition("21", "Done", "Done"),
+		testTransition("31", "Won't fix", "Done"),
+		testTransition("41", "Review", "In Review"),
+		testTransition("51", "Send to review", "In Review"),
+	}
+
+	tests := []struct {
+		status   string
+		expected string
+	}{
+		{"In Progress", "11"},
+		{"in progress", "11"},
+		{"DONE", "21"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			id, err := FindTransitionToStatus(transitions, tt.status)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if id != tt.expected {
+				t.Errorf("Expected transition %s, got %s", tt.expected, id)
+			}
+		})
+	}
+}
+
+func TestFindTransitionToStatus_Errors(t *testing.T) {
+	transitions := []any{
+		testTransition("11", "Start work", "In Progress"),
+		testTransition("41", "Submit", "In Review"),
+		testTransition("51", "Send to review", "In Review"),
+	}
+
+	_, err := FindTransitionToStatus(transitions, "Closed")
+	if err == nil || !strings.Contains(err.Error(), "available statuses: In Progress, In Review") {
+		t.Errorf("Expected the available statuses to be listed, got %v", err)
+	}
+
+	_, err = FindTransitionToStatus(transitions, "In Review")
+	if err == nil || !strings.Contains(err.Error(), "41 (Submit), 51 (Send to review)") {
+		t.Errorf("Expected the ambiguous transitions to be listed, got %v", err)
+	}
+
+	if _, err := FindTransitionToStatus(nil, "Done"); err == nil {
+		t.Error("Expected error when no transitions are available")
+	}
+}
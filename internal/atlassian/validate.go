@@ -0,0 +1,196 @@
+package atlassian
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FieldProblem describes a field that would make an issue create request fail
+type FieldProblem struct {
+	Field   string `json:"field"`
+	Name    string `json:"name,omitempty"`
+	Problem string `json:"problem"`
+}
+
+// ValidateCreateFields checks the fields of an issue create request against
+// the create metadata for the project and issue type (as returned by
+// GetCreateMeta). It reports required fields that are missing, fields that
+// are not available for the issue type, and values that aren't among a
+// field's allowed values.
+func ValidateCreateFields(meta map[string]any, fields map[string]any) []FieldProblem {
+	var problems []FieldProblem
+
+	metaFields, _ := meta["fields"].([]any)
+	known := make(map[string]map[string]any, len(metaFields))
+	for _, item := range metaFields {
+		field, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		key, _ := field["key"].(string)
+		if key == "" {
+			key, _ = field["fieldId"].(string)
+		}
+		known[key] = field
+	}
+
+	// Required fields without a default must be supplied
+	for _, item := range metaFields {
+		field, _ := item.(map[string]any)
+		key, _ := field["key"].(string)
+		if key == "" {
+			key, _ = field["fieldId"].(string)
+		}
+		name, _ := field["name"].(string)
+		required, _ := field["required"].(bool)
+		hasDefault, _ := field["hasDefaultValue"].(bool)
+
+		if required && !hasDefault && isEmptyFieldValue(fields[key]) {
+			problems = append(problems, FieldProblem{Field: key, Name: name, Problem: "required field is missing"})
+		}
+	}
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		// project and issuetype are implied by the metadata request itself
+		if key == "project" || key == "issuetype" {
+			continue
+		}
+
+		field, ok := known[key]
+		if !ok {
+			problems = append(problems, FieldProblem{Field: key, Problem: "field is not available for this project and issue type"})
+			continue
+		}
+
+		name, _ := field["name"].(string)
+		allowed, _ := field["allowedValues"].([]any)
+		if len(allowed) == 0 {
+			continue
+		}
+
+		values, isList := fields[key].([]any)
+		if !isList {
+			values = []any{fields[key]}
+		}
+		for _, value := range values {
+			if !isAllowedValue(value, allowed) {
+				problems = append(problems, FieldProblem{Field: key, Name: name, Problem: fmt.Sprintf("value %s is not allowed", describeFieldValue(value))})
+			}
+		}
+	}
+
+	return problems
+}
+
+// isEmptyFieldValue reports whether a field value counts as not supplied
+func isEmptyFieldValue(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+	return false
+}
+
+// isAllowedValue reports whether value matches one of the allowed values by
+// id, key, name, or value. Values that identify nothing (e.g. plain numbers)
+// are accepted, since Jira may interpret them in field-specific ways.
+func isAllowedValue(value any, allowed []any) bool {
+	ref, ok := value.(map[string]any)
+	if !ok {
+		return true
+	}
+
+	matched := false
+	for _, attr := range []string{"id", "key", "name", "value"} {
+		want, ok := ref[attr].(string)
+		if !ok {
+			continue
+		}
+		matched = true
+		for _, a := range allowed {
+			option, _ := a.(map[string]any)
+			if have, _ := option[attr].(string); strings.EqualFold(have, want) {
+				return true
+			}
+		}
+	}
+
+	return !matched
+}
+
+// describeFieldValue formats a field value reference for error messages
+func describeFieldValue(value any) string {
+	if ref, ok := value.(map[string]any); ok {
+		for _, attr := range []string{"id", "key", "name", "value"} {
+			if s, ok := ref[attr].(string); ok {
+				return fmt.Sprintf("%s %q", attr, s)
+			}
+		}
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// issueTypeLevel returns an issue type's hierarchy level: -1 for subtasks,
+// 0 for standard issues, 1 for epics. Older responses without
+// hierarchyLevel fall back to the subtask flag and the "Epic" name.
+func issueTypeLevel(issueType map[string]any) int {
+	if level, ok := issueType["hierarchyLevel"].(float64); ok {
+		return int(level)
+	}
+	if subtask, _ := issueType["subtask"].(bool); subtask {
+		return -1
+	}
+	if name, _ := issueType["name"].(string); strings.EqualFold(name, "Epic") {
+		return 1
+	}
+	return 0
+}
+
+// CheckParentRelationship verifies that an issue of childType can be created
+// under parent (an issue fetched with at least the issuetype and project
+// fields). Jira requires a parent exactly one hierarchy level above the child:
+// sub-tasks go under standard issues in the same project, and standard issues
+// go under epics.
+func CheckParentRelationship(childType map[string]any, childProject string, parent map[string]any) error {
+	parentKey, _ := parent["key"].(string)
+	fields, _ := parent["fields"].(map[string]any)
+	parentType, _ := fields["issuetype"].(map[string]any)
+	project, _ := fields["project"].(map[string]any)
+	parentProject, _ := project["key"].(string)
+
+	childName, _ := childType["name"].(string)
+	parentName, _ := parentType["name"].(string)
+	childLevel := issueTypeLevel(childType)
+	parentLevel := issueTypeLevel(parentType)
+
+	if childLevel+1 == parentLevel {
+		if childLevel == -1 && parentProject != "" && !strings.EqualFold(parentProject, childProject) {
+			return fmt.Errorf("%s is in project %s, but a sub-task's parent must be in the same project (%s)", parentKey, parentProject, childProject)
+		}
+		return nil
+	}
+
+	switch {
+	case childLevel == -1:
+		return fmt.Errorf("%s is a %s, but a sub-task's parent must be a standard issue (e.g. Story, Task, Bug)", parentKey, parentName)
+	case childLevel == 0 && parentLevel == 0:
+		return fmt.Errorf("%s is a %s, but a %s's parent must be an Epic. To create a sub-task under %s, use a sub-task issue type instead", parentKey, parentName, childName, parentKey)
+	case childLevel == 0:
+		return fmt.Errorf("%s is a %s, but a %s's parent must be an Epic", parentKey, parentName, childName)
+	default:
+		return fmt.Errorf("%s is a %s, which can't be the parent of a %s", parentKey, parentName, childName)
+	}
+}
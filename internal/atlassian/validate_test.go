@@ -0,0 +1,128 @@
+package atlassian
+
+import (
+	"testing"
+)
+
+func TestValidateCreateFields(t *testing.T) {
+	meta := map[string]any{
+		"fields": []any{
+			map[string]any{"key": "summary", "name": "Summary", "required": true},
+			map[string]any{"key": "reporter", "name": "Reporter", "required": true, "hasDefaultValue": true},
+			map[string]any{"key": "customfield_10010", "name": "Team", "required": true},
+			map[string]any{
+				"key":      "priority",
+				"name":     "Priority",
+				"required": false,
+				"allowedValues": []any{
+					map[string]any{"id": "1", "name": "High"},
+					map[string]any{"id": "2", "name": "Low"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		fields   map[string]any
+		expected []string
+	}{
+		{
+			name: "Valid",
+			fields: map[string]any{
+				"project":           map[string]any{"key": "ABC"},
+				"summary":           "Test",
+				"customfield_10010": "Platform",
+				"priority":          map[string]any{"name": "high"},
+			},
+		},
+		{
+			name: "Missing required field",
+			fields: map[string]any{
+				"summary": "Test",
+			},
+			expected: []string{"customfield_10010"},
+		},
+		{
+			name: "Empty required field",
+			fields: map[string]any{
+				"summary":           "  ",
+				"customfield_10010": "Platform",
+			},
+			expected: []string{"summary"},
+		},
+		{
+			name: "Unknown field",
+			fields: map[string]any{
+				"summary":           "Test",
+				"customfield_10010": "Platform",
+				"customfield_99999": "x",
+			},
+			expected: []string{"customfield_99999"},
+		},
+		{
+			name: "Disallowed value",
+			fields: map[string]any{
+				"summary":           "Test",
+				"customfield_10010": "Platform",
+				"priority":          map[string]any{"id": "9"},
+			},
+			expected: []string{"priority"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems := ValidateCreateFields(meta, tt.fields)
+			if len(problems) != len(tt.expected) {
+				t.Fatalf("Expected %d problems, got %d: %+v", len(tt.expected), len(problems), problems)
+			}
+			for i, field := range tt.expected {
+				if problems[i].Field != field {
+					t.Errorf("Expected problem with %s, got %s", field, problems[i].Field)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckParentRelationship(t *testing.T) {
+	subtask := map[string]any{"name": "Sub-task", "hierarchyLevel": float64(-1)}
+	story := map[string]any{"name": "Story", "hierarchyLevel": float64(0)}
+	epic := map[string]any{"name": "Epic", "hierarchyLevel": float64(1)}
+
+	parent := func(key, project string, issueType map[string]any) map[string]any {
+		return map[string]any{
+			"key": key,
+			"fields": map[string]any{
+				"issuetype": issueType,
+				"project":   map[string]any{"key": project},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		childType map[string]any
+		parent    map[string]any
+		wantErr   bool
+	}{
+		{"Sub-task under story", subtask, parent("ABC-1", "ABC", story), false},
+		{"Story under epic", story, parent("ABC-2", "ABC", epic), false},
+		{"Story under epic in another project", story, parent("XYZ-2", "XYZ", epic), false},
+		{"Sub-task under epic", subtask, parent("ABC-2", "ABC", epic), true},
+		{"Sub-task under sub-task", subtask, parent("ABC-3", "ABC", subtask), true},
+		{"Sub-task under story in another project", subtask, parent("XYZ-1", "XYZ", story), true},
+		{"Story under story", story, parent("ABC-1", "ABC", story), true},
+		{"Legacy subtask flag", map[string]any{"name": "Sub-task", "subtask": true}, parent("ABC-1", "ABC", map[string]any{"name": "Task"}), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckParentRelationship(tt.childType, "ABC", tt.parent)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Expected error: %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
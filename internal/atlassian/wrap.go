@@ -0,0 +1,57 @@
+package atlassian
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// listMarkerRegexp matches the indentation and bullet or number that start a
+// list item, so wrapped lines can hang under the item's text
+var listMarkerRegexp = regexp.MustCompile(`^\s*(?:[-*•]|\d+[.)]|\[[ x]\])\s+`)
+
+// WrapText word-wraps each line of text to at most width characters. Leading
+// indentation is kept, and continuation lines of list items are indented to
+// line up with the item text. Words longer than width are left intact. A
+// width of 0 or less returns text unchanged.
+func WrapText(text string, width int) string {
+	if width <= 0 {
+		return text
+	}
+
+	lines := strings.Split(text, "\n")
+	var wrapped []string
+	for _, line := range lines {
+		wrapped = append(wrapped, wrapLine(line, width)...)
+	}
+	return strings.Join(wrapped, "\n")
+}
+
+func wrapLine(line string, width int) []string {
+	if utf8.RuneCountInString(line) <= width {
+		return []string{line}
+	}
+
+	prefix := listMarkerRegexp.FindString(line)
+	if prefix == "" {
+		prefix = line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+	}
+	hang := strings.Repeat(" ", utf8.RuneCountInString(prefix))
+
+	words := strings.Fields(line[len(prefix):])
+	if len(words) == 0 {
+		return []string{line}
+	}
+
+	var result []string
+	current := prefix + words[0]
+	for _, word := range words[1:] {
+		if utf8.RuneCountInString(current)+1+utf8.RuneCountInString(word) > width {
+			result = append(result, current)
+			current = hang + word
+			continue
+		}
+		current += " " + word
+	}
+	return append(result, current)
+}
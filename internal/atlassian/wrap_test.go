@@ -0,0 +1,32 @@
+package atlassian
+
+import (
+	"testing"
+)
+
+func TestWrapText(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		width    int
+		expected string
+	}{
+		{"No wrap", "the quick brown fox", 0, "the quick brown fox"},
+		{"Short line", "the quick brown fox", 40, "the quick brown fox"},
+		{"Wraps at word boundary", "the quick brown fox jumps", 10, "the quick\nbrown fox\njumps"},
+		{"Keeps indentation", "  the quick brown fox", 12, "  the quick\n  brown fox"},
+		{"Hangs list items", "• the quick brown fox", 12, "• the quick\n  brown fox"},
+		{"Hangs numbered items", "1. the quick brown fox", 12, "1. the quick\n   brown fox"},
+		{"Long word left intact", "supercalifragilistic word", 10, "supercalifragilistic\nword"},
+		{"Multiple lines", "short\nthe quick brown fox", 10, "short\nthe quick\nbrown fox"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := WrapText(tt.text, tt.width)
+			if result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
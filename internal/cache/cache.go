@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Store is a directory of short-lived cached API responses
+type Store struct {
+	Dir string
+}
+
+// entry is the on-disk form of a cached value
+type entry struct {
+	Key       string          `json:"key"`
+	FetchedAt time.Time       `json:"fetched_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// DefaultStore returns the store under the user's cache directory
+func DefaultStore() (*Store, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cache directory: %w", err)
+	}
+	return &Store{Dir: filepath.Join(dir, "atlassian")}, nil
+}
+
+func (s *Store) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.Dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get decodes the value cached under key into v. It reports false if there
+// is no entry or the entry is older than maxAge.
+func (s *Store) Get(key string, maxAge time.Duration, v any) (bool, error) {
+	data, err := os.ReadFile(s.path(key))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read cache: %w", err)
+	}
+
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil || e.Key != key {
+		// Treat corrupt or colliding entries as a miss; they'll be overwritten
+		return false, nil
+	}
+	if time.Since(e.FetchedAt) > maxAge {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(e.Data, v); err != nil {
+		return false, nil
+	}
+	return true, nil
+}
+
+// Put caches v under key
+func (s *Store) Put(key string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache entry: %w", err)
+	}
+
+	e, err := json.Marshal(entry{Key: key, FetchedAt: time.Now(), Data: data})
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache entry: %w", err)
+	}
+
+	if err := os.MkdirAll(s.Dir, 0700); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	// Cached responses can contain private content, so keep them user-only
+	if err := os.WriteFile(s.path(key), e, 0600); err != nil {
+		return fmt.Errorf("failed to write cache: %w", err)
+	}
+
+	return nil
+}
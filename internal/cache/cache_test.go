@@ -0,0 +1,76 @@
+package cache
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestStore_PutAndGet(t *testing.T) {
+	store := &Store{Dir: t.TempDir()}
+
+	if err := store.Put("ari:cloud:jira::issue/1", map[string]any{"key": "ABC-1"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var result map[string]any
+	hit, err := store.Get("ari:cloud:jira::issue/1", time.Minute, &result)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !hit {
+		t.Fatal("Expected cache hit")
+	}
+	if result["key"] != "ABC-1" {
+		t.Errorf("Expected key ABC-1, got %v", result["key"])
+	}
+}
+
+func TestStore_Miss(t *testing.T) {
+	store := &Store{Dir: t.TempDir()}
+
+	var result map[string]any
+	hit, err := store.Get("missing", time.Minute, &result)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if hit {
+		t.Error("Expected cache miss")
+	}
+}
+
+func TestStore_Expired(t *testing.T) {
+	store := &Store{Dir: t.TempDir()}
+
+	// Write an entry fetched an hour ago
+	data, _ := json.Marshal(entry{Key: "key", FetchedAt: time.Now().Add(-time.Hour), Data: json.RawMessage(`"value"`)})
+	if err := os.WriteFile(store.path("key"), data, 0600); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var result string
+	hit, err := store.Get("key", time.Minute, &result)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if hit {
+		t.Error("Expected expired entry to miss")
+	}
+}
+
+func TestStore_FilePermissions(t *testing.T) {
+	store := &Store{Dir: t.TempDir()}
+
+	if err := store.Put("key", "value"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	info, err := os.Stat(store.path("key"))
+	if err != nil {
+		t.Fatalf("Expected cache file, got %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("Expected permissions 0600, got %o", info.Mode().Perm())
+	}
+}
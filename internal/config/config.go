@@ -5,19 +5,99 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 )
 
+// CurrentSchemaVersion is the config format written by this version of the
+// CLI. Bump it and add an entry to migrations when the format changes.
+const CurrentSchemaVersion = 1
+
+// migrations[v] upgrades a config from schema version v to v+1
+var migrations = []func(*Config){
+	// 0 → 1: unversioned configs predate defaults and per-account cloud IDs.
+	// Cloud IDs need a network request, so 'atl config migrate' fills them in.
+	func(c *Config) {
+		if c.Accounts == nil {
+			c.Accounts = make(map[string]*Account)
+		}
+		if c.Defaults == nil {
+			c.Defaults = make(map[string]string)
+		}
+	},
+}
+
 // Config represents the CLI configuration
 type Config struct {
-	ActiveAccount string              `json:"active_account,omitempty"`
-	Accounts      map[string]*Account `json:"accounts,omitempty"`
+	SchemaVersion int                     `json:"schema_version"`
+	ActiveAccount string                  `json:"active_account,omitempty"`
+	Accounts      map[string]*Account     `json:"accounts,omitempty"`
+	SavedSearches map[string]*SavedSearch `json:"saved_searches,omitempty"`
+	Defaults      map[string]string       `json:"defaults,omitempty"`
+	Teams         map[string][]string     `json:"teams,omitempty"` // Team name to Jira account IDs
+
+	migrated     bool // Load upgraded the config from migratedFrom
+	migratedFrom int
+	original     []byte // The pre-upgrade file, backed up by the next Save
 }
 
 // Account represents an Atlassian account configuration
 type Account struct {
-	Site  string `json:"site"`
-	Email string `json:"email"`
-	Token string `json:"token"`
+	Site    string `json:"site"`
+	Email   string `json:"email"`
+	Token   string `json:"token,omitempty"`
+	CloudID string `json:"cloud_id,omitempty"`
+
+	// KeyringKey is set when the token lives in the OS keyring instead of
+	// this file, and names its keyring entry. Token is filled in from the
+	// keyring by LoadToken and never written back to the file.
+	KeyringKey string `json:"keyring_key,omitempty"`
+
+	// DeploymentType is the site's deployment type as last reported by
+	// serverInfo: "Cloud", "Server", or "DataCenter"
+	DeploymentType string `json:"deployment_type,omitempty"`
+
+	// AccountID is the authenticated user's account ID, saved at login so
+	// commands acting on "me" can skip a lookup
+	AccountID string `json:"account_id,omitempty"`
+}
+
+// MarshalJSON leaves the token out of the config file for accounts whose
+// token is kept in the OS keyring
+func (a Account) MarshalJSON() ([]byte, error) {
+	type plain Account
+	if a.KeyringKey != "" {
+		a.Token = ""
+	}
+	return json.Marshal(plain(a))
+}
+
+// LoadToken reads the account's token from the OS keyring when it's kept
+// there. Accounts storing their token in the config file are left alone.
+func (a *Account) LoadToken() error {
+	if a.KeyringKey == "" || a.Token != "" {
+		return nil
+	}
+
+	keyring, err := OpenKeyring()
+	if err != nil {
+		return fmt.Errorf("failed to read token from keyring: %w", err)
+	}
+	token, err := keyring.Get(a.KeyringKey)
+	if err != nil {
+		return fmt.Errorf("failed to read token from keyring: %w. Run 'atl auth login' again", err)
+	}
+	a.Token = token
+	return nil
+}
+
+// SavedSearch represents a named cross-product search
+type SavedSearch struct {
+	Query   string `json:"query"`
+	Product string `json:"product,omitempty"` // "jira", "confluence", or empty for both
+	Project string `json:"project,omitempty"` // Jira project key scope
+	Space   string `json:"space,omitempty"`   // Confluence space key scope
+	Limit   int    `json:"limit,omitempty"`
 }
 
 // ConfigPath returns the path to the config file
@@ -35,6 +115,14 @@ func ConfigPath() (string, error) {
 	return filepath.Join(configDir, "config.json"), nil
 }
 
+// New returns an empty configuration at the current schema version
+func New() *Config {
+	return &Config{
+		SchemaVersion: CurrentSchemaVersion,
+		Accounts:      make(map[string]*Account),
+	}
+}
+
 // Load reads the configuration from disk
 func Load() (*Config, error) {
 	configPath, err := ConfigPath()
@@ -44,9 +132,7 @@ func Load() (*Config, error) {
 
 	// If config doesn't exist, return empty config
 	if _, err := os.Stat(configPath); os.IsNotExist(err) {
-		return &Config{
-			Accounts: make(map[string]*Account),
-		}, nil
+		return New(), nil
 	}
 
 	data, err := os.ReadFile(configPath)
@@ -63,9 +149,46 @@ func Load() (*Config, error) {
 		cfg.Accounts = make(map[string]*Account)
 	}
 
+	oldVersion := cfg.SchemaVersion
+	migrated, err := cfg.Migrate()
+	if err != nil {
+		return nil, err
+	}
+	if migrated {
+		// The upgrade stays in memory until something saves the config, so
+		// read-only commands never write to disk
+		cfg.migrated = true
+		cfg.migratedFrom = oldVersion
+		cfg.original = data
+	}
+
 	return &cfg, nil
 }
 
+// Migrate upgrades the config to CurrentSchemaVersion in place and reports
+// whether anything changed. Configs written by a newer CLI are rejected
+// rather than silently downgraded.
+func (c *Config) Migrate() (bool, error) {
+	if c.SchemaVersion > CurrentSchemaVersion {
+		return false, fmt.Errorf("config schema version %d is newer than this version of atl supports (%d). Upgrade atl", c.SchemaVersion, CurrentSchemaVersion)
+	}
+	if c.SchemaVersion == CurrentSchemaVersion {
+		return false, nil
+	}
+
+	for v := max(c.SchemaVersion, 0); v < CurrentSchemaVersion; v++ {
+		migrations[v](c)
+	}
+	c.SchemaVersion = CurrentSchemaVersion
+	return true, nil
+}
+
+// MigratedFrom reports the schema version the config had on disk if Load
+// upgraded it
+func (c *Config) MigratedFrom() (int, bool) {
+	return c.migratedFrom, c.migrated
+}
+
 // Save writes the configuration to disk
 func (c *Config) Save() error {
 	configPath, err := ConfigPath()
@@ -73,6 +196,18 @@ func (c *Config) Save() error {
 		return err
 	}
 
+	// The first save after an upgrade keeps the original next to the new
+	// file in case something needs to be recovered
+	if c.original != nil {
+		backupPath := fmt.Sprintf("%s.v%d.bak", configPath, c.migratedFrom)
+		if err := os.WriteFile(backupPath, c.original, 0600); err != nil {
+			return fmt.Errorf("failed to back up config before upgrading it: %w", err)
+		}
+		c.original = nil
+	}
+
+	c.SchemaVersion = CurrentSchemaVersion
+
 	data, err := json.MarshalIndent(c, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal config: %w", err)
@@ -96,9 +231,84 @@ func (c *Config) GetActiveAccount() (*Account, error) {
 		return nil, fmt.Errorf("active account '%s' not found", c.ActiveAccount)
 	}
 
+	if err := account.LoadToken(); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// Environment variables that supply credentials without a config file
+const (
+	EnvSite  = "ATLASSIAN_SITE"
+	EnvEmail = "ATLASSIAN_EMAIL"
+	EnvToken = "ATLASSIAN_TOKEN"
+)
+
+// AccountFromEnv returns the account described by ATLASSIAN_SITE,
+// ATLASSIAN_EMAIL, and ATLASSIAN_TOKEN, or nil unless all three are set
+func AccountFromEnv() *Account {
+	site, email, token := os.Getenv(EnvSite), os.Getenv(EnvEmail), os.Getenv(EnvToken)
+	if site == "" || email == "" || token == "" {
+		return nil
+	}
+	return &Account{Site: site, Email: email, Token: token}
+}
+
+// ResolveAccount returns the account commands should run as: the named
+// account when name is given, then the one from the environment when
+// ATLASSIAN_SITE, ATLASSIAN_EMAIL, and ATLASSIAN_TOKEN are all set, and
+// otherwise the active account
+func (c *Config) ResolveAccount(name string) (*Account, error) {
+	if name != "" {
+		return c.GetAccount(name)
+	}
+	if account := AccountFromEnv(); account != nil {
+		return account, nil
+	}
+	return c.GetActiveAccount()
+}
+
+// GetAccount returns the named account, listing the configured accounts in
+// the error when there's no such account
+func (c *Config) GetAccount(name string) (*Account, error) {
+	account, ok := c.Accounts[name]
+	if !ok {
+		return nil, c.accountNotFound(name)
+	}
+
+	if err := account.LoadToken(); err != nil {
+		return nil, err
+	}
 	return account, nil
 }
 
+// SetActiveAccount makes the named account the active one
+func (c *Config) SetActiveAccount(name string) error {
+	if _, ok := c.Accounts[name]; !ok {
+		return c.accountNotFound(name)
+	}
+	c.ActiveAccount = name
+	return nil
+}
+
+func (c *Config) accountNotFound(name string) error {
+	names := c.AccountNames()
+	if len(names) == 0 {
+		return fmt.Errorf("account '%s' not found: no accounts are configured. Run 'atl auth login' to add one", name)
+	}
+	return fmt.Errorf("account '%s' not found. Available accounts: %s", name, strings.Join(names, ", "))
+}
+
+// AccountNames returns the names of the configured accounts in sorted order
+func (c *Config) AccountNames() []string {
+	names := make([]string, 0, len(c.Accounts))
+	for name := range c.Accounts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // SetAccount adds or updates an account
 func (c *Config) SetAccount(name string, account *Account) {
 	if c.Accounts == nil {
@@ -106,3 +316,79 @@ func (c *Config) SetAccount(name string, account *Account) {
 	}
 	c.Accounts[name] = account
 }
+
+// SetSavedSearch adds or updates a saved search
+func (c *Config) SetSavedSearch(name string, search *SavedSearch) {
+	if c.SavedSearches == nil {
+		c.SavedSearches = make(map[string]*SavedSearch)
+	}
+	c.SavedSearches[name] = search
+}
+
+// GetSavedSearch returns the saved search with the given name
+func (c *Config) GetSavedSearch(name string) (*SavedSearch, error) {
+	search, ok := c.SavedSearches[name]
+	if !ok {
+		return nil, fmt.Errorf("saved search '%s' not found", name)
+	}
+	return search, nil
+}
+
+// SetDefault sets a default setting value
+func (c *Config) SetDefault(key, value string) {
+	if c.Defaults == nil {
+		c.Defaults = make(map[string]string)
+	}
+	c.Defaults[key] = value
+}
+
+// GetDefault returns a default setting value and whether it is set
+func (c *Config) GetDefault(key string) (string, bool) {
+	value, ok := c.Defaults[key]
+	return value, ok
+}
+
+// UnsetDefault removes a default setting
+func (c *Config) UnsetDefault(key string) {
+	delete(c.Defaults, key)
+}
+
+// ResolveList resolves a list setting such as a default field list. A
+// non-empty override (usually from a flag) wins; otherwise the comma-separated
+// default stored under key is used. It returns nil when neither is set so the
+// caller's built-in default applies.
+func (c *Config) ResolveList(key string, override []string) []string {
+	if len(override) > 0 {
+		return override
+	}
+
+	value, ok := c.GetDefault(key)
+	if !ok {
+		return nil
+	}
+
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// SetTeam adds or updates a named list of Jira account IDs
+func (c *Config) SetTeam(name string, accountIDs []string) {
+	if c.Teams == nil {
+		c.Teams = make(map[string][]string)
+	}
+	c.Teams[name] = accountIDs
+}
+
+// GetTeam returns the account IDs in a named team
+func (c *Config) GetTeam(name string) ([]string, error) {
+	team, ok := c.Teams[name]
+	if !ok {
+		return nil, fmt.Errorf("team '%s' not found", name)
+	}
+	return team, nil
+}
@@ -2,8 +2,11 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -487,3 +490,407 @@ func TestMultipleAccounts(t *testing.T) {
 		t.Errorf("Expected active account to be work account, got %q", activeAccount.Site)
 	}
 }
+
+func TestSavedSearches(t *testing.T) {
+	cfg := &Config{}
+
+	if _, err := cfg.GetSavedSearch("missing"); err == nil {
+		t.Error("Expected error for missing saved search")
+	}
+
+	cfg.SetSavedSearch("runbooks", &SavedSearch{
+		Query:   "deploy runbook",
+		Product: "confluence",
+		Space:   "OPS",
+	})
+
+	search, err := cfg.GetSavedSearch("runbooks")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if search.Query != "deploy runbook" {
+		t.Errorf("Expected query 'deploy runbook', got %q", search.Query)
+	}
+	if search.Space != "OPS" {
+		t.Errorf("Expected space 'OPS', got %q", search.Space)
+	}
+}
+
+func TestDefaults(t *testing.T) {
+	cfg := &Config{}
+
+	if _, ok := cfg.GetDefault("max-retries"); ok {
+		t.Error("Expected max-retries to be unset")
+	}
+
+	cfg.SetDefault("max-retries", "5")
+
+	value, ok := cfg.GetDefault("max-retries")
+	if !ok || value != "5" {
+		t.Errorf("Expected max-retries '5', got %q (set: %v)", value, ok)
+	}
+
+	cfg.UnsetDefault("max-retries")
+	if _, ok := cfg.GetDefault("max-retries"); ok {
+		t.Error("Expected max-retries to be unset after UnsetDefault")
+	}
+}
+
+func TestResolveList(t *testing.T) {
+	cfg := &Config{}
+	cfg.SetDefault("jira-default-fields", " summary, status,,assignee ")
+
+	tests := []struct {
+		name     string
+		key      string
+		override []string
+		expected []string
+	}{
+		{"Override wins", "jira-default-fields", []string{"key"}, []string{"key"}},
+		{"Configured default", "jira-default-fields", nil, []string{"summary", "status", "assignee"}},
+		{"Unset key", "confluence-page-expand", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := cfg.ResolveList(tt.key, tt.override)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestLoad_MigratesOldFormat(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("HOME", tmpDir)
+
+	configPath, err := ConfigPath()
+	if err != nil {
+		t.Fatalf("Failed to get config path: %v", err)
+	}
+
+	// An unversioned config as written before schema_version existed
+	oldConfig := `{
+  "active_account": "work",
+  "accounts": {
+    "work": {
+      "site": "work.atlassian.net",
+      "email": "me@work.com",
+      "token": "secret"
+    }
+  }
+}`
+	if err := os.WriteFile(configPath, []byte(oldConfig), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if cfg.SchemaVersion != CurrentSchemaVersion {
+		t.Errorf("Expected schema version %d, got %d", CurrentSchemaVersion, cfg.SchemaVersion)
+	}
+	if from, ok := cfg.MigratedFrom(); !ok || from != 0 {
+		t.Errorf("Expected migration from version 0, got %d (migrated: %v)", from, ok)
+	}
+	if cfg.Defaults == nil {
+		t.Error("Expected Defaults to be initialized")
+	}
+	if account := cfg.Accounts["work"]; account == nil || account.Token != "secret" {
+		t.Errorf("Expected account to survive migration, got %+v", account)
+	}
+
+	// Loading alone doesn't touch the file
+	if data, _ := os.ReadFile(configPath); string(data) != oldConfig {
+		t.Error("Expected Load to leave the old config on disk")
+	}
+	if _, err := os.Stat(configPath + ".v0.bak"); !os.IsNotExist(err) {
+		t.Errorf("Expected no backup before saving, got %v", err)
+	}
+
+	// Saving writes the upgraded config with a backup of the original
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Failed to save config: %v", err)
+	}
+	backup, err := os.ReadFile(configPath + ".v0.bak")
+	if err != nil {
+		t.Fatalf("Expected backup file, got %v", err)
+	}
+	if string(backup) != oldConfig {
+		t.Error("Expected backup to contain the original config")
+	}
+
+	var onDisk map[string]any
+	data, _ := os.ReadFile(configPath)
+	if err := json.Unmarshal(data, &onDisk); err != nil {
+		t.Fatalf("Failed to parse migrated config: %v", err)
+	}
+	if onDisk["schema_version"] != float64(CurrentSchemaVersion) {
+		t.Errorf("Expected schema_version %d on disk, got %v", CurrentSchemaVersion, onDisk["schema_version"])
+	}
+
+	// Loading again is a no-op
+	cfg, err = Load()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, ok := cfg.MigratedFrom(); ok {
+		t.Error("Expected no migration on second load")
+	}
+}
+
+func TestLoad_NewerSchemaVersion(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("HOME", tmpDir)
+
+	configPath, err := ConfigPath()
+	if err != nil {
+		t.Fatalf("Failed to get config path: %v", err)
+	}
+
+	data := []byte(`{"schema_version": 99, "accounts": {}}`)
+	if err := os.WriteFile(configPath, data, 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	if _, err := Load(); err == nil {
+		t.Error("Expected error for a config from a newer version")
+	}
+}
+
+func TestTeams(t *testing.T) {
+	cfg := &Config{}
+
+	if _, err := cfg.GetTeam("support"); err == nil {
+		t.Error("Expected error for missing team")
+	}
+
+	cfg.SetTeam("support", []string{"acct1", "acct2"})
+
+	team, err := cfg.GetTeam("support")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(team) != 2 || team[0] != "acct1" || team[1] != "acct2" {
+		t.Errorf("Expected [acct1 acct2], got %v", team)
+	}
+}
+
+// memoryKeyring is an in-memory Keyring for tests
+type memoryKeyring map[string]string
+
+func (k memoryKeyring) Get(key string) (string, error) {
+	secret, ok := k[key]
+	if !ok {
+		return "", ErrKeyringNotFound
+	}
+	return secret, nil
+}
+
+func (k memoryKeyring) Set(key, secret string) error {
+	k[key] = secret
+	return nil
+}
+
+func (k memoryKeyring) Delete(key string) error {
+	delete(k, key)
+	return nil
+}
+
+func useKeyring(t *testing.T, keyring Keyring, err error) {
+	original := OpenKeyring
+	OpenKeyring = func() (Keyring, error) { return keyring, err }
+	t.Cleanup(func() { OpenKeyring = original })
+}
+
+func TestKeyringToken(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("HOME", tmpDir)
+	useKeyring(t, memoryKeyring{"main": "keyring-token"}, nil)
+
+	cfg := &Config{
+		ActiveAccount: "main",
+		Accounts: map[string]*Account{
+			"main": {
+				Site:       "company.atlassian.net",
+				Email:      "user@company.com",
+				Token:      "keyring-token",
+				KeyringKey: "main",
+			},
+		},
+	}
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Failed to save config: %v", err)
+	}
+
+	// The token must not reach the config file
+	configPath, _ := ConfigPath()
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("Failed to read config: %v", err)
+	}
+	var raw map[string]any
+	json.Unmarshal(data, &raw)
+	account := raw["accounts"].(map[string]any)["main"].(map[string]any)
+	if _, ok := account["token"]; ok {
+		t.Errorf("Expected no token in config file, got %s", data)
+	}
+	if account["keyring_key"] != "main" {
+		t.Errorf("Expected keyring_key 'main', got %v", account["keyring_key"])
+	}
+
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+	active, err := loaded.GetActiveAccount()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if active.Token != "keyring-token" {
+		t.Errorf("Expected token from keyring, got %q", active.Token)
+	}
+}
+
+func TestKeyringToken_Unavailable(t *testing.T) {
+	useKeyring(t, nil, ErrKeyringUnavailable)
+
+	cfg := &Config{
+		ActiveAccount: "main",
+		Accounts: map[string]*Account{
+			"main": {Site: "company.atlassian.net", Email: "user@company.com", KeyringKey: "main"},
+		},
+	}
+
+	_, err := cfg.GetActiveAccount()
+	if err == nil || !errors.Is(err, ErrKeyringUnavailable) {
+		t.Errorf("Expected keyring unavailable error, got %v", err)
+	}
+
+	// File-stored tokens never touch the keyring
+	cfg.Accounts["main"] = &Account{Site: "company.atlassian.net", Email: "user@company.com", Token: "file-token"}
+	account, err := cfg.GetActiveAccount()
+	if err != nil || account.Token != "file-token" {
+		t.Errorf("Expected file token, got %v, %v", account, err)
+	}
+}
+
+func TestResolveAccount_Environment(t *testing.T) {
+	cfg := &Config{
+		ActiveAccount: "main",
+		Accounts: map[string]*Account{
+			"main": {Site: "company.atlassian.net", Email: "user@company.com", Token: "file-token"},
+		},
+	}
+
+	// Partial environment credentials are ignored
+	t.Setenv(EnvSite, "ci.atlassian.net")
+	t.Setenv(EnvEmail, "ci@company.com")
+	t.Setenv(EnvToken, "")
+	account, err := cfg.ResolveAccount("")
+	if err != nil || account.Token != "file-token" {
+		t.Fatalf("Expected the active account, got %v, %v", account, err)
+	}
+
+	t.Setenv(EnvToken, "env-token")
+	account, err = cfg.ResolveAccount("")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if account.Site != "ci.atlassian.net" || account.Email != "ci@company.com" || account.Token != "env-token" {
+		t.Errorf("Expected the environment account, got %+v", account)
+	}
+
+	// No config is needed at all
+	account, err = (&Config{}).ResolveAccount("")
+	if err != nil || account.Token != "env-token" {
+		t.Errorf("Expected the environment account without a config, got %v, %v", account, err)
+	}
+}
+
+func TestResolveAccount_Precedence(t *testing.T) {
+	cfg := &Config{
+		ActiveAccount: "work",
+		Accounts: map[string]*Account{
+			"work":     {Site: "work.atlassian.net", Email: "me@work.com", Token: "work-token"},
+			"personal": {Site: "me.atlassian.net", Email: "me@home.com", Token: "personal-token"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		account  string
+		env      bool
+		expected string
+	}{
+		{"Active account", "", false, "work.atlassian.net"},
+		{"Environment over active account", "", true, "ci.atlassian.net"},
+		{"Named account", "personal", false, "me.atlassian.net"},
+		{"Named account over environment", "personal", true, "me.atlassian.net"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.env {
+				t.Setenv(EnvSite, "ci.atlassian.net")
+				t.Setenv(EnvEmail, "ci@work.com")
+				t.Setenv(EnvToken, "env-token")
+			} else {
+				t.Setenv(EnvSite, "")
+			}
+
+			account, err := cfg.ResolveAccount(tt.account)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if account.Site != tt.expected {
+				t.Errorf("Expected site %q, got %q", tt.expected, account.Site)
+			}
+		})
+	}
+}
+
+func TestResolveAccount_UnknownName(t *testing.T) {
+	cfg := &Config{
+		Accounts: map[string]*Account{
+			"work":     {Site: "work.atlassian.net"},
+			"personal": {Site: "me.atlassian.net"},
+		},
+	}
+
+	_, err := cfg.ResolveAccount("client")
+	if err == nil {
+		t.Fatal("Expected error for unknown account")
+	}
+	if !strings.Contains(err.Error(), "personal, work") {
+		t.Errorf("Expected error to list the accounts, got %v", err)
+	}
+}
+
+func TestSetActiveAccount(t *testing.T) {
+	cfg := &Config{
+		ActiveAccount: "work",
+		Accounts: map[string]*Account{
+			"work":     {Site: "work.atlassian.net"},
+			"personal": {Site: "me.atlassian.net"},
+		},
+	}
+
+	if err := cfg.SetActiveAccount("personal"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if cfg.ActiveAccount != "personal" {
+		t.Errorf("Expected active account 'personal', got %q", cfg.ActiveAccount)
+	}
+
+	err := cfg.SetActiveAccount("client")
+	if err == nil || !strings.Contains(err.Error(), "personal, work") {
+		t.Errorf("Expected error listing the accounts, got %v", err)
+	}
+	if cfg.ActiveAccount != "personal" {
+		t.Errorf("Expected active account unchanged, got %q", cfg.ActiveAccount)
+	}
+}
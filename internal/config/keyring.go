@@ -0,0 +1,172 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Credential stores accepted by the credential-store setting
+const (
+	CredentialStoreFile    = "file"
+	CredentialStoreKeyring = "keyring"
+)
+
+// keyringService names the entries atl creates in the OS keyring
+const keyringService = "atlassian-cli"
+
+// ErrKeyringUnavailable is returned when this system has no supported keyring
+var ErrKeyringUnavailable = errors.New("no OS keyring is available")
+
+// ErrKeyringNotFound is returned when the keyring has no entry for a key
+var ErrKeyringNotFound = errors.New("not found in the OS keyring")
+
+// Keyring stores secrets in the operating system's credential store
+type Keyring interface {
+	Get(key string) (string, error)
+	Set(key, secret string) error
+	Delete(key string) error
+}
+
+// OpenKeyring returns the keyring used for account tokens. Tests replace it
+// with an in-memory keyring.
+var OpenKeyring = SystemKeyring
+
+// SystemKeyring returns the keyring for this OS: the macOS Keychain (via
+// security), the Windows Credential Manager (via PowerShell), or the Secret
+// Service on Linux and BSD (via secret-tool). It returns
+// ErrKeyringUnavailable when the needed tool isn't installed.
+func SystemKeyring() (Keyring, error) {
+	var tool string
+	var keyring Keyring
+	switch runtime.GOOS {
+	case "darwin":
+		tool, keyring = "security", macKeyring{}
+	case "windows":
+		tool, keyring = "powershell", windowsKeyring{}
+	default:
+		tool, keyring = "secret-tool", secretServiceKeyring{}
+	}
+	if _, err := exec.LookPath(tool); err != nil {
+		return nil, ErrKeyringUnavailable
+	}
+	return keyring, nil
+}
+
+// runKeyringCommand runs a keyring tool, feeding it stdin, and returns its
+// trimmed output. notFound reports whether a failed run means the entry
+// doesn't exist.
+func runKeyringCommand(cmd *exec.Cmd, stdin string, notFound func(exitCode int) bool) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd.Stdin = strings.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && notFound != nil && notFound(exitErr.ExitCode()) {
+			return "", ErrKeyringNotFound
+		}
+		if detail := strings.TrimSpace(stderr.String()); detail != "" {
+			return "", fmt.Errorf("%s: %s", cmd.Args[0], detail)
+		}
+		return "", fmt.Errorf("%s: %w", cmd.Args[0], err)
+	}
+	return strings.TrimRight(stdout.String(), "\r\n"), nil
+}
+
+// macKeyring uses generic passwords in the login Keychain
+type macKeyring struct{}
+
+func (macKeyring) Get(key string) (string, error) {
+	cmd := exec.Command("security", "find-generic-password", "-s", keyringService, "-a", key, "-w")
+	// security exits with 44 when the item doesn't exist
+	return runKeyringCommand(cmd, "", func(code int) bool { return code == 44 })
+}
+
+func (macKeyring) Set(key, secret string) error {
+	// Pass the secret through security's interactive mode so it never
+	// appears in the process list
+	if strings.ContainsAny(secret, "\"\\\n") || strings.ContainsAny(key, "\"\\\n") {
+		return fmt.Errorf("security: can't store values containing quotes, backslashes, or newlines")
+	}
+	command := fmt.Sprintf("add-generic-password -U -s %q -a %q -w %q\n", keyringService, key, secret)
+	_, err := runKeyringCommand(exec.Command("security", "-i"), command, nil)
+	return err
+}
+
+func (macKeyring) Delete(key string) error {
+	cmd := exec.Command("security", "delete-generic-password", "-s", keyringService, "-a", key)
+	_, err := runKeyringCommand(cmd, "", func(code int) bool { return code == 44 })
+	return err
+}
+
+// secretServiceKeyring uses the freedesktop Secret Service (GNOME Keyring,
+// KWallet) through secret-tool
+type secretServiceKeyring struct{}
+
+func (secretServiceKeyring) Get(key string) (string, error) {
+	cmd := exec.Command("secret-tool", "lookup", "service", keyringService, "account", key)
+	// secret-tool exits with 1 and prints nothing when there's no match
+	secret, err := runKeyringCommand(cmd, "", func(code int) bool { return code == 1 })
+	if err == nil && secret == "" {
+		return "", ErrKeyringNotFound
+	}
+	return secret, err
+}
+
+func (secretServiceKeyring) Set(key, secret string) error {
+	label := fmt.Sprintf("atl token for %s", key)
+	cmd := exec.Command("secret-tool", "store", "--label", label, "service", keyringService, "account", key)
+	_, err := runKeyringCommand(cmd, secret, nil)
+	return err
+}
+
+func (secretServiceKeyring) Delete(key string) error {
+	cmd := exec.Command("secret-tool", "clear", "service", keyringService, "account", key)
+	_, err := runKeyringCommand(cmd, "", nil)
+	return err
+}
+
+// windowsKeyring uses the Credential Manager's PasswordVault through
+// PowerShell. Secrets are passed on stdin.
+type windowsKeyring struct{}
+
+// windowsVaultPrelude loads the PasswordVault type and opens the vault
+const windowsVaultPrelude = `[void][Windows.Security.Credentials.PasswordVault,Windows.Security.Credentials,ContentType=WindowsRuntime]; $vault = New-Object Windows.Security.Credentials.PasswordVault; `
+
+// windowsNotFoundExitCode is the exit code the scripts use for a missing entry
+const windowsNotFoundExitCode = 3
+
+func runPowerShell(script, stdin string, notFound func(int) bool) (string, error) {
+	cmd := exec.Command("powershell", "-NoProfile", "-NonInteractive", "-Command", windowsVaultPrelude+script)
+	return runKeyringCommand(cmd, stdin, notFound)
+}
+
+// powerShellQuote quotes s as a single-quoted PowerShell string
+func powerShellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func (windowsKeyring) Get(key string) (string, error) {
+	script := fmt.Sprintf(`try { $c = $vault.Retrieve(%s, %s) } catch { exit %d }; $c.RetrievePassword(); [Console]::Out.Write($c.Password)`,
+		powerShellQuote(keyringService), powerShellQuote(key), windowsNotFoundExitCode)
+	return runPowerShell(script, "", func(code int) bool { return code == windowsNotFoundExitCode })
+}
+
+func (windowsKeyring) Set(key, secret string) error {
+	script := fmt.Sprintf(`$secret = [Console]::In.ReadToEnd(); $vault.Add((New-Object Windows.Security.Credentials.PasswordCredential(%s, %s, $secret)))`,
+		powerShellQuote(keyringService), powerShellQuote(key))
+	_, err := runPowerShell(script, secret, nil)
+	return err
+}
+
+func (windowsKeyring) Delete(key string) error {
+	script := fmt.Sprintf(`try { $c = $vault.Retrieve(%s, %s) } catch { exit %d }; $vault.Remove($c)`,
+		powerShellQuote(keyringService), powerShellQuote(key), windowsNotFoundExitCode)
+	_, err := runPowerShell(script, "", func(code int) bool { return code == windowsNotFoundExitCode })
+	return err
+}